@@ -12,6 +12,25 @@ type config struct {
 	GRPCServerAddr string `env:"GRPC_SERVER_ADDR" envDefault:":9090"`
 	LogLevel       string `env:"LOG_LEVEL" envDefault:"info"`
 
+	// AdminServerAddr serves metrics for operators, it shouldn't be exposed to API clients.
+	AdminServerAddr string `env:"ADMIN_SERVER_ADDR" envDefault:":8081"`
+
+	// Readiness of the app is checked with given interval. External services are not checked by default,
+	// so their outage doesn't take all app instances out of service.
+	HealthCheckInterval         time.Duration `env:"HEALTH_CHECK_INTERVAL" envDefault:"5s"`
+	HealthCheckTimeout          time.Duration `env:"HEALTH_CHECK_TIMEOUT" envDefault:"3s"`
+	HealthCheckExternalServices bool          `env:"HEALTH_CHECK_EXTERNAL_SERVICES" envDefault:"false"`
+
+	// ShutdownDrainDelay is a time between failing readiness probes and stopping servers on shutdown.
+	// It should be longer than readiness probe period times failure threshold, so traffic is stopped before connections are drained.
+	ShutdownDrainDelay time.Duration `env:"SHUTDOWN_DRAIN_DELAY" envDefault:"5s"`
+
+	// TracingExporter is "none" for traces used only in logs, or "otlp" for exporting them with OTLP over http.
+	// Sample ratio applies to traces started by the app, sampling decision of callers is respected.
+	TracingExporter    string  `env:"TRACING_EXPORTER" envDefault:"none"`
+	TracingServiceName string  `env:"TRACING_SERVICE_NAME" envDefault:"bikerental"`
+	TracingSampleRatio float64 `env:"TRACING_SAMPLE_RATIO" envDefault:"1"`
+
 	PostgresDB            string `env:"POSTGRES_DB" envDefault:"testdb"`
 	PostgresUser          string `env:"POSTGRES_USER" envDefault:"postgres"`
 	PostgresPass          string `env:"POSTGRES_PASS" envDefault:"password"`
@@ -23,6 +42,88 @@ type config struct {
 
 	BikewiseAddr    string        `env:"BIKEWISE_ADDR" envDefault:"https://bikewise.org/api"`
 	BikewiseTimeout time.Duration `env:"BIKEWISE_TIMEOUT" envDefault:"10s"`
+
+	// PaymentProvider is "fake" for in-memory provider or "http" for payment provider API.
+	PaymentProvider         string        `env:"PAYMENT_PROVIDER" envDefault:"fake"`
+	PaymentProviderAddr     string        `env:"PAYMENT_PROVIDER_ADDR"`
+	PaymentProviderAPIKey   string        `env:"PAYMENT_PROVIDER_API_KEY"`
+	PaymentProviderTimeout  time.Duration `env:"PAYMENT_PROVIDER_TIMEOUT" envDefault:"10s"`
+	PaymentFakeDeclineAbove int64         `env:"PAYMENT_FAKE_DECLINE_ABOVE" envDefault:"0"`
+
+	// Security deposits per bike type, in eurocents. Zero means no deposit.
+	DepositStandard int64 `env:"DEPOSIT_STANDARD" envDefault:"0"`
+	DepositElectric int64 `env:"DEPOSIT_ELECTRIC" envDefault:"20000"`
+	DepositCargo    int64 `env:"DEPOSIT_CARGO" envDefault:"30000"`
+
+	BlobStoreDir string `env:"BLOB_STORE_DIR" envDefault:"data/blobs"`
+
+	PricingRateTablesFile string `env:"PRICING_RATE_TABLES_FILE" envDefault:"configs/pricing/ratetables.json"`
+
+	OpeningHoursFile string `env:"OPENING_HOURS_FILE" envDefault:"configs/openinghours/calendars.json"`
+
+	ExchangeRatesFile string `env:"EXCHANGE_RATES_FILE" envDefault:"configs/exchangerates/rates.json"`
+
+	TaxRegionsFile string `env:"TAX_REGIONS_FILE" envDefault:"configs/tax/regions.json"`
+
+	// ReceiptTemplatesDir contains "default" directory and optional directories of brands overriding its templates.
+	ReceiptTemplatesDir string `env:"RECEIPT_TEMPLATES_DIR" envDefault:"configs/receipts"`
+
+	// AuthJWKSFile contains keys for verifying JWTs. Default file has a key for local development only.
+	// Optional issuer and audience are checked against "iss" and "aud" claims.
+	AuthJWKSFile    string `env:"AUTH_JWKS_FILE" envDefault:"configs/auth/jwks.json"`
+	AuthJWTIssuer   string `env:"AUTH_JWT_ISSUER"`
+	AuthJWTAudience string `env:"AUTH_JWT_AUDIENCE"`
+
+	// Booking limits. Zero value disables a limit.
+	BookingSlotGranularity       time.Duration `env:"BOOKING_SLOT_GRANULARITY" envDefault:"15m"`
+	BookingIndividualMinDuration time.Duration `env:"BOOKING_INDIVIDUAL_MIN_DURATION" envDefault:"30m"`
+	BookingIndividualMaxDuration time.Duration `env:"BOOKING_INDIVIDUAL_MAX_DURATION" envDefault:"168h"`
+	BookingIndividualMaxAdvance  time.Duration `env:"BOOKING_INDIVIDUAL_MAX_ADVANCE" envDefault:"2160h"`
+	BookingBusinessMinDuration   time.Duration `env:"BOOKING_BUSINESS_MIN_DURATION" envDefault:"30m"`
+	BookingBusinessMaxDuration   time.Duration `env:"BOOKING_BUSINESS_MAX_DURATION" envDefault:"720h"`
+	BookingBusinessMaxAdvance    time.Duration `env:"BOOKING_BUSINESS_MAX_ADVANCE" envDefault:"8760h"`
+
+	// Customer risk checks. Zero value disables a limit.
+	RiskMaxActiveReservations int           `env:"RISK_MAX_ACTIVE_RESERVATIONS" envDefault:"3"`
+	RiskMaxRecentNoShows      int           `env:"RISK_MAX_RECENT_NO_SHOWS" envDefault:"2"`
+	RiskNoShowWindow          time.Duration `env:"RISK_NO_SHOW_WINDOW" envDefault:"2160h"`
+	RiskMaxUnpaidInvoices     int           `env:"RISK_MAX_UNPAID_INVOICES" envDefault:"1"`
+
+	// Customer notifications. Default SMTP address works with local fake SMTP server, e.g. MailHog.
+	SMTPAddr                   string        `env:"SMTP_ADDR" envDefault:"localhost:1025"`
+	SMTPFrom                   string        `env:"SMTP_FROM" envDefault:"noreply@bikerental.example"`
+	SMTPUsername               string        `env:"SMTP_USERNAME"`
+	SMTPPassword               string        `env:"SMTP_PASSWORD"`
+	SMTPTimeout                time.Duration `env:"SMTP_TIMEOUT" envDefault:"10s"`
+	NotificationTemplatesDir   string        `env:"NOTIFICATION_TEMPLATES_DIR" envDefault:"configs/notifications"`
+	NotificationOutboxInterval time.Duration `env:"NOTIFICATION_OUTBOX_INTERVAL" envDefault:"10s"`
+
+	// EventPublisher is "log" for writing events to stdout or "webhook" for posting them to EventWebhookURL.
+	EventPublisher      string        `env:"EVENT_PUBLISHER" envDefault:"log"`
+	EventWebhookURL     string        `env:"EVENT_WEBHOOK_URL"`
+	EventWebhookTimeout time.Duration `env:"EVENT_WEBHOOK_TIMEOUT" envDefault:"10s"`
+	EventRelayInterval  time.Duration `env:"EVENT_RELAY_INTERVAL" envDefault:"5s"`
+
+	WebhookTimeout          time.Duration `env:"WEBHOOK_TIMEOUT" envDefault:"10s"`
+	WebhookDeliveryInterval time.Duration `env:"WEBHOOK_DELIVERY_INTERVAL" envDefault:"5s"`
+
+	// WebhookAllowInsecureURLs allows http webhook urls and subscribers in private networks, e.g. on localhost.
+	// It's meant only for development, in production subscribers could reach internal services.
+	WebhookAllowInsecureURLs bool `env:"WEBHOOK_ALLOW_INSECURE_URLS" envDefault:"false"`
+
+	// WatchBufferSize is a number of changes queued for a watch stream. Slower streams are interrupted.
+	WatchBufferSize int `env:"WATCH_BUFFER_SIZE" envDefault:"100"`
+
+	// RateLimitStore is "memory" for limits of each app instance, or "postgres" for limits shared by all instances.
+	RateLimitStore      string `env:"RATE_LIMIT_STORE" envDefault:"memory"`
+	RateLimitPolicyFile string `env:"RATE_LIMIT_POLICY_FILE" envDefault:"configs/ratelimits/policy.json"`
+
+	// RateLimitCleanupInterval is an interval of removing idle buckets and quota counters of previous days.
+	RateLimitCleanupInterval time.Duration `env:"RATE_LIMIT_CLEANUP_INTERVAL" envDefault:"10m"`
+
+	// IdempotencyKeyTTL is a time for which responses of calls with idempotency keys are returned to retries.
+	IdempotencyKeyTTL          time.Duration `env:"IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+	IdempotencyCleanupInterval time.Duration `env:"IDEMPOTENCY_CLEANUP_INTERVAL" envDefault:"10m"`
 }
 
 func newConfig() (config, error) {
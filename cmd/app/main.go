@@ -7,17 +7,63 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/nglogic/go-application-guide/internal/adapter/database"
+	"github.com/nglogic/go-application-guide/internal/adapter/file/blobs"
+	"github.com/nglogic/go-application-guide/internal/adapter/file/exchangerates"
+	"github.com/nglogic/go-application-guide/internal/adapter/file/jwks"
+	notificationsfile "github.com/nglogic/go-application-guide/internal/adapter/file/notifications"
+	openinghoursfile "github.com/nglogic/go-application-guide/internal/adapter/file/openinghours"
+	ratelimitsfile "github.com/nglogic/go-application-guide/internal/adapter/file/ratelimits"
+	"github.com/nglogic/go-application-guide/internal/adapter/file/ratetables"
+	"github.com/nglogic/go-application-guide/internal/adapter/file/receipts"
+	"github.com/nglogic/go-application-guide/internal/adapter/file/taxregions"
+	ahttp "github.com/nglogic/go-application-guide/internal/adapter/http"
+	httpevents "github.com/nglogic/go-application-guide/internal/adapter/http/events"
 	"github.com/nglogic/go-application-guide/internal/adapter/http/incidents"
+	httppayments "github.com/nglogic/go-application-guide/internal/adapter/http/payments"
 	"github.com/nglogic/go-application-guide/internal/adapter/http/weather"
+	httpwebhooks "github.com/nglogic/go-application-guide/internal/adapter/http/webhooks"
+	logevents "github.com/nglogic/go-application-guide/internal/adapter/log/events"
+	"github.com/nglogic/go-application-guide/internal/adapter/memory/broker"
+	memorypayments "github.com/nglogic/go-application-guide/internal/adapter/memory/payments"
+	memoryratelimit "github.com/nglogic/go-application-guide/internal/adapter/memory/ratelimit"
+	promreservations "github.com/nglogic/go-application-guide/internal/adapter/prometheus/reservations"
+	smtpnotifications "github.com/nglogic/go-application-guide/internal/adapter/smtp/notifications"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/auth"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental/bikes"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/company"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/damage"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental/discount"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/event"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/idempotency"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/loyalty"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/notification"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/openinghours"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/pricing"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/promocode"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/ratelimit"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/receipt"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental/reservation"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/risk"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/tax"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/watch"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/webhook"
+	"github.com/nglogic/go-application-guide/internal/transport/admin"
 	"github.com/nglogic/go-application-guide/internal/transport/grpc"
 	"github.com/nglogic/go-application-guide/internal/transport/grpc/httpgateway"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -39,10 +85,26 @@ func main() {
 	}
 	log.SetLevel(logLevel)
 
+	tracerProvider, err := newTracerProvider(conf)
+	if err != nil {
+		log.Fatalf("creating tracer provider: %v", err)
+	}
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	metricsRegistry := prometheus.NewRegistry()
+	metricsRegistry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
 	dbAdapter, err := database.NewAdapter(conf.PostgresHostPort, conf.PostgresDB, conf.PostgresUser, conf.PostgresPass, conf.PostgresMigrationsDir, log)
 	if err != nil {
 		log.Fatalf("creating bike repository: %v", err)
 	}
+	if err := metricsRegistry.Register(dbAdapter.StatsCollector()); err != nil {
+		log.Fatalf("registering db metrics: %v", err)
+	}
 
 	bikeService, err := bikes.NewService(dbAdapter.Bikes())
 	if err != nil {
@@ -53,63 +115,469 @@ func main() {
 		Timeout: maxHTTPClientTimeout,
 	}
 
-	weatherAdapter, err := weather.NewAdapter(conf.MetaweatherAddr, conf.MetaweatherTimeout, httpClient)
+	httpClientMetrics, err := ahttp.NewClientMetrics(metricsRegistry)
+	if err != nil {
+		log.Fatalf("creating http client metrics: %v", err)
+	}
+
+	weatherAdapter, err := weather.NewAdapter(
+		conf.MetaweatherAddr,
+		conf.MetaweatherTimeout,
+		ahttp.DoerWithMetrics(httpClient, httpClientMetrics, "metaweather"),
+	)
 	if err != nil {
 		log.Fatalf("creating weather adapter: %v", err)
 	}
 
-	incidentsAdapter, err := incidents.NewAdapter(conf.BikewiseAddr, conf.BikewiseTimeout, httpClient)
+	incidentsAdapter, err := incidents.NewAdapter(
+		conf.BikewiseAddr,
+		conf.BikewiseTimeout,
+		ahttp.DoerWithMetrics(httpClient, httpClientMetrics, "bikewise"),
+	)
 	if err != nil {
 		log.Fatalf("creating incidents adapter: %v", err)
 	}
 
-	discountService, err := discount.NewService(weatherAdapter, incidentsAdapter)
+	exchangeRatesAdapter, err := exchangerates.NewAdapter(conf.ExchangeRatesFile)
+	if err != nil {
+		log.Fatalf("creating exchange rates adapter: %v", err)
+	}
+
+	discountService, err := discount.NewService(weatherAdapter, incidentsAdapter, dbAdapter.PromoCodes(), exchangeRatesAdapter)
 	if err != nil {
 		log.Fatalf("creating discount service: %v", err)
 	}
 
-	reservationService, err := reservation.NewService(
-		discountService,
-		bikeService,
+	rateTablesAdapter, err := ratetables.NewAdapter(conf.PricingRateTablesFile)
+	if err != nil {
+		log.Fatalf("creating rate tables adapter: %v", err)
+	}
+
+	pricingService, err := pricing.NewService(rateTablesAdapter)
+	if err != nil {
+		log.Fatalf("creating pricing service: %v", err)
+	}
+
+	openingHoursAdapter, err := openinghoursfile.NewAdapter(conf.OpeningHoursFile)
+	if err != nil {
+		log.Fatalf("creating opening hours adapter: %v", err)
+	}
+
+	openingHoursService, err := openinghours.NewService(openingHoursAdapter)
+	if err != nil {
+		log.Fatalf("creating opening hours service: %v", err)
+	}
+
+	loyaltyService, err := loyalty.NewService(dbAdapter.Loyalty())
+	if err != nil {
+		log.Fatalf("creating loyalty service: %v", err)
+	}
+
+	riskService, err := risk.NewService(
+		dbAdapter.Blocklist(),
+		dbAdapter.Reservations(),
+		bikerental.RiskPolicy{
+			MaxActiveReservations: conf.RiskMaxActiveReservations,
+			MaxRecentNoShows:      conf.RiskMaxRecentNoShows,
+			NoShowWindow:          conf.RiskNoShowWindow,
+			MaxUnpaidInvoices:     conf.RiskMaxUnpaidInvoices,
+		},
+	)
+	if err != nil {
+		log.Fatalf("creating risk service: %v", err)
+	}
+
+	paymentService, err := newPaymentService(conf, httpClient)
+	if err != nil {
+		log.Fatalf("creating payment service: %v", err)
+	}
+
+	blobStore, err := blobs.NewAdapter(conf.BlobStoreDir)
+	if err != nil {
+		log.Fatalf("creating blob store: %v", err)
+	}
+
+	damageService, err := damage.NewService(
+		dbAdapter.DamageReports(),
 		dbAdapter.Reservations(),
-		dbAdapter.Customers(),
+		bikeService,
+		blobStore,
+	)
+	if err != nil {
+		log.Fatalf("creating damage report service: %v", err)
+	}
+
+	taxRegionsAdapter, err := taxregions.NewAdapter(conf.TaxRegionsFile)
+	if err != nil {
+		log.Fatalf("creating tax regions adapter: %v", err)
+	}
+
+	taxService, err := tax.NewService(taxRegionsAdapter)
+	if err != nil {
+		log.Fatalf("creating tax service: %v", err)
+	}
+
+	changesBroker := broker.NewAdapter(conf.WatchBufferSize)
+	watchService, err := watch.NewService(changesBroker)
+	if err != nil {
+		log.Fatalf("creating watch service: %v", err)
+	}
+
+	reservationMetrics, err := promreservations.NewAdapter(metricsRegistry)
+	if err != nil {
+		log.Fatalf("creating reservation metrics adapter: %v", err)
+	}
+
+	reservationService, err := reservation.NewService(
+		reservation.Dependencies{
+			DiscountService:  discountService,
+			PricingService:   pricingService,
+			OpeningHours:     openingHoursService,
+			LoyaltyService:   loyaltyService,
+			PaymentService:   paymentService,
+			ExchangeRates:    exchangeRatesAdapter,
+			TaxService:       taxService,
+			RiskService:      riskService,
+			DamageService:    damageService,
+			BikeService:      bikeService,
+			Changes:          changesBroker,
+			Metrics:          reservationMetrics,
+			ReservationsRepo: dbAdapter.Reservations(),
+			CustomersRepo:    dbAdapter.Customers(),
+			CompaniesRepo:    dbAdapter.Companies(),
+		},
+		reservation.Config{
+			BookingPolicies: bikerental.BookingPolicies{
+				Individual: bikerental.BookingPolicy{
+					MinDuration:     conf.BookingIndividualMinDuration,
+					MaxDuration:     conf.BookingIndividualMaxDuration,
+					MaxAdvance:      conf.BookingIndividualMaxAdvance,
+					SlotGranularity: conf.BookingSlotGranularity,
+				},
+				Business: bikerental.BookingPolicy{
+					MinDuration:     conf.BookingBusinessMinDuration,
+					MaxDuration:     conf.BookingBusinessMaxDuration,
+					MaxAdvance:      conf.BookingBusinessMaxAdvance,
+					SlotGranularity: conf.BookingSlotGranularity,
+				},
+			},
+			DepositPolicy: bikerental.DepositPolicy{
+				bikerental.BikeTypeStandard: bikerental.NewMoney(conf.DepositStandard, bikerental.BaseCurrency),
+				bikerental.BikeTypeElectric: bikerental.NewMoney(conf.DepositElectric, bikerental.BaseCurrency),
+				bikerental.BikeTypeCargo:    bikerental.NewMoney(conf.DepositCargo, bikerental.BaseCurrency),
+			},
+		},
 	)
 	if err != nil {
 		log.Fatalf("creating reservation service: %v", err)
 	}
 
-	srv, err := grpc.NewServer(bikeService, reservationService, log)
+	promoCodeService, err := promocode.NewService(dbAdapter.PromoCodes())
+	if err != nil {
+		log.Fatalf("creating promo code service: %v", err)
+	}
+
+	companyService, err := company.NewService(dbAdapter.Companies(), dbAdapter.Customers())
+	if err != nil {
+		log.Fatalf("creating company service: %v", err)
+	}
+
+	receiptsAdapter, err := receipts.NewAdapter(conf.ReceiptTemplatesDir)
+	if err != nil {
+		log.Fatalf("creating receipts adapter: %v", err)
+	}
+
+	receiptService, err := receipt.NewService(receiptsAdapter, dbAdapter.Reservations())
+	if err != nil {
+		log.Fatalf("creating receipt service: %v", err)
+	}
+
+	notificationTemplatesAdapter, err := notificationsfile.NewAdapter(conf.NotificationTemplatesDir)
+	if err != nil {
+		log.Fatalf("creating notification templates adapter: %v", err)
+	}
+
+	smtpAdapter, err := smtpnotifications.NewAdapter(conf.SMTPAddr, conf.SMTPFrom, conf.SMTPUsername, conf.SMTPPassword, conf.SMTPTimeout)
+	if err != nil {
+		log.Fatalf("creating smtp adapter: %v", err)
+	}
+
+	notificationService, err := notification.NewService(
+		smtpAdapter,
+		notificationTemplatesAdapter,
+		dbAdapter.Notifications(),
+		dbAdapter.Reservations(),
+	)
+	if err != nil {
+		log.Fatalf("creating notification service: %v", err)
+	}
+
+	eventPublisher, err := newEventPublisher(conf, httpClient)
+	if err != nil {
+		log.Fatalf("creating event publisher: %v", err)
+	}
+
+	webhooksAdapter, err := httpwebhooks.NewAdapter(
+		conf.WebhookTimeout,
+		ahttp.DoerWithMetrics(httpwebhooks.NewHTTPClient(conf.WebhookAllowInsecureURLs), httpClientMetrics, "webhooks"),
+	)
+	if err != nil {
+		log.Fatalf("creating webhooks adapter: %v", err)
+	}
+
+	webhookService, err := webhook.NewService(webhooksAdapter, dbAdapter.Webhooks(), conf.WebhookAllowInsecureURLs)
+	if err != nil {
+		log.Fatalf("creating webhook service: %v", err)
+	}
+
+	eventService, err := event.NewService(dbAdapter.Events(), webhookService, eventPublisher)
+	if err != nil {
+		log.Fatalf("creating event relay service: %v", err)
+	}
+
+	jwksAdapter, err := jwks.NewAdapter(conf.AuthJWKSFile, conf.AuthJWTIssuer, conf.AuthJWTAudience)
+	if err != nil {
+		log.Fatalf("creating jwks adapter: %v", err)
+	}
+
+	authService, err := auth.NewService(jwksAdapter, dbAdapter.APIKeys())
+	if err != nil {
+		log.Fatalf("creating auth service: %v", err)
+	}
+
+	rateLimitPolicyAdapter, err := ratelimitsfile.NewAdapter(conf.RateLimitPolicyFile)
+	if err != nil {
+		log.Fatalf("creating rate limit policy adapter: %v", err)
+	}
+
+	rateLimiter, quotaCounter, err := newRateLimitStore(conf, dbAdapter)
+	if err != nil {
+		log.Fatalf("creating rate limit store: %v", err)
+	}
+
+	rateLimitService, err := ratelimit.NewService(rateLimitPolicyAdapter.Policy(), rateLimiter, quotaCounter)
+	if err != nil {
+		log.Fatalf("creating rate limit service: %v", err)
+	}
+
+	idempotencyService, err := idempotency.NewService(dbAdapter.IdempotencyKeys(), conf.IdempotencyKeyTTL, log)
+	if err != nil {
+		log.Fatalf("creating idempotency service: %v", err)
+	}
+
+	rpcMetrics, err := grpc.NewRPCMetrics(metricsRegistry)
+	if err != nil {
+		log.Fatalf("creating rpc metrics: %v", err)
+	}
+
+	srv, err := grpc.NewServer(
+		grpc.Services{
+			Bikes:        bikeService,
+			Reservations: reservationService,
+			Damage:       damageService,
+			OpeningHours: openingHoursService,
+			PromoCodes:   promoCodeService,
+			Loyalty:      loyaltyService,
+			Companies:    companyService,
+			Risk:         riskService,
+			Webhooks:     webhookService,
+			Watch:        watchService,
+			APIKeys:      authService,
+			Idempotency:  idempotencyService,
+		},
+		log,
+	)
 	if err != nil {
 		log.Fatalf("creating new server: %v", err)
 	}
 
+	healthChecks := []grpc.HealthCheck{
+		{Name: "postgres", Check: dbAdapter.Ping},
+	}
+	if conf.HealthCheckExternalServices {
+		healthChecks = append(
+			healthChecks,
+			grpc.HealthCheck{Name: "metaweather", Check: weatherAdapter.Ping},
+			grpc.HealthCheck{Name: "bikewise", Check: incidentsAdapter.Ping},
+		)
+	}
+	health, err := grpc.NewHealth(log, healthChecks, conf.HealthCheckTimeout)
+	if err != nil {
+		log.Fatalf("creating health: %v", err)
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 
+	// SIGTERM is sent by Kubernetes and other process managers before killing the app.
+	// Servers are stopped after drain delay, when probes don't send traffic to the app anymore.
+	// Second signal stops them immediately.
 	sigint := make(chan os.Signal, 1)
-	signal.Notify(sigint, os.Interrupt)
+	signal.Notify(sigint, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		<-sigint
+		log.Infof("shutting down: draining for %s", conf.ShutdownDrainDelay)
+		health.Shutdown()
+		select {
+		case <-time.After(conf.ShutdownDrainDelay):
+		case <-sigint:
+		}
 		cancel()
 	}()
 
 	g, ctx := errgroup.WithContext(ctx)
 	g.Go(func() error {
-		if err := httpgateway.RunServer(ctx, log, srv, conf.HTTPServerAddr); err != nil {
+		// Servers wait for open watch streams on shutdown, so they have to be closed first.
+		<-ctx.Done()
+		changesBroker.Close()
+		return nil
+	})
+	g.Go(func() error {
+		if err := httpgateway.RunServer(ctx, log, srv, receiptService, authService, rateLimitService, rpcMetrics, health, conf.HTTPServerAddr); err != nil {
 			return fmt.Errorf("http server: %w", err)
 		}
 		return nil
 	})
+	g.Go(func() error {
+		if err := admin.RunServer(ctx, log, metricsRegistry, conf.AdminServerAddr); err != nil {
+			return fmt.Errorf("admin server: %w", err)
+		}
+		return nil
+	})
 	g.Go(func() error {
 		l, err := net.Listen("tcp", conf.GRPCServerAddr)
 		if err != nil {
 			return fmt.Errorf("creating net listener: %w", err)
 		}
-		if err = grpc.RunServer(ctx, log, srv, l); err != nil {
+		if err = grpc.RunServer(ctx, log, srv, authService, rateLimitService, rpcMetrics, health, l); err != nil {
 			return fmt.Errorf("grpc server: %w", err)
 		}
 		return nil
 	})
+	g.Go(func() error {
+		health.Run(ctx, conf.HealthCheckInterval)
+		return nil
+	})
+	g.Go(func() error {
+		runWorker(ctx, log, "notification worker", conf.NotificationOutboxInterval, notificationService.ProcessOutbox)
+		return nil
+	})
+	g.Go(func() error {
+		runWorker(ctx, log, "event relay", conf.EventRelayInterval, eventService.RelayEvents)
+		return nil
+	})
+	g.Go(func() error {
+		runWorker(ctx, log, "webhook worker", conf.WebhookDeliveryInterval, webhookService.ProcessDeliveries)
+		return nil
+	})
+	g.Go(func() error {
+		runWorker(ctx, log, "idempotency keys cleanup", conf.IdempotencyCleanupInterval, idempotencyService.DeleteExpiredKeys)
+		return nil
+	})
+	g.Go(func() error {
+		runWorker(ctx, log, "rate limits cleanup", conf.RateLimitCleanupInterval, rateLimitService.DeleteExpired)
+		return nil
+	})
 	if err := g.Wait(); err != nil {
 		log.Error(err)
 	}
+
+	// Spans are exported in batches, the last ones are exported on shutdown.
+	if err := tracerProvider.Shutdown(context.Background()); err != nil {
+		log.Errorf("shutting down tracer provider: %v", err)
+	}
+}
+
+// runWorker periodically runs process until context is canceled, e.g. processing outbox.
+// Errors are logged, failed items are retried in the next run.
+func runWorker(
+	ctx context.Context,
+	log logrus.FieldLogger,
+	name string,
+	interval time.Duration,
+	process func(context.Context) (int, error),
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("%s: shutting down", name)
+			return
+		case <-ticker.C:
+		}
+
+		n, err := process(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Errorf("%s: processing: %v", name, err)
+		}
+		if n > 0 {
+			log.Infof("%s: processed %d items", name, n)
+		}
+	}
+}
+
+func newEventPublisher(conf config, httpClient *http.Client) (bikerental.EventPublisher, error) {
+	switch conf.EventPublisher {
+	case "log":
+		return logevents.NewAdapter(os.Stdout)
+	case "webhook":
+		return httpevents.NewAdapter(conf.EventWebhookURL, conf.EventWebhookTimeout, httpClient)
+	default:
+		return nil, fmt.Errorf("unknown event publisher: '%s'", conf.EventPublisher)
+	}
+}
+
+func newPaymentService(conf config, httpClient *http.Client) (bikerental.PaymentService, error) {
+	switch conf.PaymentProvider {
+	case "fake":
+		return memorypayments.NewAdapter(conf.PaymentFakeDeclineAbove), nil
+	case "http":
+		return httppayments.NewAdapter(conf.PaymentProviderAddr, conf.PaymentProviderAPIKey, conf.PaymentProviderTimeout, httpClient)
+	default:
+		return nil, fmt.Errorf("unknown payment provider: '%s'", conf.PaymentProvider)
+	}
+}
+
+// newTracerProvider creates provider of tracers used by transport and adapters.
+// Spans are created even if they are not exported, so logs have trace ids.
+// OTLP exporter is configured with standard env variables, e.g. OTEL_EXPORTER_OTLP_ENDPOINT.
+func newTracerProvider(conf config) (*sdktrace.TracerProvider, error) {
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(conf.TracingServiceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating tracing resource: %w", err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.TracingSampleRatio))),
+	}
+	switch conf.TracingExporter {
+	case "none":
+	case "otlp":
+		exporter, err := otlptracehttp.New(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("creating otlp exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter: '%s'", conf.TracingExporter)
+	}
+	return sdktrace.NewTracerProvider(opts...), nil
+}
+
+func newRateLimitStore(conf config, dbAdapter *database.Adapter) (bikerental.RateLimiter, bikerental.QuotaCounter, error) {
+	switch conf.RateLimitStore {
+	case "memory":
+		a := memoryratelimit.NewAdapter()
+		return a, a, nil
+	case "postgres":
+		r := dbAdapter.RateLimits()
+		return r, r, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown rate limit store: '%s'", conf.RateLimitStore)
+	}
 }
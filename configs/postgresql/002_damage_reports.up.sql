@@ -0,0 +1,23 @@
+ALTER TABLE bikes ADD COLUMN out_of_service boolean NOT NULL DEFAULT false;
+
+CREATE TYPE damage_severity AS ENUM (
+	'low',
+	'medium',
+	'high'
+);
+
+CREATE TABLE damage_reports (
+	id uuid NOT NULL,
+	reservation_id uuid NOT NULL,
+	bike_id uuid NOT NULL,
+	description varchar NOT NULL,
+	severity damage_severity NOT NULL,
+	repair_cost_estimate integer NOT NULL,
+	photo_ids varchar[] NOT NULL DEFAULT '{}',
+	created_at timestamptz(0) NOT NULL,
+	CONSTRAINT damage_reports_pk PRIMARY KEY (id),
+	CONSTRAINT reservations_fk FOREIGN KEY (reservation_id) REFERENCES reservations(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+	CONSTRAINT bikes_fk FOREIGN KEY (bike_id) REFERENCES bikes(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE INDEX damage_reports_bike_idx ON public.damage_reports USING btree (bike_id, created_at);
+CREATE INDEX damage_reports_reservation_idx ON public.damage_reports USING btree (reservation_id);
@@ -0,0 +1 @@
+ALTER TABLE bikes ADD COLUMN station_id varchar NOT NULL DEFAULT '';
@@ -0,0 +1,34 @@
+CREATE TYPE promo_code_type AS ENUM (
+	'percentage',
+	'fixed'
+);
+
+CREATE TABLE promo_codes (
+	code varchar NOT NULL,
+	"type" promo_code_type NOT NULL,
+	"percent" numeric NOT NULL DEFAULT 0,
+	amount integer NOT NULL DEFAULT 0,
+	expires_at timestamptz(0) NULL,
+	max_redemptions integer NOT NULL DEFAULT 0,
+	max_redemptions_per_customer integer NOT NULL DEFAULT 0,
+	customer_type customer_type NULL,
+	bike_model_name varchar NOT NULL DEFAULT '',
+	stackable boolean NOT NULL DEFAULT false,
+	redemptions integer NOT NULL DEFAULT 0,
+	created_at timestamptz(0) NOT NULL,
+	CONSTRAINT promo_codes_pk PRIMARY KEY (code)
+);
+
+CREATE TABLE promo_code_redemptions (
+	reservation_id uuid NOT NULL,
+	code varchar NOT NULL,
+	customer_id uuid NOT NULL,
+	created_at timestamptz(0) NOT NULL,
+	CONSTRAINT promo_code_redemptions_pk PRIMARY KEY (reservation_id),
+	CONSTRAINT promo_codes_fk FOREIGN KEY (code) REFERENCES promo_codes(code) ON UPDATE CASCADE ON DELETE RESTRICT,
+	CONSTRAINT reservations_fk FOREIGN KEY (reservation_id) REFERENCES reservations(id) ON UPDATE CASCADE ON DELETE CASCADE,
+	CONSTRAINT customers_fk FOREIGN KEY (customer_id) REFERENCES customers(id) ON UPDATE CASCADE ON DELETE RESTRICT DEFERRABLE
+);
+CREATE INDEX promo_code_redemptions_customer_idx ON public.promo_code_redemptions USING btree (code, customer_id);
+
+ALTER TABLE reservations ADD COLUMN promo_code varchar NULL;
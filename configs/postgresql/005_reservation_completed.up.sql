@@ -0,0 +1 @@
+ALTER TYPE reservation_status ADD VALUE IF NOT EXISTS 'completed';
@@ -0,0 +1,42 @@
+CREATE TYPE loyalty_entry_kind AS ENUM (
+	'accrual',
+	'redemption',
+	'reversal',
+	'refund'
+);
+
+-- Append-only ledger of loyalty points.
+CREATE TABLE loyalty_ledger (
+	id uuid NOT NULL,
+	customer_id uuid NOT NULL,
+	reservation_id uuid NOT NULL,
+	kind loyalty_entry_kind NOT NULL,
+	points integer NOT NULL,
+	created_at timestamptz(0) NOT NULL,
+	CONSTRAINT loyalty_ledger_pk PRIMARY KEY (id),
+	CONSTRAINT customers_fk FOREIGN KEY (customer_id) REFERENCES customers(id) ON UPDATE CASCADE ON DELETE RESTRICT DEFERRABLE,
+	CONSTRAINT reservations_fk FOREIGN KEY (reservation_id) REFERENCES reservations(id) ON UPDATE CASCADE ON DELETE RESTRICT DEFERRABLE
+);
+CREATE INDEX loyalty_ledger_customer_idx ON public.loyalty_ledger USING btree (customer_id, created_at);
+
+CREATE FUNCTION loyalty_ledger_append_only() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'loyalty_ledger is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER loyalty_ledger_append_only
+	BEFORE UPDATE OR DELETE ON loyalty_ledger
+	FOR EACH ROW EXECUTE PROCEDURE loyalty_ledger_append_only();
+
+-- Running totals of the ledger, updated in the same transactions as the ledger.
+-- Rows are also used for locking, so concurrent redemptions can't exceed the balance.
+CREATE TABLE loyalty_accounts (
+	customer_id uuid NOT NULL,
+	balance integer NOT NULL DEFAULT 0,
+	earned_points integer NOT NULL DEFAULT 0,
+	CONSTRAINT loyalty_accounts_pk PRIMARY KEY (customer_id),
+	CONSTRAINT customers_fk FOREIGN KEY (customer_id) REFERENCES customers(id) ON UPDATE CASCADE ON DELETE RESTRICT DEFERRABLE
+);
+
+ALTER TABLE reservations ADD COLUMN redeemed_points integer NOT NULL DEFAULT 0;
@@ -0,0 +1,13 @@
+CREATE TABLE companies (
+	id uuid NOT NULL,
+	"name" varchar NOT NULL,
+	discount_percent numeric NOT NULL DEFAULT 0,
+	spending_limit integer NOT NULL DEFAULT 0,
+	-- Updated when spending limit is checked, so concurrent reservations of company members are serialized.
+	spending_checked_at timestamptz NULL,
+	CONSTRAINT companies_pk PRIMARY KEY (id)
+);
+
+ALTER TABLE customers ADD COLUMN company_id uuid NULL;
+ALTER TABLE customers ADD CONSTRAINT companies_fk FOREIGN KEY (company_id) REFERENCES companies(id) ON UPDATE CASCADE ON DELETE SET NULL;
+CREATE INDEX customers_company_idx ON public.customers USING btree (company_id);
@@ -0,0 +1,17 @@
+CREATE TYPE blocklist_entry_kind AS ENUM (
+	'customer_id',
+	'email_domain'
+);
+
+CREATE TABLE blocklist (
+	id uuid NOT NULL,
+	kind blocklist_entry_kind NOT NULL,
+	value varchar NOT NULL,
+	reason varchar NOT NULL DEFAULT '',
+	created_at timestamptz(0) NOT NULL,
+	CONSTRAINT blocklist_pk PRIMARY KEY (id),
+	CONSTRAINT blocklist_kind_value_key UNIQUE (kind, value)
+);
+
+-- Used by customer risk checks.
+CREATE INDEX reservations_customer_end_time_idx ON public.reservations USING btree (customer_id, end_time);
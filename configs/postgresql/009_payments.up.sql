@@ -0,0 +1,9 @@
+CREATE TYPE payment_status AS ENUM (
+	'authorized',
+	'captured',
+	'refunded',
+	'voided'
+);
+
+ALTER TABLE reservations ADD COLUMN payment_id varchar NULL;
+ALTER TABLE reservations ADD COLUMN payment_status payment_status NULL;
@@ -0,0 +1,18 @@
+CREATE TYPE bike_type AS ENUM (
+	'standard',
+	'electric',
+	'cargo'
+);
+
+ALTER TABLE bikes ADD COLUMN "type" bike_type NOT NULL DEFAULT 'standard';
+
+CREATE TYPE deposit_status AS ENUM (
+	'held',
+	'released',
+	'forfeited'
+);
+
+ALTER TABLE reservations ADD COLUMN deposit_amount integer NOT NULL DEFAULT 0;
+ALTER TABLE reservations ADD COLUMN deposit_payment_id varchar NULL;
+ALTER TABLE reservations ADD COLUMN deposit_status deposit_status NULL;
+ALTER TABLE reservations ADD COLUMN deposit_forfeited integer NOT NULL DEFAULT 0;
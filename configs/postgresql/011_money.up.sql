@@ -0,0 +1,17 @@
+ALTER TABLE bikes ALTER COLUMN price_per_h TYPE bigint;
+ALTER TABLE bikes ADD COLUMN currency varchar(3) NOT NULL DEFAULT 'EUR';
+
+ALTER TABLE reservations ALTER COLUMN total_value TYPE bigint;
+ALTER TABLE reservations ALTER COLUMN applied_discount TYPE bigint;
+ALTER TABLE reservations ALTER COLUMN deposit_amount TYPE bigint;
+ALTER TABLE reservations ALTER COLUMN deposit_forfeited TYPE bigint;
+ALTER TABLE reservations ADD COLUMN currency varchar(3) NOT NULL DEFAULT 'EUR';
+ALTER TABLE reservations ADD COLUMN exchange_rate double precision NOT NULL DEFAULT 1;
+
+ALTER TABLE promo_codes ALTER COLUMN amount TYPE bigint;
+ALTER TABLE promo_codes ADD COLUMN currency varchar(3) NOT NULL DEFAULT 'EUR';
+
+ALTER TABLE companies ALTER COLUMN spending_limit TYPE bigint;
+
+ALTER TABLE damage_reports ALTER COLUMN repair_cost_estimate TYPE bigint;
+ALTER TABLE damage_reports ADD COLUMN currency varchar(3) NOT NULL DEFAULT 'EUR';
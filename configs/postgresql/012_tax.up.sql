@@ -0,0 +1,13 @@
+ALTER TABLE customers ADD COLUMN vat_id varchar NULL;
+
+CREATE TYPE tax_treatment AS ENUM (
+	'standard',
+	'reverse_charge'
+);
+
+-- Reservations made before tax calculation have no tax data and can't be invoiced.
+ALTER TABLE reservations ADD COLUMN tax_treatment tax_treatment NULL;
+ALTER TABLE reservations ADD COLUMN tax_country varchar(2) NOT NULL DEFAULT '';
+ALTER TABLE reservations ADD COLUMN tax_rate double precision NOT NULL DEFAULT 0;
+ALTER TABLE reservations ADD COLUMN net_value bigint NOT NULL DEFAULT 0;
+ALTER TABLE reservations ADD COLUMN tax_value bigint NOT NULL DEFAULT 0;
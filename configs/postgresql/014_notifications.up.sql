@@ -0,0 +1,32 @@
+CREATE TYPE notification_type AS ENUM (
+	'reservation_confirmed',
+	'reservation_reminder',
+	'reservation_canceled',
+	'late_return_warning'
+);
+
+CREATE TYPE notification_status AS ENUM (
+	'pending',
+	'sent',
+	'skipped',
+	'failed'
+);
+
+-- Outbox of customer notifications. Rows are inserted in the same transactions as reservation changes
+-- and processed by notification workers after commit.
+CREATE TABLE notifications (
+	id uuid NOT NULL,
+	type notification_type NOT NULL,
+	reservation_id uuid NOT NULL,
+	recipient varchar NOT NULL,
+	send_at timestamptz NOT NULL,
+	status notification_status NOT NULL,
+	attempts integer NOT NULL DEFAULT 0,
+	last_error varchar NOT NULL DEFAULT '',
+	-- Notification claimed by a worker is not processed by other workers until the lock expires.
+	locked_until timestamptz NULL,
+	created_at timestamptz(0) NOT NULL,
+	CONSTRAINT notifications_pk PRIMARY KEY (id),
+	CONSTRAINT reservations_fk FOREIGN KEY (reservation_id) REFERENCES reservations(id) ON UPDATE CASCADE ON DELETE CASCADE DEFERRABLE
+);
+CREATE INDEX notifications_pending_idx ON public.notifications USING btree (send_at) WHERE status = 'pending';
@@ -0,0 +1,15 @@
+-- Outbox of domain events. Rows are inserted in the same transactions as aggregate changes
+-- and published by the relay in id order.
+CREATE TABLE outbox (
+	id bigserial NOT NULL,
+	type varchar NOT NULL,
+	aggregate_type varchar NOT NULL,
+	aggregate_id uuid NOT NULL,
+	payload jsonb NOT NULL,
+	occurred_at timestamptz NOT NULL,
+	published_at timestamptz NULL,
+	attempts integer NOT NULL DEFAULT 0,
+	last_error varchar NOT NULL DEFAULT '',
+	CONSTRAINT outbox_pk PRIMARY KEY (id)
+);
+CREATE INDEX outbox_unpublished_idx ON public.outbox USING btree (id) WHERE published_at IS NULL;
@@ -0,0 +1,54 @@
+CREATE TABLE webhook_subscriptions (
+	id uuid NOT NULL,
+	url varchar NOT NULL,
+	-- Empty array means all event types.
+	event_types varchar[] NOT NULL DEFAULT '{}',
+	secret varchar NOT NULL,
+	created_at timestamptz(0) NOT NULL,
+	CONSTRAINT webhook_subscriptions_pk PRIMARY KEY (id)
+);
+
+CREATE TYPE webhook_delivery_status AS ENUM (
+	'pending',
+	'delivered',
+	'dead'
+);
+
+-- Deliveries of outbox events to subscribers. Events are copied, so outbox can be cleaned up independently.
+CREATE TABLE webhook_deliveries (
+	id uuid NOT NULL,
+	subscription_id uuid NOT NULL,
+	event_id bigint NOT NULL,
+	event_type varchar NOT NULL,
+	aggregate_type varchar NOT NULL,
+	aggregate_id uuid NOT NULL,
+	payload jsonb NOT NULL,
+	occurred_at timestamptz NOT NULL,
+	status webhook_delivery_status NOT NULL,
+	attempts integer NOT NULL DEFAULT 0,
+	next_attempt_at timestamptz NOT NULL,
+	last_error varchar NOT NULL DEFAULT '',
+	-- Delivery claimed by a worker is not sent by other workers until the lock expires.
+	locked_until timestamptz NULL,
+	created_at timestamptz(0) NOT NULL,
+	delivered_at timestamptz NULL,
+	CONSTRAINT webhook_deliveries_pk PRIMARY KEY (id),
+	CONSTRAINT webhook_deliveries_event_uq UNIQUE (subscription_id, event_id),
+	CONSTRAINT webhook_subscriptions_fk FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+CREATE INDEX webhook_deliveries_pending_idx ON public.webhook_deliveries USING btree (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX webhook_deliveries_subscription_idx ON public.webhook_deliveries USING btree (subscription_id, created_at);
+
+-- Delivery log, one row per attempt.
+CREATE TABLE webhook_delivery_attempts (
+	id bigserial NOT NULL,
+	delivery_id uuid NOT NULL,
+	attempted_at timestamptz NOT NULL,
+	-- Http status of subscriber response, 0 if there was no response.
+	status_code integer NOT NULL,
+	error varchar NOT NULL DEFAULT '',
+	duration_ms integer NOT NULL,
+	CONSTRAINT webhook_delivery_attempts_pk PRIMARY KEY (id),
+	CONSTRAINT webhook_deliveries_fk FOREIGN KEY (delivery_id) REFERENCES webhook_deliveries(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+CREATE INDEX webhook_delivery_attempts_delivery_idx ON public.webhook_delivery_attempts USING btree (delivery_id, attempted_at);
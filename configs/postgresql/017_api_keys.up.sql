@@ -0,0 +1,13 @@
+-- API keys of tenant systems. Only hashes of keys are stored.
+CREATE TABLE api_keys (
+	id uuid NOT NULL,
+	tenant_id varchar NOT NULL,
+	name varchar NOT NULL,
+	prefix varchar NOT NULL,
+	hash varchar NOT NULL,
+	created_at timestamptz(0) NOT NULL,
+	revoked_at timestamptz(0) NULL,
+	CONSTRAINT api_keys_pk PRIMARY KEY (id),
+	CONSTRAINT api_keys_hash_uq UNIQUE (hash)
+);
+CREATE INDEX api_keys_tenant_idx ON public.api_keys USING btree (tenant_id, created_at);
@@ -0,0 +1,3 @@
+-- Keys issued before roles were introduced had access to everything.
+ALTER TABLE api_keys ADD COLUMN role varchar NOT NULL DEFAULT 'admin';
+ALTER TABLE api_keys ALTER COLUMN role DROP DEFAULT;
@@ -0,0 +1,70 @@
+-- Every rental company is a separate tenant. Data created before tenants were introduced belongs to 'default' tenant.
+--
+-- Tenant of a transaction is set in app.tenant_id setting. Bikes, customers and reservations are always
+-- queried in transactions with the setting, and filtered by tenant_id in queries too.
+-- Row-level security is enforced only if the app connects as a role without SUPERUSER and BYPASSRLS attributes.
+ALTER TABLE bikes ADD COLUMN tenant_id varchar NOT NULL DEFAULT 'default';
+ALTER TABLE bikes ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE bikes ADD CONSTRAINT bikes_tenant_uq UNIQUE (tenant_id, id);
+CREATE INDEX bikes_tenant_model_name_idx ON public.bikes USING btree (tenant_id, model_name);
+
+ALTER TABLE customers ADD COLUMN tenant_id varchar NOT NULL DEFAULT 'default';
+ALTER TABLE customers ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE customers ADD CONSTRAINT customers_tenant_uq UNIQUE (tenant_id, id);
+
+-- Reservations can reference only bikes and customers of the same tenant.
+ALTER TABLE reservations ADD COLUMN tenant_id varchar NOT NULL DEFAULT 'default';
+ALTER TABLE reservations ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE reservations DROP CONSTRAINT bikes_fk;
+ALTER TABLE reservations DROP CONSTRAINT reservations_fk;
+ALTER TABLE reservations ADD CONSTRAINT bikes_fk FOREIGN KEY (tenant_id, bike_id)
+	REFERENCES bikes(tenant_id, id) ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE reservations ADD CONSTRAINT reservations_fk FOREIGN KEY (tenant_id, customer_id)
+	REFERENCES customers(tenant_id, id) ON UPDATE CASCADE ON DELETE RESTRICT DEFERRABLE;
+CREATE INDEX reservations_tenant_start_time_idx ON public.reservations USING btree (tenant_id, start_time);
+
+ALTER TABLE bikes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE bikes FORCE ROW LEVEL SECURITY;
+CREATE POLICY bikes_tenant_isolation ON bikes
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE customers ENABLE ROW LEVEL SECURITY;
+ALTER TABLE customers FORCE ROW LEVEL SECURITY;
+CREATE POLICY customers_tenant_isolation ON customers
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE reservations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE reservations FORCE ROW LEVEL SECURITY;
+CREATE POLICY reservations_tenant_isolation ON reservations
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+-- Other tables get tenant of the transaction they are written in.
+-- It's empty for rows written outside tenant transactions, e.g. by workers.
+ALTER TABLE damage_reports ADD COLUMN tenant_id varchar NULL DEFAULT 'default';
+ALTER TABLE promo_codes ADD COLUMN tenant_id varchar NULL DEFAULT 'default';
+ALTER TABLE promo_code_redemptions ADD COLUMN tenant_id varchar NULL DEFAULT 'default';
+ALTER TABLE loyalty_ledger ADD COLUMN tenant_id varchar NULL DEFAULT 'default';
+ALTER TABLE loyalty_accounts ADD COLUMN tenant_id varchar NULL DEFAULT 'default';
+ALTER TABLE companies ADD COLUMN tenant_id varchar NULL DEFAULT 'default';
+ALTER TABLE blocklist ADD COLUMN tenant_id varchar NULL DEFAULT 'default';
+ALTER TABLE notifications ADD COLUMN tenant_id varchar NULL DEFAULT 'default';
+ALTER TABLE outbox ADD COLUMN tenant_id varchar NULL DEFAULT 'default';
+ALTER TABLE webhook_subscriptions ADD COLUMN tenant_id varchar NULL DEFAULT 'default';
+ALTER TABLE webhook_deliveries ADD COLUMN tenant_id varchar NULL DEFAULT 'default';
+ALTER TABLE webhook_delivery_attempts ADD COLUMN tenant_id varchar NULL DEFAULT 'default';
+
+ALTER TABLE damage_reports ALTER COLUMN tenant_id SET DEFAULT nullif(current_setting('app.tenant_id', true), '');
+ALTER TABLE promo_codes ALTER COLUMN tenant_id SET DEFAULT nullif(current_setting('app.tenant_id', true), '');
+ALTER TABLE promo_code_redemptions ALTER COLUMN tenant_id SET DEFAULT nullif(current_setting('app.tenant_id', true), '');
+ALTER TABLE loyalty_ledger ALTER COLUMN tenant_id SET DEFAULT nullif(current_setting('app.tenant_id', true), '');
+ALTER TABLE loyalty_accounts ALTER COLUMN tenant_id SET DEFAULT nullif(current_setting('app.tenant_id', true), '');
+ALTER TABLE companies ALTER COLUMN tenant_id SET DEFAULT nullif(current_setting('app.tenant_id', true), '');
+ALTER TABLE blocklist ALTER COLUMN tenant_id SET DEFAULT nullif(current_setting('app.tenant_id', true), '');
+ALTER TABLE notifications ALTER COLUMN tenant_id SET DEFAULT nullif(current_setting('app.tenant_id', true), '');
+ALTER TABLE outbox ALTER COLUMN tenant_id SET DEFAULT nullif(current_setting('app.tenant_id', true), '');
+ALTER TABLE webhook_subscriptions ALTER COLUMN tenant_id SET DEFAULT nullif(current_setting('app.tenant_id', true), '');
+ALTER TABLE webhook_deliveries ALTER COLUMN tenant_id SET DEFAULT nullif(current_setting('app.tenant_id', true), '');
+ALTER TABLE webhook_delivery_attempts ALTER COLUMN tenant_id SET DEFAULT nullif(current_setting('app.tenant_id', true), '');
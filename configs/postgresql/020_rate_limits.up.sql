@@ -0,0 +1,15 @@
+-- Token buckets of API clients, shared by all app instances. There is one bucket per client and method.
+CREATE TABLE rate_limit_buckets (
+	key varchar NOT NULL,
+	tokens double precision NOT NULL,
+	updated_at timestamptz NOT NULL,
+	CONSTRAINT rate_limit_buckets_pk PRIMARY KEY (key)
+);
+
+-- Daily calls counters of API clients. Counters of previous days are not used, they can be removed any time.
+CREATE TABLE quota_counters (
+	key varchar NOT NULL,
+	day date NOT NULL,
+	calls bigint NOT NULL,
+	CONSTRAINT quota_counters_pk PRIMARY KEY (key, day)
+);
@@ -0,0 +1,15 @@
+-- Calls with idempotency keys and their responses. Keys are unique per client of a tenant.
+-- Response is null while the call is running. Expired keys are removed periodically by the app.
+CREATE TABLE idempotency_keys (
+	tenant_id varchar NOT NULL,
+	client varchar NOT NULL,
+	key varchar NOT NULL,
+	method varchar NOT NULL,
+	request_hash varchar NOT NULL,
+	response bytea NULL,
+	created_at timestamptz NOT NULL,
+	completed_at timestamptz NULL,
+	expires_at timestamptz NOT NULL,
+	CONSTRAINT idempotency_keys_pk PRIMARY KEY (tenant_id, client, key)
+);
+CREATE INDEX idempotency_keys_expires_at_idx ON public.idempotency_keys USING btree (expires_at);
@@ -0,0 +1,5 @@
+-- Reservation value before discounts, as quoted by pricing. It's tax-inclusive, also for reverse charge reservations,
+-- so it can't be derived from total value after discount.
+-- It's unknown for reverse charge reservations made before it was stored.
+ALTER TABLE reservations ADD COLUMN quoted_value bigint NULL;
+UPDATE reservations SET quoted_value = total_value + applied_discount WHERE tax_treatment IS DISTINCT FROM 'reverse_charge';
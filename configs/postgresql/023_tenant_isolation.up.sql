@@ -0,0 +1,204 @@
+-- All tenant data is isolated like bikes, customers and reservations: tenant_id is required,
+-- rows can reference only rows of the same tenant, and row-level security is enforced.
+--
+-- Rows written outside tenant transactions before have empty tenant. They get tenant of the rows they reference,
+-- and 'default' tenant if there are none.
+
+-- Owner of the tables bypasses policies which are not forced, so all rows are updated.
+ALTER TABLE bikes NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE customers NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE reservations NO FORCE ROW LEVEL SECURITY;
+
+UPDATE damage_reports d SET tenant_id = r.tenant_id
+	FROM reservations r WHERE r.id = d.reservation_id AND d.tenant_id IS DISTINCT FROM r.tenant_id;
+
+UPDATE promo_code_redemptions p SET tenant_id = r.tenant_id
+	FROM reservations r WHERE r.id = p.reservation_id AND p.tenant_id IS DISTINCT FROM r.tenant_id;
+UPDATE promo_codes SET tenant_id = 'default' WHERE tenant_id IS NULL;
+
+ALTER TABLE loyalty_ledger DISABLE TRIGGER loyalty_ledger_append_only;
+UPDATE loyalty_ledger l SET tenant_id = c.tenant_id
+	FROM customers c WHERE c.id = l.customer_id AND l.tenant_id IS DISTINCT FROM c.tenant_id;
+ALTER TABLE loyalty_ledger ENABLE TRIGGER loyalty_ledger_append_only;
+UPDATE loyalty_accounts a SET tenant_id = c.tenant_id
+	FROM customers c WHERE c.id = a.customer_id AND a.tenant_id IS DISTINCT FROM c.tenant_id;
+
+-- Companies get tenant of their members. Members of other tenants are removed from the company.
+UPDATE companies co SET tenant_id = (SELECT min(c.tenant_id) FROM customers c WHERE c.company_id = co.id)
+	WHERE co.tenant_id IS NULL;
+UPDATE companies SET tenant_id = 'default' WHERE tenant_id IS NULL;
+UPDATE customers c SET company_id = NULL
+	FROM companies co WHERE co.id = c.company_id AND co.tenant_id <> c.tenant_id;
+
+UPDATE blocklist b SET tenant_id = c.tenant_id
+	FROM customers c WHERE b.kind = 'customer_id' AND c.id::varchar = b.value AND b.tenant_id IS NULL;
+UPDATE blocklist SET tenant_id = 'default' WHERE tenant_id IS NULL;
+
+UPDATE notifications n SET tenant_id = r.tenant_id
+	FROM reservations r WHERE r.id = n.reservation_id AND n.tenant_id IS DISTINCT FROM r.tenant_id;
+
+UPDATE outbox o SET tenant_id = r.tenant_id
+	FROM reservations r WHERE o.aggregate_type = 'reservation' AND r.id = o.aggregate_id AND o.tenant_id IS NULL;
+UPDATE outbox o SET tenant_id = b.tenant_id
+	FROM bikes b WHERE o.aggregate_type = 'bike' AND b.id = o.aggregate_id AND o.tenant_id IS NULL;
+UPDATE outbox SET tenant_id = 'default' WHERE tenant_id IS NULL;
+
+-- Deliveries of other tenants' events are removed, so they are never sent.
+UPDATE webhook_subscriptions SET tenant_id = 'default' WHERE tenant_id IS NULL;
+DELETE FROM webhook_deliveries d
+	USING outbox o, webhook_subscriptions s
+	WHERE o.id = d.event_id AND s.id = d.subscription_id AND o.tenant_id <> s.tenant_id;
+UPDATE webhook_deliveries d SET tenant_id = s.tenant_id
+	FROM webhook_subscriptions s WHERE s.id = d.subscription_id AND d.tenant_id IS DISTINCT FROM s.tenant_id;
+UPDATE webhook_delivery_attempts a SET tenant_id = d.tenant_id
+	FROM webhook_deliveries d WHERE d.id = a.delivery_id AND a.tenant_id IS DISTINCT FROM d.tenant_id;
+
+ALTER TABLE damage_reports ALTER COLUMN tenant_id DROP DEFAULT, ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE promo_codes ALTER COLUMN tenant_id DROP DEFAULT, ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE promo_code_redemptions ALTER COLUMN tenant_id DROP DEFAULT, ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE loyalty_ledger ALTER COLUMN tenant_id DROP DEFAULT, ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE loyalty_accounts ALTER COLUMN tenant_id DROP DEFAULT, ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE companies ALTER COLUMN tenant_id DROP DEFAULT, ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE blocklist ALTER COLUMN tenant_id DROP DEFAULT, ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE notifications ALTER COLUMN tenant_id DROP DEFAULT, ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE outbox ALTER COLUMN tenant_id DROP DEFAULT, ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE webhook_subscriptions ALTER COLUMN tenant_id DROP DEFAULT, ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE webhook_deliveries ALTER COLUMN tenant_id DROP DEFAULT, ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE webhook_delivery_attempts ALTER COLUMN tenant_id DROP DEFAULT, ALTER COLUMN tenant_id SET NOT NULL;
+
+-- Rows can reference only rows of the same tenant.
+ALTER TABLE damage_reports DROP CONSTRAINT reservations_fk;
+ALTER TABLE damage_reports DROP CONSTRAINT bikes_fk;
+ALTER TABLE damage_reports ADD CONSTRAINT reservations_fk FOREIGN KEY (tenant_id, reservation_id)
+	REFERENCES reservations(tenant_id, id) ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE damage_reports ADD CONSTRAINT bikes_fk FOREIGN KEY (tenant_id, bike_id)
+	REFERENCES bikes(tenant_id, id) ON UPDATE CASCADE ON DELETE RESTRICT;
+
+-- Promo codes are unique per tenant.
+ALTER TABLE promo_code_redemptions DROP CONSTRAINT promo_codes_fk;
+ALTER TABLE promo_code_redemptions DROP CONSTRAINT reservations_fk;
+ALTER TABLE promo_code_redemptions DROP CONSTRAINT customers_fk;
+ALTER TABLE promo_codes DROP CONSTRAINT promo_codes_pk;
+ALTER TABLE promo_codes ADD CONSTRAINT promo_codes_pk PRIMARY KEY (tenant_id, code);
+ALTER TABLE promo_code_redemptions ADD CONSTRAINT promo_codes_fk FOREIGN KEY (tenant_id, code)
+	REFERENCES promo_codes(tenant_id, code) ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE promo_code_redemptions ADD CONSTRAINT reservations_fk FOREIGN KEY (tenant_id, reservation_id)
+	REFERENCES reservations(tenant_id, id) ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE promo_code_redemptions ADD CONSTRAINT customers_fk FOREIGN KEY (tenant_id, customer_id)
+	REFERENCES customers(tenant_id, id) ON UPDATE CASCADE ON DELETE RESTRICT DEFERRABLE;
+DROP INDEX promo_code_redemptions_customer_idx;
+CREATE INDEX promo_code_redemptions_customer_idx ON public.promo_code_redemptions USING btree (tenant_id, code, customer_id);
+
+ALTER TABLE loyalty_ledger DROP CONSTRAINT customers_fk;
+ALTER TABLE loyalty_ledger DROP CONSTRAINT reservations_fk;
+ALTER TABLE loyalty_ledger ADD CONSTRAINT customers_fk FOREIGN KEY (tenant_id, customer_id)
+	REFERENCES customers(tenant_id, id) ON UPDATE CASCADE ON DELETE RESTRICT DEFERRABLE;
+ALTER TABLE loyalty_ledger ADD CONSTRAINT reservations_fk FOREIGN KEY (tenant_id, reservation_id)
+	REFERENCES reservations(tenant_id, id) ON UPDATE CASCADE ON DELETE RESTRICT DEFERRABLE;
+ALTER TABLE loyalty_accounts DROP CONSTRAINT customers_fk;
+ALTER TABLE loyalty_accounts ADD CONSTRAINT customers_fk FOREIGN KEY (tenant_id, customer_id)
+	REFERENCES customers(tenant_id, id) ON UPDATE CASCADE ON DELETE RESTRICT DEFERRABLE;
+
+-- Companies are not deleted by the app. Members can't be detached on delete anymore,
+-- because tenant_id of the composite key can't be set to null.
+ALTER TABLE companies ADD CONSTRAINT companies_tenant_uq UNIQUE (tenant_id, id);
+ALTER TABLE customers DROP CONSTRAINT companies_fk;
+ALTER TABLE customers ADD CONSTRAINT companies_fk FOREIGN KEY (tenant_id, company_id)
+	REFERENCES companies(tenant_id, id) ON UPDATE CASCADE ON DELETE RESTRICT;
+
+-- Entries are unique per tenant.
+ALTER TABLE blocklist DROP CONSTRAINT blocklist_kind_value_key;
+ALTER TABLE blocklist ADD CONSTRAINT blocklist_kind_value_key UNIQUE (tenant_id, kind, value);
+
+ALTER TABLE notifications DROP CONSTRAINT reservations_fk;
+ALTER TABLE notifications ADD CONSTRAINT reservations_fk FOREIGN KEY (tenant_id, reservation_id)
+	REFERENCES reservations(tenant_id, id) ON UPDATE CASCADE ON DELETE CASCADE DEFERRABLE;
+
+-- Events are delivered only to subscribers of the same tenant.
+ALTER TABLE webhook_subscriptions ADD CONSTRAINT webhook_subscriptions_tenant_uq UNIQUE (tenant_id, id);
+CREATE INDEX webhook_subscriptions_tenant_idx ON public.webhook_subscriptions USING btree (tenant_id, created_at);
+ALTER TABLE webhook_deliveries DROP CONSTRAINT webhook_subscriptions_fk;
+ALTER TABLE webhook_deliveries ADD CONSTRAINT webhook_subscriptions_fk FOREIGN KEY (tenant_id, subscription_id)
+	REFERENCES webhook_subscriptions(tenant_id, id) ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE webhook_deliveries ADD CONSTRAINT webhook_deliveries_tenant_uq UNIQUE (tenant_id, id);
+ALTER TABLE webhook_delivery_attempts DROP CONSTRAINT webhook_deliveries_fk;
+ALTER TABLE webhook_delivery_attempts ADD CONSTRAINT webhook_deliveries_fk FOREIGN KEY (tenant_id, delivery_id)
+	REFERENCES webhook_deliveries(tenant_id, id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE bikes FORCE ROW LEVEL SECURITY;
+ALTER TABLE customers FORCE ROW LEVEL SECURITY;
+ALTER TABLE reservations FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE damage_reports ENABLE ROW LEVEL SECURITY;
+ALTER TABLE damage_reports FORCE ROW LEVEL SECURITY;
+CREATE POLICY damage_reports_tenant_isolation ON damage_reports
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE promo_codes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE promo_codes FORCE ROW LEVEL SECURITY;
+CREATE POLICY promo_codes_tenant_isolation ON promo_codes
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE promo_code_redemptions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE promo_code_redemptions FORCE ROW LEVEL SECURITY;
+CREATE POLICY promo_code_redemptions_tenant_isolation ON promo_code_redemptions
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE loyalty_ledger ENABLE ROW LEVEL SECURITY;
+ALTER TABLE loyalty_ledger FORCE ROW LEVEL SECURITY;
+CREATE POLICY loyalty_ledger_tenant_isolation ON loyalty_ledger
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE loyalty_accounts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE loyalty_accounts FORCE ROW LEVEL SECURITY;
+CREATE POLICY loyalty_accounts_tenant_isolation ON loyalty_accounts
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE companies ENABLE ROW LEVEL SECURITY;
+ALTER TABLE companies FORCE ROW LEVEL SECURITY;
+CREATE POLICY companies_tenant_isolation ON companies
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE blocklist ENABLE ROW LEVEL SECURITY;
+ALTER TABLE blocklist FORCE ROW LEVEL SECURITY;
+CREATE POLICY blocklist_tenant_isolation ON blocklist
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE webhook_subscriptions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_subscriptions FORCE ROW LEVEL SECURITY;
+CREATE POLICY webhook_subscriptions_tenant_isolation ON webhook_subscriptions
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+ALTER TABLE webhook_delivery_attempts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_delivery_attempts FORCE ROW LEVEL SECURITY;
+CREATE POLICY webhook_delivery_attempts_tenant_isolation ON webhook_delivery_attempts
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+-- Workers claim rows of all tenants in transactions with app.all_tenants setting,
+-- and process each row in transaction of its tenant.
+ALTER TABLE notifications ENABLE ROW LEVEL SECURITY;
+ALTER TABLE notifications FORCE ROW LEVEL SECURITY;
+CREATE POLICY notifications_tenant_isolation ON notifications
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on');
+
+ALTER TABLE outbox ENABLE ROW LEVEL SECURITY;
+ALTER TABLE outbox FORCE ROW LEVEL SECURITY;
+CREATE POLICY outbox_tenant_isolation ON outbox
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on');
+
+ALTER TABLE webhook_deliveries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_deliveries FORCE ROW LEVEL SECURITY;
+CREATE POLICY webhook_deliveries_tenant_isolation ON webhook_deliveries
+	USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on')
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on');
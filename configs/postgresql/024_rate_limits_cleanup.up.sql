@@ -0,0 +1,3 @@
+-- Idle buckets and counters of previous days are removed periodically.
+CREATE INDEX rate_limit_buckets_updated_at_idx ON rate_limit_buckets (updated_at);
+CREATE INDEX quota_counters_day_idx ON quota_counters (day);
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -21,8 +22,9 @@ var sqlBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 
 // Adapter is a storage adapter for app.
 type Adapter struct {
-	db  *sqlx.DB
-	log logrus.FieldLogger
+	db     *sqlx.DB
+	dbName string
+	log    logrus.FieldLogger
 }
 
 // NewAdapter creates new db adapter.
@@ -50,8 +52,9 @@ func NewAdapter(
 	}
 
 	return &Adapter{
-		db:  db,
-		log: log,
+		db:     db,
+		dbName: dbname,
+		log:    log,
 	}, nil
 }
 
@@ -60,11 +63,20 @@ func (a *Adapter) Close() {
 	a.db.Close()
 }
 
+// Ping checks if db is reachable. It's used by health checks, so it's not traced.
+func (a *Adapter) Ping(ctx context.Context) error {
+	if err := a.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("pinging postgres: %w", err)
+	}
+	return nil
+}
+
 // Bikes returns bikes repository.
 func (a *Adapter) Bikes() *BikesRepository {
 	return &BikesRepository{
-		db:  a.db,
-		log: a.log.WithField("repository", "db.bikes"),
+		parent: a,
+		db:     a.db,
+		log:    a.log.WithField("repository", "db.bikes"),
 	}
 }
 
@@ -84,3 +96,92 @@ func (a *Adapter) Customers() *CustomersRepository {
 		log: a.log.WithField("repository", "db.customers"),
 	}
 }
+
+// DamageReports returns damage reports repository.
+func (a *Adapter) DamageReports() *DamageReportsRepository {
+	return &DamageReportsRepository{
+		parent: a,
+		db:     a.db,
+		log:    a.log.WithField("repository", "db.damagereports"),
+	}
+}
+
+// PromoCodes returns promo codes repository.
+func (a *Adapter) PromoCodes() *PromoCodesRepository {
+	return &PromoCodesRepository{
+		db:  a.db,
+		log: a.log.WithField("repository", "db.promocodes"),
+	}
+}
+
+// Loyalty returns loyalty ledger repository.
+func (a *Adapter) Loyalty() *LoyaltyRepository {
+	return &LoyaltyRepository{
+		db:  a.db,
+		log: a.log.WithField("repository", "db.loyalty"),
+	}
+}
+
+// Companies returns companies repository.
+func (a *Adapter) Companies() *CompaniesRepository {
+	return &CompaniesRepository{
+		db:  a.db,
+		log: a.log.WithField("repository", "db.companies"),
+	}
+}
+
+// Blocklist returns customer blocklist repository.
+func (a *Adapter) Blocklist() *BlocklistRepository {
+	return &BlocklistRepository{
+		db:  a.db,
+		log: a.log.WithField("repository", "db.blocklist"),
+	}
+}
+
+// Notifications returns notifications outbox repository.
+func (a *Adapter) Notifications() *NotificationsRepository {
+	return &NotificationsRepository{
+		db:  a.db,
+		log: a.log.WithField("repository", "db.notifications"),
+	}
+}
+
+// Events returns domain events outbox repository.
+func (a *Adapter) Events() *EventsRepository {
+	return &EventsRepository{
+		db:  a.db,
+		log: a.log.WithField("repository", "db.events"),
+	}
+}
+
+// Webhooks returns webhook subscriptions and deliveries repository.
+func (a *Adapter) Webhooks() *WebhooksRepository {
+	return &WebhooksRepository{
+		db:  a.db,
+		log: a.log.WithField("repository", "db.webhooks"),
+	}
+}
+
+// APIKeys returns API keys repository.
+func (a *Adapter) APIKeys() *APIKeysRepository {
+	return &APIKeysRepository{
+		db:  a.db,
+		log: a.log.WithField("repository", "db.apikeys"),
+	}
+}
+
+// RateLimits returns rate limit buckets and quota counters repository.
+func (a *Adapter) RateLimits() *RateLimitsRepository {
+	return &RateLimitsRepository{
+		db:  a.db,
+		log: a.log.WithField("repository", "db.ratelimits"),
+	}
+}
+
+// IdempotencyKeys returns idempotency keys repository.
+func (a *Adapter) IdempotencyKeys() *IdempotencyKeysRepository {
+	return &IdempotencyKeysRepository{
+		db:  a.db,
+		log: a.log.WithField("repository", "db.idempotencykeys"),
+	}
+}
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+// APIKeysRepository manages API keys in db.
+type APIKeysRepository struct {
+	db  *sqlx.DB
+	log logrus.FieldLogger
+}
+
+// List returns keys of a tenant, from the oldest.
+func (r *APIKeysRepository) List(ctx context.Context, tenantID string) (_ []bikerental.APIKey, err error) {
+	ctx, span := startSpan(ctx, "APIKeysRepository.List")
+	defer func() { endSpan(span, err) }()
+
+	var ms []apiKeyModel
+	if err := r.db.SelectContext(
+		ctx,
+		&ms,
+		"select * from api_keys where tenant_id=$1 order by created_at asc",
+		tenantID,
+	); err != nil {
+		return nil, fmt.Errorf("querying for api keys in postgresql: %w", err)
+	}
+
+	result := make([]bikerental.APIKey, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, m.ToAppAPIKey())
+	}
+	return result, nil
+}
+
+// GetByHash returns key by hash of the key.
+// Returns app.ErrNotFound if key doesn't exist.
+func (r *APIKeysRepository) GetByHash(ctx context.Context, hash string) (_ *bikerental.APIKey, err error) {
+	ctx, span := startSpan(ctx, "APIKeysRepository.GetByHash")
+	defer func() { endSpan(span, err) }()
+
+	var m apiKeyModel
+	if err := r.db.GetContext(ctx, &m, "select * from api_keys where hash=$1", hash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, app.ErrNotFound
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := m.ToAppAPIKey()
+	return &result, nil
+}
+
+// Create creates new key in db.
+func (r *APIKeysRepository) Create(ctx context.Context, k bikerental.APIKey) (err error) {
+	ctx, span := startSpan(ctx, "APIKeysRepository.Create")
+	defer func() { endSpan(span, err) }()
+
+	if _, err := r.db.NamedExecContext(
+		ctx,
+		`insert into api_keys (id, tenant_id, name, role, prefix, hash, created_at, revoked_at)
+		values (:id, :tenant_id, :name, :role, :prefix, :hash, :created_at, :revoked_at)`,
+		newAPIKeyModel(k),
+	); err != nil {
+		return fmt.Errorf("inserting api key row into postgres: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", k.ID).Info("api key created in db")
+
+	return nil
+}
+
+// Revoke marks key of a tenant as revoked. Revocation time of already revoked key is not changed.
+// Returns app.ErrNotFound if tenant has no such key.
+func (r *APIKeysRepository) Revoke(ctx context.Context, tenantID, id string, at time.Time) (err error) {
+	ctx, span := startSpan(ctx, "APIKeysRepository.Revoke")
+	defer func() { endSpan(span, err) }()
+
+	res, err := r.db.ExecContext(
+		ctx,
+		"update api_keys set revoked_at=coalesce(revoked_at, $1) where id=$2 and tenant_id=$3",
+		at, id, tenantID,
+	)
+	if err != nil {
+		return fmt.Errorf("updating api key row in postgres: %w", err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return app.ErrNotFound
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("api key revoked in db")
+
+	return nil
+}
+
+type apiKeyModel struct {
+	ID        string       `db:"id"`
+	TenantID  string       `db:"tenant_id"`
+	Name      string       `db:"name"`
+	Role      string       `db:"role"`
+	Prefix    string       `db:"prefix"`
+	Hash      string       `db:"hash"`
+	CreatedAt time.Time    `db:"created_at"`
+	RevokedAt sql.NullTime `db:"revoked_at"`
+}
+
+func newAPIKeyModel(k bikerental.APIKey) apiKeyModel {
+	return apiKeyModel{
+		ID:        k.ID,
+		TenantID:  k.TenantID,
+		Name:      k.Name,
+		Role:      string(k.Role),
+		Prefix:    k.Prefix,
+		Hash:      k.Hash,
+		CreatedAt: k.CreatedAt,
+		RevokedAt: sql.NullTime{Time: k.RevokedAt, Valid: !k.RevokedAt.IsZero()},
+	}
+}
+
+func (m *apiKeyModel) ToAppAPIKey() bikerental.APIKey {
+	return bikerental.APIKey{
+		ID:        m.ID,
+		TenantID:  m.TenantID,
+		Name:      m.Name,
+		Role:      app.Role(m.Role),
+		Prefix:    m.Prefix,
+		Hash:      m.Hash,
+		CreatedAt: m.CreatedAt,
+		RevokedAt: m.RevokedAt.Time,
+	}
+}
@@ -15,14 +15,25 @@ import (
 
 // BikesRepository manages bikes in db.
 type BikesRepository struct {
-	db  *sqlx.DB
-	log logrus.FieldLogger
+	parent *Adapter
+	db     *sqlx.DB
+	log    logrus.FieldLogger
 }
 
-// List returns list of all bikes from db sorted by name ascending.
-func (r *BikesRepository) List(ctx context.Context) ([]bikerental.Bike, error) {
+// List returns list of all bikes of the tenant from context, sorted by name ascending.
+func (r *BikesRepository) List(ctx context.Context) (_ []bikerental.Bike, err error) {
+	ctx, span := startSpan(ctx, "BikesRepository.List")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var bikes []bikeModel
-	if err := r.db.SelectContext(ctx, &bikes, "select * from bikes order by model_name asc"); err != nil {
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(ctx, &bikes, "select * from bikes where tenant_id=$1 order by model_name asc", tenantID)
+	}); err != nil {
 		return nil, fmt.Errorf("querying postgres: %w", err)
 	}
 
@@ -33,10 +44,20 @@ func (r *BikesRepository) List(ctx context.Context) ([]bikerental.Bike, error) {
 	return result, nil
 }
 
-// Get returns a bike by id. If it doesn't exists, returns app.ErrNotFound error.
-func (r *BikesRepository) Get(ctx context.Context, id string) (*bikerental.Bike, error) {
+// Get returns a bike of the tenant from context by id. If it doesn't exists, returns app.ErrNotFound error.
+func (r *BikesRepository) Get(ctx context.Context, id string) (_ *bikerental.Bike, err error) {
+	ctx, span := startSpan(ctx, "BikesRepository.Get")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var b bikeModel
-	if err := r.db.GetContext(ctx, &b, "select * from bikes where id=$1", id); err != nil {
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.GetContext(ctx, &b, "select * from bikes where id=$1 and tenant_id=$2", id, tenantID)
+	}); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, app.ErrNotFound
 		}
@@ -47,43 +68,89 @@ func (r *BikesRepository) Get(ctx context.Context, id string) (*bikerental.Bike,
 	return &result, nil
 }
 
-// Create creates new bike in db.
-func (r *BikesRepository) Create(ctx context.Context, b bikerental.Bike) error {
+// Create creates new bike of the tenant from context in db and stores the event in outbox in the same transaction.
+func (r *BikesRepository) Create(ctx context.Context, b bikerental.Bike, event bikerental.Event) (err error) {
+	ctx, span := startSpan(ctx, "BikesRepository.Create")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
 	sqlq := sqlBuilder.Insert("bikes").
-		Columns("id", "model_name", "weight", "price_per_h").
+		Columns("id", "tenant_id", "type", "model_name", "weight", "price_per_h", "currency", "out_of_service", "station_id").
 		Values(
 			squirrel.Expr(":id"),
+			squirrel.Expr(":tenant_id"),
+			squirrel.Expr(":type"),
 			squirrel.Expr(":model_name"),
 			squirrel.Expr(":weight"),
 			squirrel.Expr(":price_per_h"),
+			squirrel.Expr(":currency"),
+			squirrel.Expr(":out_of_service"),
+			squirrel.Expr(":station_id"),
 		)
 	q, _, err := sqlq.ToSql()
 	if err != nil {
 		return fmt.Errorf("building sql query: %w", err)
 	}
 
-	if _, err = r.db.NamedExecContext(ctx, q, newBikeModel(b)); err != nil {
+	tx, err := beginTenantTx(ctx, r.db, nil)
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	if _, err = tx.NamedExecContext(ctx, q, newBikeModel(tenantID, b)); err != nil {
 		return fmt.Errorf("inserting bike row into postgres: %w", err)
 	}
 
+	if err := r.parent.Events().AppendInTx(ctx, tx, []bikerental.Event{event}); err != nil {
+		return fmt.Errorf("storing bike event: %w", err)
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
 	app.AugmentLogFromCtx(ctx, r.log).WithField("id", b.ID).Info("bike created in db")
 
 	return nil
 }
 
-// Update updates a bike in db by id. If bike is not in db, returns app.ErrNotFound error.
-func (r *BikesRepository) Update(ctx context.Context, id string, b bikerental.Bike) error {
+// Update updates a bike of the tenant from context in db by id and stores the event in outbox in the same transaction.
+// If bike is not in db, returns app.ErrNotFound error.
+func (r *BikesRepository) Update(ctx context.Context, id string, b bikerental.Bike, event bikerental.Event) (err error) {
+	ctx, span := startSpan(ctx, "BikesRepository.Update")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
 	sqlq := sqlBuilder.Update("bikes").
+		Set("type", b.Type).
 		Set("model_name", b.ModelName).
 		Set("weight", b.Weight).
-		Set("price_per_h", b.PricePerHour).
-		Where(squirrel.Eq{"id": id})
+		Set("price_per_h", b.PricePerHour.Amount).
+		Set("currency", b.PricePerHour.Currency).
+		Set("out_of_service", b.OutOfService).
+		Set("station_id", b.StationID).
+		Where(squirrel.Eq{"id": id, "tenant_id": tenantID})
 	q, args, err := sqlq.ToSql()
 	if err != nil {
 		return fmt.Errorf("building sql query: %w", err)
 	}
 
-	res, err := r.db.ExecContext(ctx, q, args...)
+	tx, err := beginTenantTx(ctx, r.db, nil)
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	res, err := tx.ExecContext(ctx, q, args...)
 	if err != nil {
 		return fmt.Errorf("updating bike row in postgres: %w", err)
 	}
@@ -92,14 +159,38 @@ func (r *BikesRepository) Update(ctx context.Context, id string, b bikerental.Bi
 		return app.ErrNotFound
 	}
 
+	// Event is stored after the bike row is locked by update, so events of the bike are ordered like updates.
+	if err := r.parent.Events().AppendInTx(ctx, tx, []bikerental.Event{event}); err != nil {
+		return fmt.Errorf("storing bike event: %w", err)
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
 	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("bike updated in db")
 
 	return nil
 }
 
-// Delete deletes a bike from db by id. If bike is not in db, returns app.ErrNotFound error.
-func (r *BikesRepository) Delete(ctx context.Context, id string) error {
-	res, err := r.db.ExecContext(ctx, `delete from bikes where id=$1`, id)
+// Delete deletes a bike of the tenant from context from db by id and stores the event in outbox in the same transaction.
+// If bike is not in db, returns app.ErrNotFound error.
+func (r *BikesRepository) Delete(ctx context.Context, id string, event bikerental.Event) (err error) {
+	ctx, span := startSpan(ctx, "BikesRepository.Delete")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := beginTenantTx(ctx, r.db, nil)
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	res, err := tx.ExecContext(ctx, `delete from bikes where id=$1 and tenant_id=$2`, id, tenantID)
 	if err != nil {
 		return fmt.Errorf("deleting bike row from postgres: %w", err)
 	}
@@ -108,23 +199,53 @@ func (r *BikesRepository) Delete(ctx context.Context, id string) error {
 		return app.ErrNotFound
 	}
 
+	if err := r.parent.Events().AppendInTx(ctx, tx, []bikerental.Event{event}); err != nil {
+		return fmt.Errorf("storing bike event: %w", err)
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
 	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("bike deleted from db")
 
 	return nil
 }
 
 type bikeModel struct {
-	ID           string  `db:"id"`
-	ModelName    string  `db:"model_name"`
-	Weight       float64 `db:"weight"`
-	PricePerHour int     `db:"price_per_h"`
+	ID           string              `db:"id"`
+	TenantID     string              `db:"tenant_id"`
+	Type         bikerental.BikeType `db:"type"`
+	ModelName    string              `db:"model_name"`
+	Weight       float64             `db:"weight"`
+	PricePerHour int64               `db:"price_per_h"`
+	Currency     string              `db:"currency"`
+	OutOfService bool                `db:"out_of_service"`
+	StationID    string              `db:"station_id"`
 }
 
-func newBikeModel(ab bikerental.Bike) bikeModel {
-	// In this example bike is really simple and can be exactly the same as domain bike type.
-	return bikeModel(ab)
+func newBikeModel(tenantID string, ab bikerental.Bike) bikeModel {
+	return bikeModel{
+		ID:           ab.ID,
+		TenantID:     tenantID,
+		Type:         ab.Type,
+		ModelName:    ab.ModelName,
+		Weight:       ab.Weight,
+		PricePerHour: ab.PricePerHour.Amount,
+		Currency:     string(ab.PricePerHour.Currency),
+		OutOfService: ab.OutOfService,
+		StationID:    ab.StationID,
+	}
 }
 
 func (b *bikeModel) ToAppBike() bikerental.Bike {
-	return bikerental.Bike(*b)
+	return bikerental.Bike{
+		ID:           b.ID,
+		Type:         b.Type,
+		ModelName:    b.ModelName,
+		Weight:       b.Weight,
+		PricePerHour: bikerental.NewMoney(b.PricePerHour, bikerental.Currency(b.Currency)),
+		OutOfService: b.OutOfService,
+		StationID:    b.StationID,
+	}
 }
@@ -0,0 +1,197 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+// BlocklistRepository manages customer blocklist in db.
+type BlocklistRepository struct {
+	db  *sqlx.DB
+	log logrus.FieldLogger
+}
+
+// List returns all blocklist entries of the tenant from context, from the newest.
+func (r *BlocklistRepository) List(ctx context.Context) (_ []bikerental.BlocklistEntry, err error) {
+	ctx, span := startSpan(ctx, "BlocklistRepository.List")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ms []blocklistEntryModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(ctx, &ms, "select * from blocklist where tenant_id=$1 order by created_at desc", tenantID)
+	}); err != nil {
+		return nil, fmt.Errorf("querying for blocklist entries in postgresql: %w", err)
+	}
+
+	result := make([]bikerental.BlocklistEntry, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, m.ToAppBlocklistEntry())
+	}
+	return result, nil
+}
+
+// Find returns the first entry of the tenant from context matching customer id or email domain,
+// or nil if there is none. Empty arguments are not matched.
+func (r *BlocklistRepository) Find(ctx context.Context, customerID, emailDomain string) (_ *bikerental.BlocklistEntry, err error) {
+	ctx, span := startSpan(ctx, "BlocklistRepository.Find")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	or := squirrel.Or{}
+	if customerID != "" {
+		or = append(or, squirrel.Eq{"kind": bikerental.BlocklistEntryKindCustomerID, "value": customerID})
+	}
+	if emailDomain != "" {
+		or = append(or, squirrel.Eq{"kind": bikerental.BlocklistEntryKindEmailDomain, "value": emailDomain})
+	}
+	if len(or) == 0 {
+		return nil, nil
+	}
+
+	q, args, err := sqlBuilder.Select("*").
+		From("blocklist").
+		Where(squirrel.Eq{"tenant_id": tenantID}).
+		Where(or).
+		OrderBy("created_at").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var m blocklistEntryModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.GetContext(ctx, &m, q, args...)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := m.ToAppBlocklistEntry()
+	return &result, nil
+}
+
+// Create creates new blocklist entry of the tenant from context in db.
+// Returns app.ConflictError if the same entry already exists.
+func (r *BlocklistRepository) Create(ctx context.Context, e bikerental.BlocklistEntry) (err error) {
+	ctx, span := startSpan(ctx, "BlocklistRepository.Create")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	sqlq := sqlBuilder.Insert("blocklist").
+		Columns("id", "tenant_id", "kind", "value", "reason", "created_at").
+		Values(
+			squirrel.Expr(":id"),
+			squirrel.Expr(":tenant_id"),
+			squirrel.Expr(":kind"),
+			squirrel.Expr(":value"),
+			squirrel.Expr(":reason"),
+			squirrel.Expr(":created_at"),
+		)
+	q, _, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		_, err := tx.NamedExecContext(ctx, q, newBlocklistEntryModel(tenantID, e))
+		return err
+	}); err != nil {
+		if isPQError(err, pqCodeUniqueViolation) {
+			return app.NewConflictError(fmt.Sprintf("%s '%s' is already blocked", e.Kind, e.Value))
+		}
+		return fmt.Errorf("inserting blocklist row into postgres: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("id", e.ID).
+		WithField("kind", e.Kind).
+		Info("blocklist entry created in db")
+
+	return nil
+}
+
+// Delete deletes blocklist entry of the tenant from context from db.
+// Returns app.ErrNotFound if entry doesn't exist.
+func (r *BlocklistRepository) Delete(ctx context.Context, id string) (err error) {
+	ctx, span := startSpan(ctx, "BlocklistRepository.Delete")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	var rows int64
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		res, err := tx.ExecContext(ctx, `delete from blocklist where id=$1 and tenant_id=$2`, id, tenantID)
+		if err != nil {
+			return err
+		}
+		rows, _ = res.RowsAffected()
+		return nil
+	}); err != nil {
+		return fmt.Errorf("deleting blocklist row from postgres: %w", err)
+	}
+	if rows == 0 {
+		return app.ErrNotFound
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("blocklist entry deleted from db")
+
+	return nil
+}
+
+type blocklistEntryModel struct {
+	ID        string    `db:"id"`
+	TenantID  string    `db:"tenant_id"`
+	Kind      string    `db:"kind"`
+	Value     string    `db:"value"`
+	Reason    string    `db:"reason"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func newBlocklistEntryModel(tenantID string, e bikerental.BlocklistEntry) blocklistEntryModel {
+	return blocklistEntryModel{
+		ID:        e.ID,
+		TenantID:  tenantID,
+		Kind:      string(e.Kind),
+		Value:     e.Value,
+		Reason:    e.Reason,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func (m *blocklistEntryModel) ToAppBlocklistEntry() bikerental.BlocklistEntry {
+	return bikerental.BlocklistEntry{
+		ID:        m.ID,
+		Kind:      bikerental.BlocklistEntryKind(m.Kind),
+		Value:     m.Value,
+		Reason:    m.Reason,
+		CreatedAt: m.CreatedAt,
+	}
+}
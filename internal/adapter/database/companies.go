@@ -0,0 +1,315 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+const companyColumns = "id, name, discount_percent, spending_limit"
+
+// CompaniesRepository manages companies in db.
+type CompaniesRepository struct {
+	db  *sqlx.DB
+	log logrus.FieldLogger
+}
+
+// List returns all companies of the tenant from context sorted by name ascending.
+func (r *CompaniesRepository) List(ctx context.Context) (_ []bikerental.Company, err error) {
+	ctx, span := startSpan(ctx, "CompaniesRepository.List")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ms []companyModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(ctx, &ms, "select "+companyColumns+" from companies where tenant_id=$1 order by name asc", tenantID)
+	}); err != nil {
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := make([]bikerental.Company, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, m.ToAppCompany())
+	}
+	return result, nil
+}
+
+// Get returns a company of the tenant from context by id. If it doesn't exists, returns app.ErrNotFound error.
+func (r *CompaniesRepository) Get(ctx context.Context, id string) (_ *bikerental.Company, err error) {
+	ctx, span := startSpan(ctx, "CompaniesRepository.Get")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var m companyModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.GetContext(ctx, &m, "select "+companyColumns+" from companies where id=$1 and tenant_id=$2", id, tenantID)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, app.ErrNotFound
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := m.ToAppCompany()
+	return &result, nil
+}
+
+// Create creates new company of the tenant from context in db.
+func (r *CompaniesRepository) Create(ctx context.Context, c bikerental.Company) (err error) {
+	ctx, span := startSpan(ctx, "CompaniesRepository.Create")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	sqlq := sqlBuilder.Insert("companies").
+		Columns("id", "tenant_id", "name", "discount_percent", "spending_limit").
+		Values(
+			squirrel.Expr(":id"),
+			squirrel.Expr(":tenant_id"),
+			squirrel.Expr(":name"),
+			squirrel.Expr(":discount_percent"),
+			squirrel.Expr(":spending_limit"),
+		)
+	q, _, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		_, err := tx.NamedExecContext(ctx, q, newCompanyModel(tenantID, c))
+		return err
+	}); err != nil {
+		return fmt.Errorf("inserting company row into postgres: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", c.ID).Info("company created in db")
+
+	return nil
+}
+
+// Update updates a company of the tenant from context in db by id.
+// If company is not in db, returns app.ErrNotFound error.
+func (r *CompaniesRepository) Update(ctx context.Context, id string, c bikerental.Company) (err error) {
+	ctx, span := startSpan(ctx, "CompaniesRepository.Update")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	sqlq := sqlBuilder.Update("companies").
+		Set("name", c.Name).
+		Set("discount_percent", c.DiscountPercent).
+		Set("spending_limit", c.SpendingLimit.Amount).
+		Where(squirrel.Eq{"id": id, "tenant_id": tenantID})
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+
+	var rows int64
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		res, err := tx.ExecContext(ctx, q, args...)
+		if err != nil {
+			return err
+		}
+		rows, _ = res.RowsAffected()
+		return nil
+	}); err != nil {
+		return fmt.Errorf("updating company row in postgres: %w", err)
+	}
+	if rows == 0 {
+		return app.ErrNotFound
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("company updated in db")
+
+	return nil
+}
+
+// ListMembers returns all customers of the tenant from context that are members of the company, sorted by surname.
+func (r *CompaniesRepository) ListMembers(ctx context.Context, companyID string) (_ []bikerental.Customer, err error) {
+	ctx, span := startSpan(ctx, "CompaniesRepository.ListMembers")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ms []customerModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(
+			ctx,
+			&ms,
+			"select * from customers where company_id=$1 and tenant_id=$2 order by surname asc, first_name asc",
+			companyID, tenantID,
+		)
+	}); err != nil {
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := make([]bikerental.Customer, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, m.ToAppCustomer())
+	}
+	return result, nil
+}
+
+// ListMemberReservations returns approved and completed reservations of all company members,
+// starting in given time range: [from, to). Reservations are sorted by start time.
+// Only reservations of the tenant from context are returned.
+func (r *CompaniesRepository) ListMemberReservations(ctx context.Context, companyID string, from, to time.Time) (_ []bikerental.Reservation, err error) {
+	ctx, span := startSpan(ctx, "CompaniesRepository.ListMemberReservations")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q, args, err := selectReservations(tenantID).
+		Where(squirrel.Eq{"c.company_id": companyID}).
+		Where(squirrel.GtOrEq{"r.start_time": from}).
+		Where(squirrel.Lt{"r.start_time": to}).
+		Where(squirrel.Eq{"r.status": []bikerental.ReservationStatus{
+			bikerental.ReservationStatusApproved,
+			bikerental.ReservationStatusCompleted,
+		}}).
+		OrderBy("r.start_time asc").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var rs []reservationModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(ctx, &rs, q, args...)
+	}); err != nil {
+		return nil, fmt.Errorf("querying for reservations in postgresql: %w", err)
+	}
+
+	result := make([]bikerental.Reservation, 0, len(rs))
+	for _, v := range rs {
+		result = append(result, v.ToAppReservation())
+	}
+	return result, nil
+}
+
+// CheckSpendingLimitInTx checks if new reservation fits in company monthly spending limit, using existing transaction.
+// Company row is updated, so concurrent reservations of company members can't exceed the limit together.
+// Value has to be in bikerental.BaseCurrency, same as the limit.
+// Only reservations of the tenant from context are counted.
+// Returns bikerental.ErrCompanySpendingLimitReached if the limit would be exceeded.
+func (r *CompaniesRepository) CheckSpendingLimitInTx(ctx context.Context, tx *sqlx.Tx, companyID string, startTime time.Time, value bikerental.Money) (err error) {
+	ctx, span := startSpan(ctx, "CompaniesRepository.CheckSpendingLimitInTx")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	var limit int64
+	if err := tx.GetContext(
+		ctx,
+		&limit,
+		"update companies set spending_checked_at = now() where id=$1 and tenant_id=$2 returning spending_limit",
+		companyID, tenantID,
+	); err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("company not found: %w", app.ErrNotFound)
+		case isPQError(err, pqCodeSerializationFailure):
+			// Concurrent reservation was made by other company member, we can't be sure the limit is still fine.
+			return fmt.Errorf("%w: concurrent reservation", bikerental.ErrCompanySpendingLimitReached)
+		default:
+			return fmt.Errorf("locking company row in postgres: %w", err)
+		}
+	}
+	if limit == 0 {
+		return nil
+	}
+
+	from, to := bikerental.CompanyStatementPeriod(startTime.UTC().Year(), startTime.UTC().Month())
+	// Values are converted to base currency in the app, so rounding is the same as in statements.
+	var values []reservationValueModel
+	if err := tx.SelectContext(
+		ctx,
+		&values,
+		`select r.total_value, r.currency, r.exchange_rate from reservations r
+		join customers c on r.customer_id = c.id
+		where c.company_id = $1 and r.start_time >= $2 and r.start_time < $3 and r.status in ($4, $5) and r.tenant_id = $6`,
+		companyID, from, to, bikerental.ReservationStatusApproved, bikerental.ReservationStatusCompleted, tenantID,
+	); err != nil {
+		return fmt.Errorf("querying company spending in postgres: %w", err)
+	}
+
+	spent := value
+	for _, v := range values {
+		spent = spent.Add(v.ToAppBaseValue())
+	}
+	if spent.Amount > limit {
+		return bikerental.ErrCompanySpendingLimitReached
+	}
+	return nil
+}
+
+type companyModel struct {
+	ID              string  `db:"id"`
+	TenantID        string  `db:"tenant_id"`
+	Name            string  `db:"name"`
+	DiscountPercent float64 `db:"discount_percent"`
+	SpendingLimit   int64   `db:"spending_limit"`
+}
+
+func newCompanyModel(tenantID string, ac bikerental.Company) companyModel {
+	return companyModel{
+		ID:              ac.ID,
+		TenantID:        tenantID,
+		Name:            ac.Name,
+		DiscountPercent: ac.DiscountPercent,
+		SpendingLimit:   ac.SpendingLimit.Amount,
+	}
+}
+
+func (m *companyModel) ToAppCompany() bikerental.Company {
+	return bikerental.Company{
+		ID:              m.ID,
+		Name:            m.Name,
+		DiscountPercent: m.DiscountPercent,
+		SpendingLimit:   bikerental.NewMoney(m.SpendingLimit, bikerental.BaseCurrency),
+	}
+}
+
+// reservationValueModel is a reservation value with exchange rate, used for spending calculations.
+type reservationValueModel struct {
+	TotalValue   int64   `db:"total_value"`
+	Currency     string  `db:"currency"`
+	ExchangeRate float64 `db:"exchange_rate"`
+}
+
+func (m *reservationValueModel) ToAppBaseValue() bikerental.Money {
+	r := bikerental.Reservation{ExchangeRate: m.ExchangeRate}
+	return r.ToBase(bikerental.NewMoney(m.TotalValue, bikerental.Currency(m.Currency)))
+}
@@ -19,11 +19,19 @@ type CustomersRepository struct {
 	log logrus.FieldLogger
 }
 
-// GetInTx returns a customer by id using existing transaction.
+// GetInTx returns a customer of the tenant from context by id using existing transaction.
 // If customer doesn't exists, returns app.ErrNotFound error.
-func (r *CustomersRepository) GetInTx(ctx context.Context, tx *sqlx.Tx, id string) (*bikerental.Customer, error) {
+func (r *CustomersRepository) GetInTx(ctx context.Context, tx *sqlx.Tx, id string) (_ *bikerental.Customer, err error) {
+	ctx, span := startSpan(ctx, "CustomersRepository.GetInTx")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var m customerModel
-	if err := tx.GetContext(ctx, &m, `select * from customers where id = $1`, id); err != nil {
+	if err := tx.GetContext(ctx, &m, `select * from customers where id = $1 and tenant_id = $2`, id, tenantID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, app.ErrNotFound
 		}
@@ -34,11 +42,14 @@ func (r *CustomersRepository) GetInTx(ctx context.Context, tx *sqlx.Tx, id strin
 	return &result, nil
 }
 
-// Get returns a customer by id. If it doesn't exists, returns app.ErrNotFound error.
-func (r *CustomersRepository) Get(ctx context.Context, id string) (*bikerental.Customer, error) {
-	tx, err := r.db.BeginTxx(ctx, nil)
+// Get returns a customer of the tenant from context by id. If it doesn't exists, returns app.ErrNotFound error.
+func (r *CustomersRepository) Get(ctx context.Context, id string) (_ *bikerental.Customer, err error) {
+	ctx, span := startSpan(ctx, "CustomersRepository.Get")
+	defer func() { endSpan(span, err) }()
+
+	tx, err := beginTenantTx(ctx, r.db, nil)
 	if err != nil {
-		return nil, fmt.Errorf("creating postgresql transaction: %w", err)
+		return nil, err
 	}
 	defer func() {
 		_ = tx.Commit()
@@ -47,23 +58,34 @@ func (r *CustomersRepository) Get(ctx context.Context, id string) (*bikerental.C
 	return r.GetInTx(ctx, tx, id)
 }
 
-// CreateInTx creates new customer in db using existing db transaction.
-func (r *CustomersRepository) CreateInTx(ctx context.Context, tx *sqlx.Tx, c bikerental.Customer) error {
+// CreateInTx creates new customer of the tenant from context in db using existing db transaction.
+func (r *CustomersRepository) CreateInTx(ctx context.Context, tx *sqlx.Tx, c bikerental.Customer) (err error) {
+	ctx, span := startSpan(ctx, "CustomersRepository.CreateInTx")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
 	sqlq := sqlBuilder.Insert("customers").
-		Columns("id", "type", "first_name", "surname", "email").
+		Columns("id", "tenant_id", "type", "first_name", "surname", "email", "company_id", "vat_id").
 		Values(
 			squirrel.Expr(":id"),
+			squirrel.Expr(":tenant_id"),
 			squirrel.Expr(":type"),
 			squirrel.Expr(":first_name"),
 			squirrel.Expr(":surname"),
 			squirrel.Expr(":email"),
+			squirrel.Expr(":company_id"),
+			squirrel.Expr(":vat_id"),
 		)
 	q, _, err := sqlq.ToSql()
 	if err != nil {
 		return fmt.Errorf("building sql query: %w", err)
 	}
 
-	if _, err = tx.NamedExecContext(ctx, q, newCustmerModel(c)); err != nil {
+	if _, err = tx.NamedExecContext(ctx, q, newCustmerModel(tenantID, c)); err != nil {
 		return fmt.Errorf("inserting customer row into postgres: %w", err)
 	}
 
@@ -72,11 +94,14 @@ func (r *CustomersRepository) CreateInTx(ctx context.Context, tx *sqlx.Tx, c bik
 	return nil
 }
 
-// Create creates new customer in db.
-func (r *CustomersRepository) Create(ctx context.Context, c bikerental.Customer) error {
-	tx, err := r.db.BeginTxx(ctx, nil)
+// Create creates new customer of the tenant from context in db.
+func (r *CustomersRepository) Create(ctx context.Context, c bikerental.Customer) (err error) {
+	ctx, span := startSpan(ctx, "CustomersRepository.Create")
+	defer func() { endSpan(span, err) }()
+
+	tx, err := beginTenantTx(ctx, r.db, nil)
 	if err != nil {
-		return fmt.Errorf("creating postgresql transaction: %w", err)
+		return err
 	}
 	defer func() {
 		_ = tx.Commit()
@@ -85,10 +110,63 @@ func (r *CustomersRepository) Create(ctx context.Context, c bikerental.Customer)
 	return r.CreateInTx(ctx, tx, c)
 }
 
-// Delete removes customer from db.
-func (r *CustomersRepository) Delete(ctx context.Context, id string) error {
-	res, err := r.db.ExecContext(ctx, `delete from customers where id=$1`, id)
+// Update updates a customer of the tenant from context in db by id.
+// If customer is not in db, returns app.ErrNotFound error.
+func (r *CustomersRepository) Update(ctx context.Context, id string, c bikerental.Customer) (err error) {
+	ctx, span := startSpan(ctx, "CustomersRepository.Update")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	m := newCustmerModel(tenantID, c)
+	sqlq := sqlBuilder.Update("customers").
+		Set("type", m.Type).
+		Set("first_name", m.FirstName).
+		Set("surname", m.Surname).
+		Set("email", m.Email).
+		Set("company_id", m.CompanyID).
+		Set("vat_id", m.VATID).
+		Where(squirrel.Eq{"id": id, "tenant_id": tenantID})
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+
+	var res sql.Result
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		res, err = tx.ExecContext(ctx, q, args...)
+		return err
+	}); err != nil {
+		return fmt.Errorf("updating customer row in postgres: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return app.ErrNotFound
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("customer updated in db")
+
+	return nil
+}
+
+// Delete removes customer of the tenant from context from db.
+func (r *CustomersRepository) Delete(ctx context.Context, id string) (err error) {
+	ctx, span := startSpan(ctx, "CustomersRepository.Delete")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
 	if err != nil {
+		return err
+	}
+
+	var res sql.Result
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		res, err = tx.ExecContext(ctx, `delete from customers where id=$1 and tenant_id=$2`, id, tenantID)
+		return err
+	}); err != nil {
 		return fmt.Errorf("deleting customer row from postgres: %w", err)
 	}
 	rows, _ := res.RowsAffected()
@@ -103,18 +181,25 @@ func (r *CustomersRepository) Delete(ctx context.Context, id string) error {
 
 type customerModel struct {
 	ID        string `db:"id"`
+	TenantID  string `db:"tenant_id"`
 	Type      string `db:"type"`
 	FirstName string `db:"first_name"`
 	Surname   string `db:"surname"`
 	Email     string `db:"email"`
+
+	CompanyID sql.NullString `db:"company_id"`
+	VATID     sql.NullString `db:"vat_id"`
 }
 
-func newCustmerModel(ac bikerental.Customer) customerModel {
+func newCustmerModel(tenantID string, ac bikerental.Customer) customerModel {
 	c := customerModel{
 		ID:        ac.ID,
+		TenantID:  tenantID,
 		FirstName: ac.FirstName,
 		Surname:   ac.Surname,
 		Email:     ac.Email,
+		CompanyID: sql.NullString{String: ac.CompanyID, Valid: ac.CompanyID != ""},
+		VATID:     sql.NullString{String: ac.VATID, Valid: ac.VATID != ""},
 	}
 	switch ac.Type {
 	case bikerental.CustomerTypeBusiness:
@@ -133,6 +218,8 @@ func (m *customerModel) ToAppCustomer() bikerental.Customer {
 		FirstName: m.FirstName,
 		Surname:   m.Surname,
 		Email:     m.Email,
+		CompanyID: m.CompanyID.String,
+		VATID:     m.VATID.String,
 	}
 	switch m.Type {
 	case customerTypeBusiness:
@@ -0,0 +1,203 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/damage"
+	"github.com/sirupsen/logrus"
+)
+
+// DamageReportsRepository manages damage reports in db.
+type DamageReportsRepository struct {
+	parent *Adapter
+	db     *sqlx.DB
+	log    logrus.FieldLogger
+}
+
+// List returns damage reports of the tenant from context matching query criteria, sorted from the newest.
+func (r *DamageReportsRepository) List(ctx context.Context, query damage.ListReportsQuery) (_ []bikerental.DamageReport, err error) {
+	ctx, span := startSpan(ctx, "DamageReportsRepository.List")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlq := sqlBuilder.Select("*").
+		From("damage_reports").
+		Where(squirrel.Eq{"tenant_id": tenantID}).
+		OrderBy("created_at desc")
+	if query.BikeID != "" {
+		sqlq = sqlq.Where(squirrel.Eq{"bike_id": query.BikeID})
+	}
+	if query.ReservationID != "" {
+		sqlq = sqlq.Where(squirrel.Eq{"reservation_id": query.ReservationID})
+	}
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var ms []damageReportModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(ctx, &ms, q, args...)
+	}); err != nil {
+		return nil, fmt.Errorf("querying for damage reports in postgresql: %w", err)
+	}
+
+	result := make([]bikerental.DamageReport, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, m.ToAppDamageReport())
+	}
+	return result, nil
+}
+
+// Get returns damage report of the tenant from context by id. If it doesn't exists, returns app.ErrNotFound error.
+func (r *DamageReportsRepository) Get(ctx context.Context, id string) (_ *bikerental.DamageReport, err error) {
+	ctx, span := startSpan(ctx, "DamageReportsRepository.Get")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var m damageReportModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.GetContext(ctx, &m, "select * from damage_reports where id=$1 and tenant_id=$2", id, tenantID)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, app.ErrNotFound
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := m.ToAppDamageReport()
+	return &result, nil
+}
+
+// Create creates new damage report of the tenant from context in db.
+// If bike event is given, the bike is put out of service and the event is stored in outbox in the same transaction.
+func (r *DamageReportsRepository) Create(ctx context.Context, report bikerental.DamageReport, outOfServiceEvent *bikerental.Event) (err error) {
+	ctx, span := startSpan(ctx, "DamageReportsRepository.Create")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	sqlq := sqlBuilder.Insert("damage_reports").
+		Columns(
+			"id", "tenant_id", "reservation_id", "bike_id", "description", "severity", "repair_cost_estimate", "currency",
+			"photo_ids", "created_at",
+		).
+		Values(
+			squirrel.Expr(":id"),
+			squirrel.Expr(":tenant_id"),
+			squirrel.Expr(":reservation_id"),
+			squirrel.Expr(":bike_id"),
+			squirrel.Expr(":description"),
+			squirrel.Expr(":severity"),
+			squirrel.Expr(":repair_cost_estimate"),
+			squirrel.Expr(":currency"),
+			squirrel.Expr(":photo_ids"),
+			squirrel.Expr(":created_at"),
+		)
+	q, _, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+
+	tx, err := beginTenantTx(ctx, r.db, nil)
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	if _, err = tx.NamedExecContext(ctx, q, newDamageReportModel(tenantID, report)); err != nil {
+		return fmt.Errorf("inserting damage report row into postgres: %w", err)
+	}
+
+	if outOfServiceEvent != nil {
+		res, err := tx.ExecContext(ctx, "update bikes set out_of_service=true where id=$1 and tenant_id=$2", report.BikeID, tenantID)
+		if err != nil {
+			return fmt.Errorf("updating bike row in postgres: %w", err)
+		}
+		rows, _ := res.RowsAffected()
+		if rows == 0 {
+			return app.ErrNotFound
+		}
+
+		if err := r.parent.Events().AppendInTx(ctx, tx, []bikerental.Event{*outOfServiceEvent}); err != nil {
+			return fmt.Errorf("storing bike event: %w", err)
+		}
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("id", report.ID).
+		WithField("bikeId", report.BikeID).
+		WithField("reservationId", report.ReservationID).
+		Info("damage report created in db")
+
+	return nil
+}
+
+type damageReportModel struct {
+	ID                 string         `db:"id"`
+	TenantID           string         `db:"tenant_id"`
+	ReservationID      string         `db:"reservation_id"`
+	BikeID             string         `db:"bike_id"`
+	Description        string         `db:"description"`
+	Severity           string         `db:"severity"`
+	RepairCostEstimate int64          `db:"repair_cost_estimate"`
+	Currency           string         `db:"currency"`
+	PhotoIDs           pq.StringArray `db:"photo_ids"`
+	CreatedAt          time.Time      `db:"created_at"`
+}
+
+func newDamageReportModel(tenantID string, ar bikerental.DamageReport) damageReportModel {
+	photoIDs := ar.PhotoIDs
+	if photoIDs == nil {
+		photoIDs = []string{}
+	}
+	return damageReportModel{
+		ID:                 ar.ID,
+		TenantID:           tenantID,
+		ReservationID:      ar.ReservationID,
+		BikeID:             ar.BikeID,
+		Description:        ar.Description,
+		Severity:           string(ar.Severity),
+		RepairCostEstimate: ar.RepairCostEstimate.Amount,
+		Currency:           string(ar.RepairCostEstimate.Currency),
+		PhotoIDs:           photoIDs,
+		CreatedAt:          ar.CreatedAt,
+	}
+}
+
+func (m *damageReportModel) ToAppDamageReport() bikerental.DamageReport {
+	return bikerental.DamageReport{
+		ID:                 m.ID,
+		ReservationID:      m.ReservationID,
+		BikeID:             m.BikeID,
+		Description:        m.Description,
+		Severity:           bikerental.DamageSeverity(m.Severity),
+		RepairCostEstimate: bikerental.NewMoney(m.RepairCostEstimate, bikerental.Currency(m.Currency)),
+		PhotoIDs:           m.PhotoIDs,
+		CreatedAt:          m.CreatedAt,
+	}
+}
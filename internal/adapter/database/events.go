@@ -0,0 +1,183 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+// outboxRelayLockID is a key of postgres advisory lock held by the relay publishing events.
+// Only one relay at a time publishes events, so events of an aggregate are not published out of order.
+const outboxRelayLockID = 4040
+
+// EventsRepository manages domain events outbox in db.
+type EventsRepository struct {
+	db  *sqlx.DB
+	log logrus.FieldLogger
+}
+
+// AppendInTx stores events of the tenant from context in outbox using existing transaction.
+// Events have to be appended after the aggregate row is written, so the row lock orders events of one aggregate.
+func (r *EventsRepository) AppendInTx(ctx context.Context, tx *sqlx.Tx, events []bikerental.Event) (err error) {
+	ctx, span := startSpan(ctx, "EventsRepository.AppendInTx")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		if _, err := tx.NamedExecContext(
+			ctx,
+			`insert into outbox (tenant_id, type, aggregate_type, aggregate_id, payload, occurred_at)
+			values (:tenant_id, :type, :aggregate_type, :aggregate_id, :payload, :occurred_at)`,
+			newEventModel(tenantID, e),
+		); err != nil {
+			return fmt.Errorf("inserting outbox row into postgres: %w", err)
+		}
+
+		app.AugmentLogFromCtx(ctx, r.log).
+			WithField("type", e.Type).
+			WithField("aggregateId", e.AggregateID).
+			Info("event stored in outbox")
+	}
+	return nil
+}
+
+// Relay passes unpublished events to publish func in id order and marks published ones.
+// After publish fails, later events of the same aggregate are not passed in this call, so events are published in order.
+// Events are published at least once: they can be published again if marking them fails.
+// Returns number of published events. Returns 0 if other relay is running at the same time.
+//
+// Events are published outside of transaction, so slow publishers don't keep it open.
+// Only one relay runs at a time, thanks to session advisory lock held on a dedicated connection.
+func (r *EventsRepository) Relay(
+	ctx context.Context,
+	limit int,
+	publish func(context.Context, bikerental.Event) error,
+) (_ int, err error) {
+	ctx, span := startSpan(ctx, "EventsRepository.Relay")
+	defer func() { endSpan(span, err) }()
+
+	conn, err := r.db.Connx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("getting postgresql connection: %w", err)
+	}
+	defer conn.Close()
+
+	var locked bool
+	if err := conn.GetContext(ctx, &locked, "select pg_try_advisory_lock($1)", outboxRelayLockID); err != nil {
+		return 0, fmt.Errorf("acquiring relay lock in postgres: %w", err)
+	}
+	if !locked {
+		return 0, nil
+	}
+	defer r.unlockRelay(ctx, conn)
+
+	// Relay publishes events of all tenants.
+	var ms []eventModel
+	if err := inAllTenantsTx(ctx, conn, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(
+			ctx,
+			&ms,
+			"select * from outbox where published_at is null order by id asc limit $1",
+			limit,
+		)
+	}); err != nil {
+		return 0, fmt.Errorf("querying for unpublished events in postgresql: %w", err)
+	}
+
+	published := 0
+	blocked := make(map[string]bool)
+	for _, m := range ms {
+		aggregate := m.AggregateType + "/" + m.AggregateID
+		if blocked[aggregate] {
+			continue
+		}
+
+		if publishErr := publish(ctx, m.ToAppEvent()); publishErr != nil {
+			blocked[aggregate] = true
+			if err := r.markInConn(
+				ctx, conn,
+				"update outbox set attempts = attempts + 1, last_error = $2 where id = $1",
+				m.ID, publishErr.Error(),
+			); err != nil {
+				return published, err
+			}
+			continue
+		}
+
+		if err := r.markInConn(ctx, conn, "update outbox set published_at = $2 where id = $1", m.ID, time.Now()); err != nil {
+			return published, err
+		}
+		published++
+	}
+	return published, nil
+}
+
+// markInConn updates outbox row of any tenant using relay connection.
+func (r *EventsRepository) markInConn(ctx context.Context, conn *sqlx.Conn, q string, args ...interface{}) error {
+	if err := inAllTenantsTx(ctx, conn, r.log, func(tx *sqlx.Tx) error {
+		_, err := tx.ExecContext(ctx, q, args...)
+		return err
+	}); err != nil {
+		return fmt.Errorf("updating outbox row in postgres: %w", err)
+	}
+	return nil
+}
+
+// unlockRelay releases relay lock. If it fails, the connection is closed instead of returning to the pool,
+// because session lock is released only with the session.
+func (r *EventsRepository) unlockRelay(ctx context.Context, conn *sqlx.Conn) {
+	// Lock has to be released even if relay was cancelled.
+	ctx = app.DetachedCtx(ctx)
+	if _, err := conn.ExecContext(ctx, "select pg_advisory_unlock($1)", outboxRelayLockID); err != nil {
+		app.AugmentLogFromCtx(ctx, r.log).Errorf("releasing relay lock in postgres: %v", err)
+		_ = conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+	}
+}
+
+type eventModel struct {
+	ID            int64        `db:"id"`
+	TenantID      string       `db:"tenant_id"`
+	Type          string       `db:"type"`
+	AggregateType string       `db:"aggregate_type"`
+	AggregateID   string       `db:"aggregate_id"`
+	Payload       []byte       `db:"payload"`
+	OccurredAt    time.Time    `db:"occurred_at"`
+	PublishedAt   sql.NullTime `db:"published_at"`
+	Attempts      int          `db:"attempts"`
+	LastError     string       `db:"last_error"`
+}
+
+func newEventModel(tenantID string, e bikerental.Event) eventModel {
+	return eventModel{
+		ID:            e.ID,
+		TenantID:      tenantID,
+		Type:          string(e.Type),
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID,
+		Payload:       e.Payload,
+		OccurredAt:    e.OccurredAt,
+	}
+}
+
+func (m *eventModel) ToAppEvent() bikerental.Event {
+	return bikerental.Event{
+		ID:            m.ID,
+		TenantID:      m.TenantID,
+		Type:          bikerental.EventType(m.Type),
+		AggregateType: m.AggregateType,
+		AggregateID:   m.AggregateID,
+		Payload:       m.Payload,
+		OccurredAt:    m.OccurredAt,
+	}
+}
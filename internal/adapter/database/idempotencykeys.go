@@ -0,0 +1,167 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+// IdempotencyKeysRepository stores calls with idempotency keys in db.
+type IdempotencyKeysRepository struct {
+	db  *sqlx.DB
+	log logrus.FieldLogger
+}
+
+// Reserve creates record of a running call, replacing expired record of the key.
+// Returns existing record if the key is already used, or nil if the record was created.
+func (r *IdempotencyKeysRepository) Reserve(ctx context.Context, rec bikerental.IdempotencyRecord) (_ *bikerental.IdempotencyRecord, err error) {
+	ctx, span := startSpan(ctx, "IdempotencyKeysRepository.Reserve")
+	defer func() { endSpan(span, err) }()
+
+	m := newIdempotencyRecordModel(rec)
+	res, err := r.db.NamedExecContext(
+		ctx,
+		`insert into idempotency_keys (tenant_id, client, key, method, request_hash, response, created_at, completed_at, expires_at)
+		values (:tenant_id, :client, :key, :method, :request_hash, :response, :created_at, :completed_at, :expires_at)
+		on conflict (tenant_id, client, key) do update set
+			method=excluded.method,
+			request_hash=excluded.request_hash,
+			response=excluded.response,
+			created_at=excluded.created_at,
+			completed_at=excluded.completed_at,
+			expires_at=excluded.expires_at
+		where idempotency_keys.expires_at <= excluded.created_at`,
+		m,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("inserting idempotency key row into postgres: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("checking affected rows: %w", err)
+	}
+	if n > 0 {
+		return nil, nil
+	}
+
+	var existing idempotencyRecordModel
+	if err := r.db.GetContext(
+		ctx,
+		&existing,
+		"select * from idempotency_keys where tenant_id=$1 and client=$2 and key=$3",
+		rec.TenantID, rec.Client, rec.Key,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Key was released by a failed call in the meantime.
+			return nil, app.NewConflictError("call with the same idempotency key has just failed, retry it")
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := existing.ToAppIdempotencyRecord()
+	return &result, nil
+}
+
+// Complete stores response, completion time and new expiry time of the call.
+// Returns app.ErrNotFound if the record was replaced or removed in the meantime.
+func (r *IdempotencyKeysRepository) Complete(ctx context.Context, rec bikerental.IdempotencyRecord) (err error) {
+	ctx, span := startSpan(ctx, "IdempotencyKeysRepository.Complete")
+	defer func() { endSpan(span, err) }()
+
+	res, err := r.db.NamedExecContext(
+		ctx,
+		`update idempotency_keys set response=:response, completed_at=:completed_at, expires_at=:expires_at
+		where tenant_id=:tenant_id and client=:client and key=:key and created_at=:created_at`,
+		newIdempotencyRecordModel(rec),
+	)
+	if err != nil {
+		return fmt.Errorf("updating idempotency key row in postgres: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking affected rows: %w", err)
+	}
+	if n == 0 {
+		return app.ErrNotFound
+	}
+	return nil
+}
+
+// Release removes record of a running call, so it can be run again.
+func (r *IdempotencyKeysRepository) Release(ctx context.Context, rec bikerental.IdempotencyRecord) (err error) {
+	ctx, span := startSpan(ctx, "IdempotencyKeysRepository.Release")
+	defer func() { endSpan(span, err) }()
+
+	if _, err := r.db.ExecContext(
+		ctx,
+		`delete from idempotency_keys
+		where tenant_id=$1 and client=$2 and key=$3 and created_at=$4 and completed_at is null`,
+		rec.TenantID, rec.Client, rec.Key, rec.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("deleting idempotency key row from postgres: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired removes records expired before given time, and returns number of removed records.
+func (r *IdempotencyKeysRepository) DeleteExpired(ctx context.Context, now time.Time) (_ int, err error) {
+	ctx, span := startSpan(ctx, "IdempotencyKeysRepository.DeleteExpired")
+	defer func() { endSpan(span, err) }()
+
+	res, err := r.db.ExecContext(ctx, "delete from idempotency_keys where expires_at <= $1", now)
+	if err != nil {
+		return 0, fmt.Errorf("deleting expired idempotency key rows from postgres: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("checking affected rows: %w", err)
+	}
+	return int(n), nil
+}
+
+type idempotencyRecordModel struct {
+	TenantID    string       `db:"tenant_id"`
+	Client      string       `db:"client"`
+	Key         string       `db:"key"`
+	Method      string       `db:"method"`
+	RequestHash string       `db:"request_hash"`
+	Response    []byte       `db:"response"`
+	CreatedAt   time.Time    `db:"created_at"`
+	CompletedAt sql.NullTime `db:"completed_at"`
+	ExpiresAt   time.Time    `db:"expires_at"`
+}
+
+func newIdempotencyRecordModel(r bikerental.IdempotencyRecord) idempotencyRecordModel {
+	return idempotencyRecordModel{
+		TenantID:    r.TenantID,
+		Client:      r.Client,
+		Key:         r.Key,
+		Method:      r.Method,
+		RequestHash: r.RequestHash,
+		Response:    r.Response,
+		CreatedAt:   r.CreatedAt,
+		CompletedAt: sql.NullTime{Time: r.CompletedAt, Valid: !r.CompletedAt.IsZero()},
+		ExpiresAt:   r.ExpiresAt,
+	}
+}
+
+func (m *idempotencyRecordModel) ToAppIdempotencyRecord() bikerental.IdempotencyRecord {
+	return bikerental.IdempotencyRecord{
+		TenantID:    m.TenantID,
+		Client:      m.Client,
+		Key:         m.Key,
+		Method:      m.Method,
+		RequestHash: m.RequestHash,
+		Response:    m.Response,
+		CreatedAt:   m.CreatedAt,
+		CompletedAt: m.CompletedAt.Time,
+		ExpiresAt:   m.ExpiresAt,
+	}
+}
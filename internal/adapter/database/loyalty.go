@@ -0,0 +1,196 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+// LoyaltyRepository manages loyalty ledger in db.
+// Ledger rows are never updated nor deleted. Account rows keep running totals of the ledger.
+type LoyaltyRepository struct {
+	db  *sqlx.DB
+	log logrus.FieldLogger
+}
+
+// GetAccount returns loyalty account summary of a customer of the tenant from context.
+// Returns empty account if customer has no ledger entries.
+func (r *LoyaltyRepository) GetAccount(ctx context.Context, customerID string) (_ *bikerental.LoyaltyAccount, err error) {
+	ctx, span := startSpan(ctx, "LoyaltyRepository.GetAccount")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var m loyaltyAccountModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.GetContext(ctx, &m, "select * from loyalty_accounts where customer_id=$1 and tenant_id=$2", customerID, tenantID)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &bikerental.LoyaltyAccount{CustomerID: customerID}, nil
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := m.ToAppLoyaltyAccount()
+	return &result, nil
+}
+
+// ListEntries returns all ledger entries of a customer of the tenant from context, from the oldest.
+func (r *LoyaltyRepository) ListEntries(ctx context.Context, customerID string) (_ []bikerental.LoyaltyEntry, err error) {
+	ctx, span := startSpan(ctx, "LoyaltyRepository.ListEntries")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ms []loyaltyEntryModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(
+			ctx,
+			&ms,
+			"select * from loyalty_ledger where customer_id=$1 and tenant_id=$2 order by created_at asc",
+			customerID, tenantID,
+		)
+	}); err != nil {
+		return nil, fmt.Errorf("querying for loyalty ledger in postgresql: %w", err)
+	}
+
+	result := make([]bikerental.LoyaltyEntry, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, m.ToAppLoyaltyEntry())
+	}
+	return result, nil
+}
+
+// AppendInTx appends entries of the tenant from context to the ledger and updates account totals
+// using existing transaction.
+// Redemptions can't make balance negative, in that case bikerental.ErrLoyaltyPointsNotRedeemable is returned.
+func (r *LoyaltyRepository) AppendInTx(ctx context.Context, tx *sqlx.Tx, entries []bikerental.LoyaltyEntry) (err error) {
+	ctx, span := startSpan(ctx, "LoyaltyRepository.AppendInTx")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if err := r.updateAccount(ctx, tx, tenantID, e); err != nil {
+			return err
+		}
+
+		if _, err := tx.NamedExecContext(
+			ctx,
+			`insert into loyalty_ledger (id, tenant_id, customer_id, reservation_id, kind, points, created_at)
+			values (:id, :tenant_id, :customer_id, :reservation_id, :kind, :points, :created_at)`,
+			newLoyaltyEntryModel(tenantID, e),
+		); err != nil {
+			return fmt.Errorf("inserting loyalty ledger row into postgres: %w", err)
+		}
+
+		app.AugmentLogFromCtx(ctx, r.log).
+			WithField("customerId", e.CustomerID).
+			WithField("reservationId", e.ReservationID).
+			WithField("kind", e.Kind).
+			WithField("points", e.Points).
+			Info("loyalty ledger entry appended in db")
+	}
+	return nil
+}
+
+func (r *LoyaltyRepository) updateAccount(ctx context.Context, tx *sqlx.Tx, tenantID string, e bikerental.LoyaltyEntry) error {
+	if e.Kind == bikerental.LoyaltyEntryKindRedemption {
+		res, err := tx.ExecContext(
+			ctx,
+			"update loyalty_accounts set balance = balance + $2 where customer_id=$1 and tenant_id=$3 and balance + $2 >= 0",
+			e.CustomerID, e.Points, tenantID,
+		)
+		if err != nil {
+			if isPQError(err, pqCodeSerializationFailure) {
+				// Points were changed by concurrent transaction, we can't be sure the balance is still fine.
+				return fmt.Errorf("%w: concurrent update", bikerental.ErrLoyaltyPointsNotRedeemable)
+			}
+			return fmt.Errorf("updating loyalty account in postgres: %w", err)
+		}
+		if rows, _ := res.RowsAffected(); rows == 0 {
+			return bikerental.ErrLoyaltyPointsNotRedeemable
+		}
+		return nil
+	}
+
+	earned := 0
+	if e.Kind.IsEarned() {
+		earned = e.Points
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		`insert into loyalty_accounts (customer_id, tenant_id, balance, earned_points) values ($1, $2, $3, $4)
+		on conflict (customer_id) do update set
+			balance = loyalty_accounts.balance + excluded.balance,
+			earned_points = loyalty_accounts.earned_points + excluded.earned_points`,
+		e.CustomerID, tenantID, e.Points, earned,
+	); err != nil {
+		return fmt.Errorf("updating loyalty account in postgres: %w", err)
+	}
+	return nil
+}
+
+type loyaltyAccountModel struct {
+	CustomerID   string `db:"customer_id"`
+	TenantID     string `db:"tenant_id"`
+	Balance      int    `db:"balance"`
+	EarnedPoints int    `db:"earned_points"`
+}
+
+func (m *loyaltyAccountModel) ToAppLoyaltyAccount() bikerental.LoyaltyAccount {
+	return bikerental.LoyaltyAccount{
+		CustomerID:   m.CustomerID,
+		Balance:      m.Balance,
+		EarnedPoints: m.EarnedPoints,
+	}
+}
+
+type loyaltyEntryModel struct {
+	ID            string    `db:"id"`
+	TenantID      string    `db:"tenant_id"`
+	CustomerID    string    `db:"customer_id"`
+	ReservationID string    `db:"reservation_id"`
+	Kind          string    `db:"kind"`
+	Points        int       `db:"points"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+func newLoyaltyEntryModel(tenantID string, ae bikerental.LoyaltyEntry) loyaltyEntryModel {
+	return loyaltyEntryModel{
+		ID:            ae.ID,
+		TenantID:      tenantID,
+		CustomerID:    ae.CustomerID,
+		ReservationID: ae.ReservationID,
+		Kind:          string(ae.Kind),
+		Points:        ae.Points,
+		CreatedAt:     ae.CreatedAt,
+	}
+}
+
+func (m *loyaltyEntryModel) ToAppLoyaltyEntry() bikerental.LoyaltyEntry {
+	return bikerental.LoyaltyEntry{
+		ID:            m.ID,
+		CustomerID:    m.CustomerID,
+		ReservationID: m.ReservationID,
+		Kind:          bikerental.LoyaltyEntryKind(m.Kind),
+		Points:        m.Points,
+		CreatedAt:     m.CreatedAt,
+	}
+}
@@ -0,0 +1,12 @@
+package database
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// StatsCollector returns prometheus collector of connection pool stats, e.g. open and idle connections.
+// Stats are read from the pool when metrics are collected.
+func (a *Adapter) StatsCollector() prometheus.Collector {
+	return collectors.NewDBStatsCollector(a.db.DB, a.dbName)
+}
@@ -0,0 +1,162 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+// NotificationsRepository manages notifications outbox in db.
+type NotificationsRepository struct {
+	db  *sqlx.DB
+	log logrus.FieldLogger
+}
+
+// EnqueueInTx stores notifications of the tenant from context in outbox using existing transaction.
+func (r *NotificationsRepository) EnqueueInTx(ctx context.Context, tx *sqlx.Tx, notifications []bikerental.Notification) (err error) {
+	ctx, span := startSpan(ctx, "NotificationsRepository.EnqueueInTx")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range notifications {
+		if _, err := tx.NamedExecContext(
+			ctx,
+			`insert into notifications (
+				id, tenant_id, type, reservation_id, recipient, send_at, status, attempts, last_error, created_at
+			) values (
+				:id, :tenant_id, :type, :reservation_id, :recipient, :send_at, :status, :attempts, :last_error, :created_at
+			)`,
+			newNotificationModel(tenantID, n),
+		); err != nil {
+			return fmt.Errorf("inserting notification row into postgres: %w", err)
+		}
+
+		app.AugmentLogFromCtx(ctx, r.log).
+			WithField("id", n.ID).
+			WithField("reservationId", n.ReservationID).
+			WithField("type", n.Type).
+			Info("notification enqueued in db")
+	}
+	return nil
+}
+
+// ClaimDue returns pending notifications of all tenants due at given time and locks them for lease duration,
+// so concurrent workers don't send them twice.
+// Notifications not updated before the lease expires are returned again.
+func (r *NotificationsRepository) ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) (_ []bikerental.Notification, err error) {
+	ctx, span := startSpan(ctx, "NotificationsRepository.ClaimDue")
+	defer func() { endSpan(span, err) }()
+
+	var ms []notificationModel
+	if err := inAllTenantsTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(
+			ctx,
+			&ms,
+			`update notifications set locked_until = $2
+			where id in (
+				select id from notifications
+				where status = 'pending' and send_at <= $1 and (locked_until is null or locked_until <= $1)
+				order by send_at
+				limit $3
+				for update skip locked
+			)
+			returning *`,
+			now, now.Add(lease), limit,
+		)
+	}); err != nil {
+		return nil, fmt.Errorf("claiming notifications in postgres: %w", err)
+	}
+
+	result := make([]bikerental.Notification, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, m.ToAppNotification())
+	}
+	return result, nil
+}
+
+// Update stores status, schedule and attempts of a notification of the tenant from context and releases its lock.
+// Returns app.ErrNotFound if notification doesn't exist.
+func (r *NotificationsRepository) Update(ctx context.Context, n bikerental.Notification) (err error) {
+	ctx, span := startSpan(ctx, "NotificationsRepository.Update")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	var rows int64
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		res, err := tx.NamedExecContext(
+			ctx,
+			`update notifications set status=:status, send_at=:send_at, attempts=:attempts, last_error=:last_error, locked_until=null
+			where id=:id and tenant_id=:tenant_id`,
+			newNotificationModel(tenantID, n),
+		)
+		if err != nil {
+			return err
+		}
+		rows, _ = res.RowsAffected()
+		return nil
+	}); err != nil {
+		return fmt.Errorf("updating notification in postgres: %w", err)
+	}
+	if rows == 0 {
+		return app.ErrNotFound
+	}
+	return nil
+}
+
+type notificationModel struct {
+	ID            string       `db:"id"`
+	TenantID      string       `db:"tenant_id"`
+	Type          string       `db:"type"`
+	ReservationID string       `db:"reservation_id"`
+	Recipient     string       `db:"recipient"`
+	SendAt        time.Time    `db:"send_at"`
+	Status        string       `db:"status"`
+	Attempts      int          `db:"attempts"`
+	LastError     string       `db:"last_error"`
+	LockedUntil   sql.NullTime `db:"locked_until"`
+	CreatedAt     time.Time    `db:"created_at"`
+}
+
+func newNotificationModel(tenantID string, n bikerental.Notification) notificationModel {
+	return notificationModel{
+		ID:            n.ID,
+		TenantID:      tenantID,
+		Type:          string(n.Type),
+		ReservationID: n.ReservationID,
+		Recipient:     n.Recipient,
+		SendAt:        n.SendAt,
+		Status:        string(n.Status),
+		Attempts:      n.Attempts,
+		LastError:     n.LastError,
+		CreatedAt:     n.CreatedAt,
+	}
+}
+
+func (m *notificationModel) ToAppNotification() bikerental.Notification {
+	return bikerental.Notification{
+		ID:            m.ID,
+		Type:          bikerental.NotificationType(m.Type),
+		ReservationID: m.ReservationID,
+		TenantID:      m.TenantID,
+		Recipient:     m.Recipient,
+		SendAt:        m.SendAt,
+		Status:        bikerental.NotificationStatus(m.Status),
+		Attempts:      m.Attempts,
+		LastError:     m.LastError,
+		CreatedAt:     m.CreatedAt,
+	}
+}
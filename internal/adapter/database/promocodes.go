@@ -0,0 +1,277 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+// Postgres error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html.
+const (
+	pqCodeUniqueViolation      = "23505"
+	pqCodeSerializationFailure = "40001"
+)
+
+// PromoCodesRepository manages promo codes in db.
+type PromoCodesRepository struct {
+	db  *sqlx.DB
+	log logrus.FieldLogger
+}
+
+// List returns all promo codes of the tenant from context, from the newest.
+func (r *PromoCodesRepository) List(ctx context.Context) (_ []bikerental.PromoCode, err error) {
+	ctx, span := startSpan(ctx, "PromoCodesRepository.List")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ms []promoCodeModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(ctx, &ms, "select * from promo_codes where tenant_id=$1 order by created_at desc", tenantID)
+	}); err != nil {
+		return nil, fmt.Errorf("querying for promo codes in postgresql: %w", err)
+	}
+
+	result := make([]bikerental.PromoCode, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, m.ToAppPromoCode())
+	}
+	return result, nil
+}
+
+// GetPromoCode returns promo code of the tenant from context. If it doesn't exists, returns app.ErrNotFound error.
+func (r *PromoCodesRepository) GetPromoCode(ctx context.Context, code string) (_ *bikerental.PromoCode, err error) {
+	ctx, span := startSpan(ctx, "PromoCodesRepository.GetPromoCode")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var m promoCodeModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.GetContext(ctx, &m, "select * from promo_codes where code=$1 and tenant_id=$2", code, tenantID)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, app.ErrNotFound
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := m.ToAppPromoCode()
+	return &result, nil
+}
+
+// Create creates new promo code of the tenant from context in db. Codes are unique per tenant.
+// Returns app.ConflictError if code already exists.
+func (r *PromoCodesRepository) Create(ctx context.Context, p bikerental.PromoCode) (err error) {
+	ctx, span := startSpan(ctx, "PromoCodesRepository.Create")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	sqlq := sqlBuilder.Insert("promo_codes").
+		Columns(
+			"code", "tenant_id", "type", "percent", "amount", "currency", "expires_at", "max_redemptions",
+			"max_redemptions_per_customer", "customer_type", "bike_model_name", "stackable", "redemptions", "created_at",
+		).
+		Values(
+			squirrel.Expr(":code"),
+			squirrel.Expr(":tenant_id"),
+			squirrel.Expr(":type"),
+			squirrel.Expr(":percent"),
+			squirrel.Expr(":amount"),
+			squirrel.Expr(":currency"),
+			squirrel.Expr(":expires_at"),
+			squirrel.Expr(":max_redemptions"),
+			squirrel.Expr(":max_redemptions_per_customer"),
+			squirrel.Expr(":customer_type"),
+			squirrel.Expr(":bike_model_name"),
+			squirrel.Expr(":stackable"),
+			squirrel.Expr(":redemptions"),
+			squirrel.Expr(":created_at"),
+		)
+	q, _, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		_, err := tx.NamedExecContext(ctx, q, newPromoCodeModel(tenantID, p))
+		return err
+	}); err != nil {
+		if isPQError(err, pqCodeUniqueViolation) {
+			return app.NewConflictError(fmt.Sprintf("promo code '%s' already exists", p.Code))
+		}
+		return fmt.Errorf("inserting promo code row into postgres: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("code", p.Code).Info("promo code created in db")
+
+	return nil
+}
+
+// RedeemInTx redeems promo code of the tenant from context for a reservation using existing transaction.
+// Promo code row is locked until the end of the transaction, so concurrent redemptions can't exceed limits.
+// Expiration is checked again too, because the code could have expired since reservation was priced.
+// Returns bikerental.ErrPromoCodeNotRedeemable if code can't be redeemed.
+func (r *PromoCodesRepository) RedeemInTx(ctx context.Context, tx *sqlx.Tx, code, customerID, reservationID string) (err error) {
+	ctx, span := startSpan(ctx, "PromoCodesRepository.RedeemInTx")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	var m promoCodeModel
+	if err := tx.GetContext(ctx, &m, "select * from promo_codes where code=$1 and tenant_id=$2 for update", code, tenantID); err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("%w: code doesn't exist", bikerental.ErrPromoCodeNotRedeemable)
+		case isPQError(err, pqCodeSerializationFailure):
+			// Code was redeemed by concurrent transaction, we can't be sure the limits are still fine.
+			return fmt.Errorf("%w: concurrent redemption", bikerental.ErrPromoCodeNotRedeemable)
+		default:
+			return fmt.Errorf("locking promo code row in postgres: %w", err)
+		}
+	}
+
+	// Reservation is stored now, so the code has to be valid now, as checked by PromoCode.CheckEligibility.
+	now := time.Now()
+	if m.ExpiresAt.Valid && !now.Before(m.ExpiresAt.Time) {
+		return fmt.Errorf("%w: code has expired", bikerental.ErrPromoCodeNotRedeemable)
+	}
+	if m.MaxRedemptions > 0 && m.Redemptions >= m.MaxRedemptions {
+		return fmt.Errorf("%w: redemption limit reached", bikerental.ErrPromoCodeNotRedeemable)
+	}
+	if m.MaxRedemptionsPerCustomer > 0 {
+		var count int
+		if err := tx.GetContext(
+			ctx,
+			&count,
+			"select count(*) from promo_code_redemptions where code=$1 and customer_id=$2 and tenant_id=$3",
+			code, customerID, tenantID,
+		); err != nil {
+			return fmt.Errorf("counting customer redemptions in postgres: %w", err)
+		}
+		if count >= m.MaxRedemptionsPerCustomer {
+			return fmt.Errorf("%w: customer redemption limit reached", bikerental.ErrPromoCodeNotRedeemable)
+		}
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		"update promo_codes set redemptions = redemptions + 1 where code=$1 and tenant_id=$2",
+		code, tenantID,
+	); err != nil {
+		return fmt.Errorf("updating promo code redemptions in postgres: %w", err)
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		`insert into promo_code_redemptions (reservation_id, tenant_id, code, customer_id, created_at)
+		values ($1, $2, $3, $4, $5)`,
+		reservationID, tenantID, code, customerID, now,
+	); err != nil {
+		return fmt.Errorf("inserting promo code redemption row into postgres: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("code", code).
+		WithField("reservationId", reservationID).
+		Info("promo code redeemed in db")
+
+	return nil
+}
+
+func isPQError(err error, code pq.ErrorCode) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == code
+}
+
+type promoCodeModel struct {
+	Code                      string         `db:"code"`
+	TenantID                  string         `db:"tenant_id"`
+	Type                      string         `db:"type"`
+	Percent                   float64        `db:"percent"`
+	Amount                    int64          `db:"amount"`
+	Currency                  string         `db:"currency"`
+	ExpiresAt                 sql.NullTime   `db:"expires_at"`
+	MaxRedemptions            int            `db:"max_redemptions"`
+	MaxRedemptionsPerCustomer int            `db:"max_redemptions_per_customer"`
+	CustomerType              sql.NullString `db:"customer_type"`
+	BikeModelName             string         `db:"bike_model_name"`
+	Stackable                 bool           `db:"stackable"`
+	Redemptions               int            `db:"redemptions"`
+	CreatedAt                 time.Time      `db:"created_at"`
+}
+
+func newPromoCodeModel(tenantID string, ap bikerental.PromoCode) promoCodeModel {
+	m := promoCodeModel{
+		Code:                      ap.Code,
+		TenantID:                  tenantID,
+		Type:                      string(ap.Type),
+		Percent:                   ap.Percent,
+		Amount:                    ap.Amount.Amount,
+		Currency:                  string(ap.Amount.Currency),
+		ExpiresAt:                 sql.NullTime{Time: ap.ExpiresAt, Valid: !ap.ExpiresAt.IsZero()},
+		MaxRedemptions:            ap.MaxRedemptions,
+		MaxRedemptionsPerCustomer: ap.MaxRedemptionsPerCustomer,
+		BikeModelName:             ap.BikeModelName,
+		Stackable:                 ap.Stackable,
+		Redemptions:               ap.Redemptions,
+		CreatedAt:                 ap.CreatedAt,
+	}
+	// Percentage codes have no amount, but currency column is required.
+	if m.Currency == "" {
+		m.Currency = string(bikerental.BaseCurrency)
+	}
+	switch ap.CustomerType {
+	case bikerental.CustomerTypeBusiness:
+		m.CustomerType = sql.NullString{String: customerTypeBusiness, Valid: true}
+	case bikerental.CustomerTypeIndividual:
+		m.CustomerType = sql.NullString{String: customerTypeIndividual, Valid: true}
+	}
+	return m
+}
+
+func (m *promoCodeModel) ToAppPromoCode() bikerental.PromoCode {
+	p := bikerental.PromoCode{
+		Code:                      m.Code,
+		Type:                      bikerental.PromoCodeType(m.Type),
+		Percent:                   m.Percent,
+		Amount:                    bikerental.NewMoney(m.Amount, bikerental.Currency(m.Currency)),
+		MaxRedemptions:            m.MaxRedemptions,
+		MaxRedemptionsPerCustomer: m.MaxRedemptionsPerCustomer,
+		BikeModelName:             m.BikeModelName,
+		Stackable:                 m.Stackable,
+		Redemptions:               m.Redemptions,
+		CreatedAt:                 m.CreatedAt,
+	}
+	if m.ExpiresAt.Valid {
+		p.ExpiresAt = m.ExpiresAt.Time
+	}
+	switch m.CustomerType.String {
+	case customerTypeBusiness:
+		p.CustomerType = bikerental.CustomerTypeBusiness
+	case customerTypeIndividual:
+		p.CustomerType = bikerental.CustomerTypeIndividual
+	}
+	return p
+}
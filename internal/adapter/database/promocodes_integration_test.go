@@ -0,0 +1,51 @@
+//go:build integration
+// +build integration
+
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+func TestPromoCodesRedeemInTxExpired(t *testing.T) {
+	a := newTestAdapter(t)
+	f := newTenantFixture(t, a)
+	repo := a.PromoCodes()
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		wantErr   error
+	}{
+		{name: "valid code", expiresAt: time.Now().Add(time.Hour)},
+		{name: "expired code", expiresAt: time.Now().Add(-time.Minute), wantErr: bikerental.ErrPromoCodeNotRedeemable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Code could expire after reservation was priced, so expiration is checked when it's redeemed.
+			code := bikerental.PromoCode{
+				Code:      "SPRING-" + uuid.NewString(),
+				Type:      bikerental.PromoCodeTypePercentage,
+				Percent:   10,
+				ExpiresAt: tt.expiresAt,
+				CreatedAt: time.Now().Add(-time.Hour),
+			}
+			if err := repo.Create(f.ctx, code); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			err := inTenantTx(f.ctx, a.db, a.log, func(tx *sqlx.Tx) error {
+				return repo.RedeemInTx(f.ctx, tx, code.Code, f.customerID, f.reservationID)
+			})
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("RedeemInTx() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+// RateLimitsRepository keeps token buckets and quota counters in db, so limits are shared by all app instances.
+type RateLimitsRepository struct {
+	db  *sqlx.DB
+	log logrus.FieldLogger
+}
+
+// Take takes one token from the bucket of the key.
+// Bucket row is locked, so concurrent calls of the client are counted one by one.
+func (r *RateLimitsRepository) Take(ctx context.Context, key string, limit bikerental.RateLimit, now time.Time) (_ bikerental.RateLimitDecision, err error) {
+	ctx, span := startSpan(ctx, "RateLimitsRepository.Take")
+	defer func() { endSpan(span, err) }()
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return bikerental.RateLimitDecision{}, fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	full := bikerental.NewTokenBucket(limit, now)
+	if _, err := tx.ExecContext(
+		ctx,
+		"insert into rate_limit_buckets (key, tokens, updated_at) values ($1, $2, $3) on conflict (key) do nothing",
+		key, full.Tokens, full.UpdatedAt,
+	); err != nil {
+		return bikerental.RateLimitDecision{}, fmt.Errorf("inserting rate limit bucket into postgres: %w", err)
+	}
+
+	var m tokenBucketModel
+	if err := tx.GetContext(ctx, &m, "select * from rate_limit_buckets where key=$1 for update", key); err != nil {
+		return bikerental.RateLimitDecision{}, fmt.Errorf("locking rate limit bucket in postgres: %w", err)
+	}
+
+	b, decision := m.ToAppTokenBucket().Take(limit, now)
+	if _, err := tx.ExecContext(
+		ctx,
+		"update rate_limit_buckets set tokens=$2, updated_at=$3 where key=$1",
+		key, b.Tokens, b.UpdatedAt,
+	); err != nil {
+		return bikerental.RateLimitDecision{}, fmt.Errorf("updating rate limit bucket in postgres: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return bikerental.RateLimitDecision{}, fmt.Errorf("committing postgres transaction: %w", err)
+	}
+	return decision, nil
+}
+
+// Increment increments counter of the key for the day of given time, and returns the new value.
+func (r *RateLimitsRepository) Increment(ctx context.Context, key string, at time.Time) (_ int64, err error) {
+	ctx, span := startSpan(ctx, "RateLimitsRepository.Increment")
+	defer func() { endSpan(span, err) }()
+
+	var calls int64
+	if err := r.db.GetContext(
+		ctx,
+		&calls,
+		`insert into quota_counters (key, day, calls) values ($1, $2, 1)
+		on conflict (key, day) do update set calls = quota_counters.calls + 1
+		returning calls`,
+		key, at.UTC().Format("2006-01-02"),
+	); err != nil {
+		return 0, fmt.Errorf("incrementing quota counter in postgres: %w", err)
+	}
+	return calls, nil
+}
+
+// DeleteIdle removes buckets not used since given time, and returns number of removed buckets.
+func (r *RateLimitsRepository) DeleteIdle(ctx context.Context, before time.Time) (_ int, err error) {
+	ctx, span := startSpan(ctx, "RateLimitsRepository.DeleteIdle")
+	defer func() { endSpan(span, err) }()
+
+	res, err := r.db.ExecContext(ctx, "delete from rate_limit_buckets where updated_at < $1", before)
+	if err != nil {
+		return 0, fmt.Errorf("deleting idle rate limit bucket rows from postgres: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("checking affected rows: %w", err)
+	}
+	return int(n), nil
+}
+
+// DeleteBefore removes counters of days before the day of given time, and returns number of removed counters.
+func (r *RateLimitsRepository) DeleteBefore(ctx context.Context, at time.Time) (_ int, err error) {
+	ctx, span := startSpan(ctx, "RateLimitsRepository.DeleteBefore")
+	defer func() { endSpan(span, err) }()
+
+	res, err := r.db.ExecContext(ctx, "delete from quota_counters where day < $1", at.UTC().Format("2006-01-02"))
+	if err != nil {
+		return 0, fmt.Errorf("deleting old quota counter rows from postgres: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("checking affected rows: %w", err)
+	}
+	return int(n), nil
+}
+
+type tokenBucketModel struct {
+	Key       string    `db:"key"`
+	Tokens    float64   `db:"tokens"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (m *tokenBucketModel) ToAppTokenBucket() bikerental.TokenBucket {
+	return bikerental.TokenBucket{
+		Tokens:    m.Tokens,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
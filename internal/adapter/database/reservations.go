@@ -33,19 +33,23 @@ type ReservationsRepository struct {
 	log    logrus.FieldLogger
 }
 
-// List returns list of reservations matching request criteria.
-func (r *ReservationsRepository) List(ctx context.Context, query reservation.ListReservationsQuery) ([]bikerental.Reservation, error) {
-	sqlq := sqlBuilder.Select(
-		"r.*",
-		"c.first_name", "c.surname", "c.email", "c.type",
-		"b.model_name", "b.weight", "b.price_per_h",
-	).
-		From("reservations r").
-		Join("customers c on r.customer_id = c.id").
-		Join("bikes b on r.bike_id = b.id")
+// List returns list of reservations of the tenant from context matching request criteria.
+func (r *ReservationsRepository) List(ctx context.Context, query reservation.ListReservationsQuery) (_ []bikerental.Reservation, err error) {
+	ctx, span := startSpan(ctx, "ReservationsRepository.List")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlq := selectReservations(tenantID)
 	if query.BikeID != "" {
 		sqlq = sqlq.Where(squirrel.Eq{"r.bike_id": query.BikeID})
 	}
+	if query.CustomerID != "" {
+		sqlq = sqlq.Where(squirrel.Eq{"r.customer_id": query.CustomerID})
+	}
 	if !query.StartTime.IsZero() {
 		sqlq = sqlq.Where(squirrel.Gt{"r.end_time": query.StartTime})
 	}
@@ -66,7 +70,9 @@ func (r *ReservationsRepository) List(ctx context.Context, query reservation.Lis
 	}
 
 	var rs []reservationModel
-	if err := r.db.SelectContext(ctx, &rs, q, args...); err != nil {
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(ctx, &rs, q, args...)
+	}); err != nil {
 		return nil, fmt.Errorf("querying for reservations in postgresql: %w", err)
 	}
 
@@ -77,11 +83,26 @@ func (r *ReservationsRepository) List(ctx context.Context, query reservation.Lis
 	return result, nil
 }
 
-// Get returns a reservation by id.
+// Get returns a reservation of the tenant from context by id.
 // Returns app.ErrNotFound if reservation doesn't exists.
-func (r *ReservationsRepository) Get(ctx context.Context, id string) (*bikerental.Reservation, error) {
+func (r *ReservationsRepository) Get(ctx context.Context, id string) (_ *bikerental.Reservation, err error) {
+	ctx, span := startSpan(ctx, "ReservationsRepository.Get")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q, args, err := selectReservations(tenantID).Where(squirrel.Eq{"r.id": id}).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
 	var res reservationModel
-	if err := r.db.GetContext(ctx, &res, "select * from reservations where id=$1", id); err != nil {
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.GetContext(ctx, &res, q, args...)
+	}); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, app.ErrNotFound
 		}
@@ -93,19 +114,32 @@ func (r *ReservationsRepository) Get(ctx context.Context, id string) (*bikerenta
 	return &result, nil
 }
 
-// Create creates new reservation in db.
+// Create creates new reservation of the tenant from context in db.
 // Bike id must be provided.
 // If customer doesn't exists, it is created with reservation.
-func (r *ReservationsRepository) Create(ctx context.Context, reservation bikerental.Reservation) (*bikerental.Reservation, error) {
+// Notifications and events are stored in outbox in the same transaction.
+func (r *ReservationsRepository) Create(
+	ctx context.Context,
+	reservation bikerental.Reservation,
+	notifications []bikerental.Notification,
+	events []bikerental.Event,
+) (_ *bikerental.Reservation, err error) {
+	ctx, span := startSpan(ctx, "ReservationsRepository.Create")
+	defer func() { endSpan(span, err) }()
+
 	if err := r.checkReservationData(reservation); err != nil {
 		return nil, err
 	}
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{
+	tx, err := beginTenantTx(ctx, r.db, &sql.TxOptions{
 		Isolation: sql.LevelRepeatableRead,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("creating postgresql transaction: %w", err)
+		return nil, err
 	}
 	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
 
@@ -121,7 +155,7 @@ func (r *ReservationsRepository) Create(ctx context.Context, reservation bikeren
 	}
 	reservation.Bike = *bike
 
-	available, err := r.checkAvailability(ctx, tx, reservation.Bike.ID, reservation.StartTime, reservation.EndTime)
+	available, err := r.checkAvailability(ctx, tx, tenantID, reservation.Bike.ID, reservation.StartTime, reservation.EndTime)
 	if err != nil {
 		return nil, fmt.Errorf("checking bike availability: %w", err)
 	}
@@ -142,10 +176,45 @@ func (r *ReservationsRepository) Create(ctx context.Context, reservation bikeren
 		}
 	}
 
-	if err := r.createReservation(ctx, tx, reservation); err != nil {
+	if reservation.Customer.CompanyID != "" {
+		if err := r.parent.Companies().CheckSpendingLimitInTx(
+			ctx, tx, reservation.Customer.CompanyID, reservation.StartTime, reservation.ToBase(reservation.TotalValue),
+		); err != nil {
+			return nil, fmt.Errorf("checking company spending limit: %w", err)
+		}
+	}
+
+	if err := r.createReservation(ctx, tx, tenantID, reservation); err != nil {
 		return nil, fmt.Errorf("creating reservation: %w", err)
 	}
 
+	if reservation.PromoCode != "" {
+		if err := r.parent.PromoCodes().RedeemInTx(ctx, tx, reservation.PromoCode, reservation.Customer.ID, reservation.ID); err != nil {
+			return nil, fmt.Errorf("redeeming promo code: %w", err)
+		}
+	}
+
+	if reservation.RedeemedPoints > 0 {
+		if err := r.parent.Loyalty().AppendInTx(ctx, tx, []bikerental.LoyaltyEntry{{
+			ID:            uuid.NewString(),
+			CustomerID:    reservation.Customer.ID,
+			ReservationID: reservation.ID,
+			Kind:          bikerental.LoyaltyEntryKindRedemption,
+			Points:        -reservation.RedeemedPoints,
+			CreatedAt:     time.Now(),
+		}}); err != nil {
+			return nil, fmt.Errorf("redeeming loyalty points: %w", err)
+		}
+	}
+
+	if err := r.parent.Notifications().EnqueueInTx(ctx, tx, notifications); err != nil {
+		return nil, fmt.Errorf("enqueuing notifications: %w", err)
+	}
+
+	if err := r.parent.Events().AppendInTx(ctx, tx, events); err != nil {
+		return nil, fmt.Errorf("storing reservation events: %w", err)
+	}
+
 	if err := commitTx(ctx, tx, r.log); err != nil {
 		return nil, fmt.Errorf("committing postgres transaction: %w", err)
 	}
@@ -153,10 +222,21 @@ func (r *ReservationsRepository) Create(ctx context.Context, reservation bikeren
 	return &reservation, nil
 }
 
-// Delete deletes reservation from db.
-func (r *ReservationsRepository) Delete(ctx context.Context, id string) error {
-	res, err := r.db.ExecContext(ctx, `delete from reservations where id=$1`, id)
+// Delete deletes reservation of the tenant from context from db.
+func (r *ReservationsRepository) Delete(ctx context.Context, id string) (err error) {
+	ctx, span := startSpan(ctx, "ReservationsRepository.Delete")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
 	if err != nil {
+		return err
+	}
+
+	var res sql.Result
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		res, err = tx.ExecContext(ctx, `delete from reservations where id=$1 and tenant_id=$2`, id, tenantID)
+		return err
+	}); err != nil {
 		return fmt.Errorf("deleting reservation row from postgres: %w", err)
 	}
 	rows, _ := res.RowsAffected()
@@ -169,36 +249,236 @@ func (r *ReservationsRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// SetStatus updates the status of the reservation by its id.
+// UpdateStatus changes status of the reservation, appends loyalty ledger entries
+// and stores notifications and events in outbox in one transaction.
+// Returns app.ConflictError if current status of the reservation is not `From` anymore.
 // Returns app.ErrNotFound if reservation doesn't exists.
-func (r *ReservationsRepository) SetStatus(ctx context.Context, id string, status bikerental.ReservationStatus) error {
-	sqlq := sqlBuilder.Update("reservations").
-		Set("status", status).
-		Where(squirrel.Eq{"id": id})
-	q, args, err := sqlq.ToSql()
+func (r *ReservationsRepository) UpdateStatus(ctx context.Context, change reservation.StatusChange) (err error) {
+	ctx, span := startSpan(ctx, "ReservationsRepository.UpdateStatus")
+	defer func() { endSpan(span, err) }()
+
+	id := change.ReservationID
+	tenantID, err := tenantFromCtx(ctx)
 	if err != nil {
-		return fmt.Errorf("building sql query: %w", err)
+		return err
 	}
 
-	res, err := r.db.ExecContext(ctx, q, args...)
+	tx, err := beginTenantTx(ctx, r.db, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+	})
 	if err != nil {
+		return err
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	var current string
+	if err := tx.GetContext(
+		ctx, &current, "select status from reservations where id=$1 and tenant_id=$2 for update", id, tenantID,
+	); err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return app.ErrNotFound
+		case isPQError(err, pqCodeSerializationFailure):
+			return app.NewConflictError("reservation was modified concurrently")
+		default:
+			return fmt.Errorf("locking reservation row in postgres: %w", err)
+		}
+	}
+	if bikerental.ReservationStatus(current) != change.From {
+		return app.NewConflictError(fmt.Sprintf("reservation status is '%s', expected '%s'", current, change.From))
+	}
+
+	if _, err := tx.ExecContext(ctx, "update reservations set status=$2 where id=$1 and tenant_id=$3", id, change.To, tenantID); err != nil {
 		return fmt.Errorf("updating reservation status in postgres: %w", err)
 	}
 
+	if err := r.parent.Loyalty().AppendInTx(ctx, tx, change.Ledger); err != nil {
+		return fmt.Errorf("appending loyalty ledger entries: %w", err)
+	}
+
+	if err := r.parent.Notifications().EnqueueInTx(ctx, tx, change.Notifications); err != nil {
+		return fmt.Errorf("enqueuing notifications: %w", err)
+	}
+
+	// Reservation row is locked, so events of the reservation are ordered like status changes.
+	if err := r.parent.Events().AppendInTx(ctx, tx, change.Events); err != nil {
+		return fmt.Errorf("storing reservation events: %w", err)
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("id", id).
+		WithField("status", change.To).
+		Info("reservation status updated in db")
+
+	return nil
+}
+
+// UpdatePaymentStatus changes payment status of the reservation.
+// Returns app.ErrNotFound if reservation doesn't exists.
+func (r *ReservationsRepository) UpdatePaymentStatus(ctx context.Context, id string, status bikerental.PaymentStatus) (err error) {
+	ctx, span := startSpan(ctx, "ReservationsRepository.UpdatePaymentStatus")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	var res sql.Result
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		res, err = tx.ExecContext(
+			ctx, "update reservations set payment_status=$2 where id=$1 and tenant_id=$3", id, status, tenantID,
+		)
+		return err
+	}); err != nil {
+		return fmt.Errorf("updating reservation payment status in postgres: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return app.ErrNotFound
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("id", id).
+		WithField("paymentStatus", status).
+		Info("reservation payment status updated in db")
+
+	return nil
+}
+
+// UpdateDepositStatus changes security deposit status of the reservation and sets forfeited amount.
+// Returns app.ErrNotFound if reservation doesn't exists.
+func (r *ReservationsRepository) UpdateDepositStatus(
+	ctx context.Context,
+	id string,
+	status bikerental.DepositStatus,
+	forfeited bikerental.Money,
+) (err error) {
+	ctx, span := startSpan(ctx, "ReservationsRepository.UpdateDepositStatus")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	var res sql.Result
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		res, err = tx.ExecContext(
+			ctx,
+			"update reservations set deposit_status=$2, deposit_forfeited=$3 where id=$1 and tenant_id=$4",
+			id, status, forfeited.Amount, tenantID,
+		)
+		return err
+	}); err != nil {
+		return fmt.Errorf("updating reservation deposit status in postgres: %w", err)
+	}
 	rows, _ := res.RowsAffected()
 	if rows == 0 {
 		return app.ErrNotFound
 	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("id", id).
+		WithField("depositStatus", status).
+		Info("reservation deposit status updated in db")
+
 	return nil
 }
 
-func (r *ReservationsRepository) checkAvailability(ctx context.Context, tx *sqlx.Tx, bikeID string, startTime, endTime time.Time) (bool, error) {
+// CountActive returns number of approved customer reservations that end after given time.
+func (r *ReservationsRepository) CountActive(ctx context.Context, customerID string, at time.Time) (_ int, err error) {
+	ctx, span := startSpan(ctx, "ReservationsRepository.CountActive")
+	defer func() { endSpan(span, err) }()
+
+	return r.countCustomerReservations(ctx, squirrel.And{
+		squirrel.Eq{"customer_id": customerID},
+		squirrel.Eq{"status": bikerental.ReservationStatusApproved},
+		squirrel.Gt{"end_time": at},
+	})
+}
+
+// CountNoShows returns number of approved customer reservations that ended in [from, to) time range.
+// Reservations are completed when the bike is returned, so approved reservations in the past were never picked up.
+func (r *ReservationsRepository) CountNoShows(ctx context.Context, customerID string, from, to time.Time) (_ int, err error) {
+	ctx, span := startSpan(ctx, "ReservationsRepository.CountNoShows")
+	defer func() { endSpan(span, err) }()
+
+	return r.countCustomerReservations(ctx, squirrel.And{
+		squirrel.Eq{"customer_id": customerID},
+		squirrel.Eq{"status": bikerental.ReservationStatusApproved},
+		squirrel.GtOrEq{"end_time": from},
+		squirrel.Lt{"end_time": to},
+	})
+}
+
+// CountUnpaid returns number of completed customer reservations with payment authorized, but not captured.
+// Payment is captured when reservation is completed, so it's left authorized only if capture failed.
+func (r *ReservationsRepository) CountUnpaid(ctx context.Context, customerID string) (_ int, err error) {
+	ctx, span := startSpan(ctx, "ReservationsRepository.CountUnpaid")
+	defer func() { endSpan(span, err) }()
+
+	return r.countCustomerReservations(ctx, squirrel.And{
+		squirrel.Eq{"customer_id": customerID},
+		squirrel.Eq{"status": bikerental.ReservationStatusCompleted},
+		squirrel.Eq{"payment_status": bikerental.PaymentStatusAuthorized},
+	})
+}
+
+func (r *ReservationsRepository) countCustomerReservations(ctx context.Context, pred squirrel.Sqlizer) (int, error) {
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	q, args, err := sqlBuilder.Select("count(*)").
+		From("reservations").
+		Where(squirrel.Eq{"tenant_id": tenantID}).
+		Where(pred).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var count int
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.GetContext(ctx, &count, q, args...)
+	}); err != nil {
+		return 0, fmt.Errorf("counting reservations in postgresql: %w", err)
+	}
+	return count, nil
+}
+
+// selectReservations returns query for reservations of a tenant joined with customers and bikes.
+func selectReservations(tenantID string) squirrel.SelectBuilder {
+	return sqlBuilder.Select(
+		"r.*",
+		"c.first_name", "c.surname", "c.email", "c.type", "c.company_id", "c.vat_id",
+		"b.type as bike_type", "b.model_name", "b.weight", "b.price_per_h", "b.currency as bike_currency",
+	).
+		From("reservations r").
+		Join("customers c on r.customer_id = c.id").
+		Join("bikes b on r.bike_id = b.id").
+		Where(squirrel.Eq{"r.tenant_id": tenantID})
+}
+
+func (r *ReservationsRepository) checkAvailability(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	tenantID, bikeID string,
+	startTime, endTime time.Time,
+) (bool, error) {
 	sqlq := sqlBuilder.Select("count(*)").
 		From("reservations").
 		Where(squirrel.Eq{"bike_id": bikeID}).
+		Where(squirrel.Eq{"tenant_id": tenantID}).
 		Where(squirrel.Gt{"end_time": startTime}).
 		Where(squirrel.Lt{"start_time": endTime}).
-		Where(squirrel.NotEq{"status": bikerental.ReservationStatusCanceled})
+		Where(squirrel.Eq{"status": bikerental.ReservationStatusApproved})
 	q, args, err := sqlq.ToSql()
 	if err != nil {
 		return false, fmt.Errorf("building sql query: %w", err)
@@ -234,26 +514,50 @@ func (r *ReservationsRepository) checkReservationData(reservation bikerental.Res
 	return nil
 }
 
-func (r *ReservationsRepository) createReservation(ctx context.Context, tx *sqlx.Tx, reservation bikerental.Reservation) error {
+func (r *ReservationsRepository) createReservation(ctx context.Context, tx *sqlx.Tx, tenantID string, reservation bikerental.Reservation) error {
 	sqlq := sqlBuilder.
 		Insert("reservations").
-		Columns("id", "status", "bike_id", "customer_id", "start_time", "end_time", "total_value", "applied_discount").
+		Columns(
+			"id", "tenant_id", "status", "bike_id", "customer_id", "start_time", "end_time",
+			"total_value", "quoted_value", "applied_discount", "discount_rule", "currency", "exchange_rate", "promo_code", "redeemed_points",
+			"payment_id", "payment_status",
+			"deposit_amount", "deposit_payment_id", "deposit_status", "deposit_forfeited",
+			"tax_treatment", "tax_country", "tax_rate", "net_value", "tax_value",
+		).
 		Values(
 			squirrel.Expr(":id"),
+			squirrel.Expr(":tenant_id"),
 			squirrel.Expr(":status"),
 			squirrel.Expr(":bike_id"),
 			squirrel.Expr(":customer_id"),
 			squirrel.Expr(":start_time"),
 			squirrel.Expr(":end_time"),
 			squirrel.Expr(":total_value"),
+			squirrel.Expr(":quoted_value"),
 			squirrel.Expr(":applied_discount"),
+			squirrel.Expr(":discount_rule"),
+			squirrel.Expr(":currency"),
+			squirrel.Expr(":exchange_rate"),
+			squirrel.Expr(":promo_code"),
+			squirrel.Expr(":redeemed_points"),
+			squirrel.Expr(":payment_id"),
+			squirrel.Expr(":payment_status"),
+			squirrel.Expr(":deposit_amount"),
+			squirrel.Expr(":deposit_payment_id"),
+			squirrel.Expr(":deposit_status"),
+			squirrel.Expr(":deposit_forfeited"),
+			squirrel.Expr(":tax_treatment"),
+			squirrel.Expr(":tax_country"),
+			squirrel.Expr(":tax_rate"),
+			squirrel.Expr(":net_value"),
+			squirrel.Expr(":tax_value"),
 		)
 	q, _, err := sqlq.ToSql()
 	if err != nil {
 		return fmt.Errorf("building sql query: %w", err)
 	}
 
-	m := newReservationModel(reservation)
+	m := newReservationModel(tenantID, reservation)
 	if _, err := tx.NamedExec(q, m); err != nil {
 		return fmt.Errorf("inserting reservation row into postgres: %w", err)
 	}
@@ -268,37 +572,78 @@ func (r *ReservationsRepository) createReservation(ctx context.Context, tx *sqlx
 }
 
 type reservationModel struct {
-	ID              string    `db:"id"`
-	Status          string    `db:"status"`
-	BikeID          string    `db:"bike_id"`
-	CustomerID      string    `db:"customer_id"`
-	StartTime       time.Time `db:"start_time"`
-	EndTime         time.Time `db:"end_time"`
-	TotalValue      int       `db:"total_value"`
-	AppliedDiscount int       `db:"applied_discount"`
+	ID               string         `db:"id"`
+	TenantID         string         `db:"tenant_id"`
+	Status           string         `db:"status"`
+	BikeID           string         `db:"bike_id"`
+	CustomerID       string         `db:"customer_id"`
+	StartTime        time.Time      `db:"start_time"`
+	EndTime          time.Time      `db:"end_time"`
+	TotalValue       int64          `db:"total_value"`
+	QuotedValue      sql.NullInt64  `db:"quoted_value"`
+	AppliedDiscount  int64          `db:"applied_discount"`
+	DiscountRule     string         `db:"discount_rule"`
+	Currency         string         `db:"currency"`
+	ExchangeRate     float64        `db:"exchange_rate"`
+	PromoCode        sql.NullString `db:"promo_code"`
+	RedeemedPoints   int            `db:"redeemed_points"`
+	PaymentID        sql.NullString `db:"payment_id"`
+	PaymentStatus    sql.NullString `db:"payment_status"`
+	DepositAmount    int64          `db:"deposit_amount"`
+	DepositPaymentID sql.NullString `db:"deposit_payment_id"`
+	DepositStatus    sql.NullString `db:"deposit_status"`
+	DepositForfeited int64          `db:"deposit_forfeited"`
+	TaxTreatment     sql.NullString `db:"tax_treatment"`
+	TaxCountry       string         `db:"tax_country"`
+	TaxRate          float64        `db:"tax_rate"`
+	NetValue         int64          `db:"net_value"`
+	TaxValue         int64          `db:"tax_value"`
 
 	// Join on customers
-	FirstName string `db:"first_name"`
-	Surname   string `db:"surname"`
-	Email     string `db:"email"`
-	Type      string `db:"type"`
+	FirstName string         `db:"first_name"`
+	Surname   string         `db:"surname"`
+	Email     string         `db:"email"`
+	Type      string         `db:"type"`
+	CompanyID sql.NullString `db:"company_id"`
+	VATID     sql.NullString `db:"vat_id"`
 
 	// Join on bikes
+	BikeType     string  `db:"bike_type"`
 	ModelName    string  `db:"model_name"`
 	Weight       float64 `db:"weight"`
-	PricePerHour int     `db:"price_per_h"`
+	PricePerHour int64   `db:"price_per_h"`
+	BikeCurrency string  `db:"bike_currency"`
 }
 
-func newReservationModel(ar bikerental.Reservation) reservationModel {
+func newReservationModel(tenantID string, ar bikerental.Reservation) reservationModel {
+	// All reservation amounts are in the same currency.
 	return reservationModel{
-		ID:              ar.ID,
-		Status:          string(ar.Status),
-		BikeID:          ar.Bike.ID,
-		CustomerID:      ar.Customer.ID,
-		StartTime:       ar.StartTime,
-		EndTime:         ar.EndTime,
-		TotalValue:      ar.TotalValue,
-		AppliedDiscount: ar.AppliedDiscount,
+		ID:               ar.ID,
+		TenantID:         tenantID,
+		Status:           string(ar.Status),
+		BikeID:           ar.Bike.ID,
+		CustomerID:       ar.Customer.ID,
+		StartTime:        ar.StartTime,
+		EndTime:          ar.EndTime,
+		TotalValue:       ar.TotalValue.Amount,
+		QuotedValue:      sql.NullInt64{Int64: ar.QuotedValue.Amount, Valid: ar.QuotedValue.Currency != ""},
+		AppliedDiscount:  ar.AppliedDiscount.Amount,
+		DiscountRule:     ar.DiscountRule,
+		Currency:         string(ar.TotalValue.Currency),
+		ExchangeRate:     ar.ExchangeRate,
+		PromoCode:        sql.NullString{String: ar.PromoCode, Valid: ar.PromoCode != ""},
+		RedeemedPoints:   ar.RedeemedPoints,
+		PaymentID:        sql.NullString{String: ar.PaymentID, Valid: ar.PaymentID != ""},
+		PaymentStatus:    sql.NullString{String: string(ar.PaymentStatus), Valid: ar.PaymentStatus != bikerental.PaymentStatusNone},
+		DepositAmount:    ar.DepositAmount.Amount,
+		DepositPaymentID: sql.NullString{String: ar.DepositPaymentID, Valid: ar.DepositPaymentID != ""},
+		DepositStatus:    sql.NullString{String: string(ar.DepositStatus), Valid: ar.DepositStatus != bikerental.DepositStatusNone},
+		DepositForfeited: ar.DepositForfeited.Amount,
+		TaxTreatment:     sql.NullString{String: string(ar.Tax.Treatment), Valid: ar.Tax.Treatment != bikerental.TaxTreatmentNone},
+		TaxCountry:       ar.Tax.Country,
+		TaxRate:          ar.Tax.Rate,
+		NetValue:         ar.Tax.Net.Amount,
+		TaxValue:         ar.Tax.Tax.Amount,
 	}
 }
 
@@ -309,21 +654,48 @@ func (m *reservationModel) ToAppReservation() bikerental.Reservation {
 		FirstName: m.FirstName,
 		Surname:   m.Surname,
 		Email:     m.Email,
+		CompanyID: m.CompanyID,
+		VATID:     m.VATID,
 	}
 	bm := bikeModel{
 		ID:           m.BikeID,
+		Type:         bikerental.BikeType(m.BikeType),
 		ModelName:    m.ModelName,
 		Weight:       m.Weight,
 		PricePerHour: m.PricePerHour,
+		Currency:     m.BikeCurrency,
+	}
+	currency := bikerental.Currency(m.Currency)
+	var quotedValue bikerental.Money
+	if m.QuotedValue.Valid {
+		quotedValue = bikerental.NewMoney(m.QuotedValue.Int64, currency)
 	}
 	return bikerental.Reservation{
-		ID:              m.ID,
-		Status:          bikerental.ReservationStatus(m.Status),
-		Customer:        cm.ToAppCustomer(),
-		Bike:            bm.ToAppBike(),
-		StartTime:       m.StartTime,
-		EndTime:         m.EndTime,
-		TotalValue:      m.TotalValue,
-		AppliedDiscount: m.AppliedDiscount,
+		ID:               m.ID,
+		Status:           bikerental.ReservationStatus(m.Status),
+		Customer:         cm.ToAppCustomer(),
+		Bike:             bm.ToAppBike(),
+		StartTime:        m.StartTime,
+		EndTime:          m.EndTime,
+		TotalValue:       bikerental.NewMoney(m.TotalValue, currency),
+		QuotedValue:      quotedValue,
+		AppliedDiscount:  bikerental.NewMoney(m.AppliedDiscount, currency),
+		DiscountRule:     m.DiscountRule,
+		ExchangeRate:     m.ExchangeRate,
+		PromoCode:        m.PromoCode.String,
+		RedeemedPoints:   m.RedeemedPoints,
+		PaymentID:        m.PaymentID.String,
+		PaymentStatus:    bikerental.PaymentStatus(m.PaymentStatus.String),
+		DepositAmount:    bikerental.NewMoney(m.DepositAmount, currency),
+		DepositPaymentID: m.DepositPaymentID.String,
+		DepositStatus:    bikerental.DepositStatus(m.DepositStatus.String),
+		DepositForfeited: bikerental.NewMoney(m.DepositForfeited, currency),
+		Tax: bikerental.ReservationTax{
+			Country:   m.TaxCountry,
+			Treatment: bikerental.TaxTreatment(m.TaxTreatment.String),
+			Rate:      m.TaxRate,
+			Net:       bikerental.NewMoney(m.NetValue, currency),
+			Tax:       bikerental.NewMoney(m.TaxValue, currency),
+		},
 	}
 }
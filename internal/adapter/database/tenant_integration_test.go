@@ -0,0 +1,663 @@
+//go:build integration
+// +build integration
+
+package database
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/damage"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/webhook"
+	"github.com/sirupsen/logrus"
+)
+
+// Integration tests require postgres, configured with the same env variables as the app.
+// Run them with: go test -tags=integration ./internal/adapter/database/...
+
+// rlsTestRole is a role without BYPASSRLS attribute, used for checking row-level security policies.
+const rlsTestRole = "bikerental_rls_test"
+
+// tenantTables are all tables with tenant data.
+var tenantTables = []string{
+	"bikes",
+	"customers",
+	"reservations",
+	"damage_reports",
+	"promo_codes",
+	"promo_code_redemptions",
+	"loyalty_ledger",
+	"loyalty_accounts",
+	"companies",
+	"blocklist",
+	"notifications",
+	"outbox",
+	"webhook_subscriptions",
+	"webhook_deliveries",
+	"webhook_delivery_attempts",
+}
+
+// workerTables are tenant tables which rows are claimed by workers for all tenants.
+var workerTables = map[string]bool{
+	"notifications":      true,
+	"outbox":             true,
+	"webhook_deliveries": true,
+}
+
+func envOrDefault(name, def string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return def
+}
+
+func newTestAdapter(t *testing.T) *Adapter {
+	t.Helper()
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	a, err := NewAdapter(
+		envOrDefault("POSTGRES_HOSTPORT", "localhost:5432"),
+		envOrDefault("POSTGRES_DB", "testdb"),
+		envOrDefault("POSTGRES_USER", "postgres"),
+		envOrDefault("POSTGRES_PASS", "password"),
+		"../../../configs/postgresql",
+		log,
+	)
+	if err != nil {
+		t.Fatalf("creating adapter: %v", err)
+	}
+	t.Cleanup(a.Close)
+	return a
+}
+
+// tenantFixture is a new tenant with a bike, customer and reservation.
+type tenantFixture struct {
+	ctx           context.Context
+	tenantID      string
+	bikeID        string
+	customerID    string
+	reservationID string
+}
+
+func newTenantFixture(t *testing.T, a *Adapter) tenantFixture {
+	t.Helper()
+	tenantID := "test-" + uuid.NewString()
+	f := tenantFixture{
+		ctx:           app.CtxWithTenantID(context.Background(), tenantID),
+		tenantID:      tenantID,
+		bikeID:        uuid.NewString(),
+		customerID:    uuid.NewString(),
+		reservationID: uuid.NewString(),
+	}
+
+	start := time.Now().Add(24 * time.Hour)
+	if err := inTenantTx(f.ctx, a.db, a.log, func(tx *sqlx.Tx) error {
+		if _, err := tx.ExecContext(
+			f.ctx,
+			"insert into bikes (id, tenant_id, model_name, weight, price_per_h) values ($1, $2, 'test', 10, 100)",
+			f.bikeID, tenantID,
+		); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(
+			f.ctx,
+			"insert into customers (id, tenant_id, email, type) values ($1, $2, 'customer@example.com', 'individual')",
+			f.customerID, tenantID,
+		); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(
+			f.ctx,
+			`insert into reservations (id, tenant_id, status, start_time, end_time, bike_id, customer_id, total_value, applied_discount)
+			values ($1, $2, 'approved', $3, $4, $5, $6, 1000, 0)`,
+			f.reservationID, tenantID, start, start.Add(time.Hour), f.bikeID, f.customerID,
+		)
+		return err
+	}); err != nil {
+		t.Fatalf("creating tenant fixture: %v", err)
+	}
+	return f
+}
+
+func TestTenantIsolationDamageReports(t *testing.T) {
+	a := newTestAdapter(t)
+	own, other := newTenantFixture(t, a), newTenantFixture(t, a)
+	repo := a.DamageReports()
+
+	report := bikerental.DamageReport{
+		ID:                 uuid.NewString(),
+		ReservationID:      own.reservationID,
+		BikeID:             own.bikeID,
+		Description:        "broken chain",
+		Severity:           bikerental.DamageSeverityLow,
+		RepairCostEstimate: bikerental.NewMoney(100, "EUR"),
+		CreatedAt:          time.Now(),
+	}
+	if err := repo.Create(own.ctx, report, nil); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if _, err := repo.Get(other.ctx, report.ID); !app.IsNotFoundError(err) {
+		t.Errorf("Get() of other tenant error = %v, want not found", err)
+	}
+	reports, err := repo.List(other.ctx, damage.ListReportsQuery{BikeID: own.bikeID})
+	if err != nil || len(reports) != 0 {
+		t.Errorf("List() of other tenant = %d reports, error = %v, want none", len(reports), err)
+	}
+
+	report.ID = uuid.NewString()
+	if err := repo.Create(other.ctx, report, nil); err == nil {
+		t.Error("Create() of report of other tenant reservation error = nil, want error")
+	}
+}
+
+func TestTenantIsolationPromoCodes(t *testing.T) {
+	a := newTestAdapter(t)
+	own, other := newTenantFixture(t, a), newTenantFixture(t, a)
+	repo := a.PromoCodes()
+
+	code := bikerental.PromoCode{
+		Code:      "SPRING-" + uuid.NewString(),
+		Type:      bikerental.PromoCodeTypePercentage,
+		Percent:   10,
+		CreatedAt: time.Now(),
+	}
+	if err := repo.Create(own.ctx, code); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if _, err := repo.GetPromoCode(other.ctx, code.Code); !app.IsNotFoundError(err) {
+		t.Errorf("GetPromoCode() of other tenant error = %v, want not found", err)
+	}
+	codes, err := repo.List(other.ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	for _, c := range codes {
+		if c.Code == code.Code {
+			t.Errorf("List() of other tenant returned code %s", c.Code)
+		}
+	}
+
+	err = inTenantTx(other.ctx, a.db, a.log, func(tx *sqlx.Tx) error {
+		return repo.RedeemInTx(other.ctx, tx, code.Code, other.customerID, other.reservationID)
+	})
+	if !errors.Is(err, bikerental.ErrPromoCodeNotRedeemable) {
+		t.Errorf("RedeemInTx() of other tenant code error = %v, want %v", err, bikerental.ErrPromoCodeNotRedeemable)
+	}
+
+	// Codes are unique per tenant.
+	if err := repo.Create(other.ctx, code); err != nil {
+		t.Errorf("Create() of the same code in other tenant error = %v", err)
+	}
+}
+
+func TestTenantIsolationLoyalty(t *testing.T) {
+	a := newTestAdapter(t)
+	own, other := newTenantFixture(t, a), newTenantFixture(t, a)
+	repo := a.Loyalty()
+
+	appendEntry := func(f tenantFixture, e bikerental.LoyaltyEntry) error {
+		return inTenantTx(f.ctx, a.db, a.log, func(tx *sqlx.Tx) error {
+			return repo.AppendInTx(f.ctx, tx, []bikerental.LoyaltyEntry{e})
+		})
+	}
+	entry := bikerental.LoyaltyEntry{
+		ID:            uuid.NewString(),
+		CustomerID:    own.customerID,
+		ReservationID: own.reservationID,
+		Kind:          bikerental.LoyaltyEntryKindAccrual,
+		Points:        100,
+		CreatedAt:     time.Now(),
+	}
+	if err := appendEntry(own, entry); err != nil {
+		t.Fatalf("AppendInTx() error = %v", err)
+	}
+
+	account, err := repo.GetAccount(other.ctx, own.customerID)
+	if err != nil || account.Balance != 0 {
+		t.Errorf("GetAccount() of other tenant = %+v, error = %v, want empty account", account, err)
+	}
+	entries, err := repo.ListEntries(other.ctx, own.customerID)
+	if err != nil || len(entries) != 0 {
+		t.Errorf("ListEntries() of other tenant = %d entries, error = %v, want none", len(entries), err)
+	}
+
+	redemption := entry
+	redemption.ID = uuid.NewString()
+	redemption.ReservationID = other.reservationID
+	redemption.Kind = bikerental.LoyaltyEntryKindRedemption
+	redemption.Points = -50
+	if err := appendEntry(other, redemption); !errors.Is(err, bikerental.ErrLoyaltyPointsNotRedeemable) {
+		t.Errorf("AppendInTx() of other tenant redemption error = %v, want %v", err, bikerental.ErrLoyaltyPointsNotRedeemable)
+	}
+	accrual := entry
+	accrual.ID = uuid.NewString()
+	accrual.ReservationID = other.reservationID
+	if err := appendEntry(other, accrual); err == nil {
+		t.Error("AppendInTx() of other tenant accrual error = nil, want error")
+	}
+
+	account, err = repo.GetAccount(own.ctx, own.customerID)
+	if err != nil || account.Balance != 100 {
+		t.Errorf("GetAccount() = %+v, error = %v, want balance 100", account, err)
+	}
+}
+
+func TestTenantIsolationCompanies(t *testing.T) {
+	a := newTestAdapter(t)
+	own, other := newTenantFixture(t, a), newTenantFixture(t, a)
+	repo := a.Companies()
+
+	company := bikerental.Company{ID: uuid.NewString(), Name: "Acme"}
+	if err := repo.Create(own.ctx, company); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if _, err := repo.Get(other.ctx, company.ID); !app.IsNotFoundError(err) {
+		t.Errorf("Get() of other tenant error = %v, want not found", err)
+	}
+	if err := repo.Update(other.ctx, company.ID, bikerental.Company{Name: "Hijacked"}); !app.IsNotFoundError(err) {
+		t.Errorf("Update() of other tenant error = %v, want not found", err)
+	}
+	companies, err := repo.List(other.ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	for _, c := range companies {
+		if c.ID == company.ID {
+			t.Errorf("List() of other tenant returned company %s", c.ID)
+		}
+	}
+
+	got, err := repo.Get(own.ctx, company.ID)
+	if err != nil || got.Name != company.Name {
+		t.Errorf("Get() = %+v, error = %v, want not changed company", got, err)
+	}
+}
+
+func TestTenantIsolationBlocklist(t *testing.T) {
+	a := newTestAdapter(t)
+	own, other := newTenantFixture(t, a), newTenantFixture(t, a)
+	repo := a.Blocklist()
+
+	entry := bikerental.BlocklistEntry{
+		ID:        uuid.NewString(),
+		Kind:      bikerental.BlocklistEntryKindEmailDomain,
+		Value:     uuid.NewString() + ".example.com",
+		CreatedAt: time.Now(),
+	}
+	if err := repo.Create(own.ctx, entry); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if found, err := repo.Find(other.ctx, "", entry.Value); err != nil || found != nil {
+		t.Errorf("Find() of other tenant = %+v, error = %v, want nil", found, err)
+	}
+	entries, err := repo.List(other.ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	for _, e := range entries {
+		if e.ID == entry.ID {
+			t.Errorf("List() of other tenant returned entry %s", e.ID)
+		}
+	}
+	if err := repo.Delete(other.ctx, entry.ID); !app.IsNotFoundError(err) {
+		t.Errorf("Delete() of other tenant error = %v, want not found", err)
+	}
+
+	if found, err := repo.Find(own.ctx, "", entry.Value); err != nil || found == nil {
+		t.Errorf("Find() = %+v, error = %v, want not deleted entry", found, err)
+	}
+
+	// Entries are unique per tenant.
+	entry.ID = uuid.NewString()
+	if err := repo.Create(other.ctx, entry); err != nil {
+		t.Errorf("Create() of the same entry in other tenant error = %v", err)
+	}
+}
+
+func TestTenantIsolationNotifications(t *testing.T) {
+	a := newTestAdapter(t)
+	own, other := newTenantFixture(t, a), newTenantFixture(t, a)
+	repo := a.Notifications()
+
+	enqueue := func(f tenantFixture, n bikerental.Notification) error {
+		return inTenantTx(f.ctx, a.db, a.log, func(tx *sqlx.Tx) error {
+			return repo.EnqueueInTx(f.ctx, tx, []bikerental.Notification{n})
+		})
+	}
+	n := bikerental.Notification{
+		ID:            uuid.NewString(),
+		Type:          bikerental.NotificationTypeReservationConfirmed,
+		ReservationID: own.reservationID,
+		Recipient:     "customer@example.com",
+		// Notification is not due, so it's not claimed by workers.
+		SendAt:    time.Now().Add(time.Hour),
+		Status:    bikerental.NotificationStatusPending,
+		CreatedAt: time.Now(),
+	}
+	if err := enqueue(own, n); err != nil {
+		t.Fatalf("EnqueueInTx() error = %v", err)
+	}
+
+	n.Status = bikerental.NotificationStatusSkipped
+	if err := repo.Update(other.ctx, n); !app.IsNotFoundError(err) {
+		t.Errorf("Update() of other tenant error = %v, want not found", err)
+	}
+
+	otherN := n
+	otherN.ID = uuid.NewString()
+	if err := enqueue(other, otherN); err == nil {
+		t.Error("EnqueueInTx() of other tenant reservation notification error = nil, want error")
+	}
+
+	if err := repo.Update(own.ctx, n); err != nil {
+		t.Errorf("Update() error = %v", err)
+	}
+}
+
+func TestTenantIsolationWebhooks(t *testing.T) {
+	a := newTestAdapter(t)
+	own, other := newTenantFixture(t, a), newTenantFixture(t, a)
+	repo := a.Webhooks()
+
+	sub := newTestSubscription()
+	if err := repo.CreateSubscription(own.ctx, sub); err != nil {
+		t.Fatalf("CreateSubscription() error = %v", err)
+	}
+
+	if _, err := repo.GetSubscription(other.ctx, sub.ID); !app.IsNotFoundError(err) {
+		t.Errorf("GetSubscription() of other tenant error = %v, want not found", err)
+	}
+	subs, err := repo.ListSubscriptions(other.ctx)
+	if err != nil || len(subs) != 0 {
+		t.Errorf("ListSubscriptions() of other tenant = %d subscriptions, error = %v, want none", len(subs), err)
+	}
+	if err := repo.DeleteSubscription(other.ctx, sub.ID); !app.IsNotFoundError(err) {
+		t.Errorf("DeleteSubscription() of other tenant error = %v, want not found", err)
+	}
+
+	d := bikerental.WebhookDelivery{
+		ID:             uuid.NewString(),
+		SubscriptionID: sub.ID,
+		Event:          newTestEvent(own),
+		Status:         bikerental.WebhookDeliveryStatusPending,
+		// Delivery is not due, so it's not claimed by workers.
+		NextAttemptAt: time.Now().Add(time.Hour),
+		CreatedAt:     time.Now(),
+	}
+	if err := repo.CreateDeliveries(other.ctx, []bikerental.WebhookDelivery{d}); err == nil {
+		t.Error("CreateDeliveries() for other tenant subscription error = nil, want error")
+	}
+	if err := repo.CreateDeliveries(own.ctx, []bikerental.WebhookDelivery{d}); err != nil {
+		t.Fatalf("CreateDeliveries() error = %v", err)
+	}
+
+	if _, err := repo.GetDelivery(other.ctx, d.ID); !app.IsNotFoundError(err) {
+		t.Errorf("GetDelivery() of other tenant error = %v, want not found", err)
+	}
+	ds, err := repo.ListDeliveries(other.ctx, bikerental.ListWebhookDeliveriesRequest{SubscriptionID: sub.ID, Limit: 10})
+	if err != nil || len(ds) != 0 {
+		t.Errorf("ListDeliveries() of other tenant = %d deliveries, error = %v, want none", len(ds), err)
+	}
+	attempt := bikerental.WebhookAttempt{AttemptedAt: time.Now(), StatusCode: 200, Duration: time.Millisecond}
+	if err := repo.RecordAttempt(other.ctx, d, attempt); !app.IsNotFoundError(err) {
+		t.Errorf("RecordAttempt() of other tenant error = %v, want not found", err)
+	}
+	if err := repo.Reschedule(other.ctx, d); !app.IsNotFoundError(err) {
+		t.Errorf("Reschedule() of other tenant error = %v, want not found", err)
+	}
+
+	if err := repo.RecordAttempt(own.ctx, d, attempt); err != nil {
+		t.Fatalf("RecordAttempt() error = %v", err)
+	}
+	got, err := repo.GetDelivery(own.ctx, d.ID)
+	if err != nil {
+		t.Fatalf("GetDelivery() error = %v", err)
+	}
+	if len(got.Log) != 1 || got.Event.TenantID != own.tenantID {
+		t.Errorf("GetDelivery() = %d attempts of tenant '%s' event, want 1 attempt of tenant '%s' event",
+			len(got.Log), got.Event.TenantID, own.tenantID)
+	}
+}
+
+type noopSender struct{}
+
+func (noopSender) Send(ctx context.Context, req bikerental.WebhookRequest) (int, error) {
+	return 200, nil
+}
+
+func TestTenantIsolationWebhookFanOut(t *testing.T) {
+	a := newTestAdapter(t)
+	own, other := newTenantFixture(t, a), newTenantFixture(t, a)
+	repo := a.Webhooks()
+	s, err := webhook.NewService(noopSender{}, repo, true)
+	if err != nil {
+		t.Fatalf("creating service: %v", err)
+	}
+
+	sub := newTestSubscription()
+	if err := repo.CreateSubscription(own.ctx, sub); err != nil {
+		t.Fatalf("CreateSubscription() error = %v", err)
+	}
+
+	// Relay publishes events of all tenants without tenant in context.
+	if err := s.Publish(context.Background(), newTestEvent(other)); err != nil {
+		t.Fatalf("Publish() of other tenant event error = %v", err)
+	}
+	req := bikerental.ListWebhookDeliveriesRequest{SubscriptionID: sub.ID, Limit: 10}
+	if ds, err := repo.ListDeliveries(own.ctx, req); err != nil || len(ds) != 0 {
+		t.Errorf("deliveries of other tenant event = %d, error = %v, want none", len(ds), err)
+	}
+
+	if err := s.Publish(context.Background(), newTestEvent(own)); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	if ds, err := repo.ListDeliveries(own.ctx, req); err != nil || len(ds) != 1 {
+		t.Errorf("deliveries of own tenant event = %d, error = %v, want 1", len(ds), err)
+	}
+}
+
+// TestTenantIsolationRowLevelSecurity checks policies of all tenant tables, with a role without BYPASSRLS attribute.
+// Repositories filter by tenant in queries too, so other tests pass without policies.
+func TestTenantIsolationRowLevelSecurity(t *testing.T) {
+	a := newTestAdapter(t)
+	own, other := newTenantFixture(t, a), newTenantFixture(t, a)
+	seedTenantTables(t, a, own)
+
+	if _, err := a.db.Exec(`do $$ begin
+		if not exists (select from pg_roles where rolname = '` + rlsTestRole + `') then
+			create role ` + rlsTestRole + ` nologin;
+		end if;
+	end $$`); err != nil {
+		t.Skipf("creating role for row-level security tests: %v", err)
+	}
+	if _, err := a.db.Exec("grant select, insert, update, delete on all tables in schema public to " + rlsTestRole); err != nil {
+		t.Fatalf("granting privileges: %v", err)
+	}
+
+	for _, table := range tenantTables {
+		table := table
+		t.Run(table, func(t *testing.T) {
+			ctx := context.Background()
+			tx, err := a.db.BeginTxx(ctx, nil)
+			if err != nil {
+				t.Fatalf("beginning transaction: %v", err)
+			}
+			// Rows changed by the test are not committed.
+			defer func() { _ = tx.Rollback() }()
+
+			if _, err := tx.ExecContext(ctx, "set local role "+rlsTestRole); err != nil {
+				t.Skipf("setting role for row-level security tests: %v", err)
+			}
+			setTenant := func(tenantID string) {
+				t.Helper()
+				if _, err := tx.ExecContext(ctx, "select set_config('app.tenant_id', $1, true)", tenantID); err != nil {
+					t.Fatalf("setting tenant: %v", err)
+				}
+			}
+			count := func() int {
+				t.Helper()
+				var n int
+				if err := tx.GetContext(ctx, &n, "select count(*) from "+table+" where tenant_id = $1", own.tenantID); err != nil {
+					t.Fatalf("counting rows: %v", err)
+				}
+				return n
+			}
+
+			setTenant(other.tenantID)
+			if n := count(); n != 0 {
+				t.Errorf("other tenant reads %d rows, want 0", n)
+			}
+			res, err := tx.ExecContext(ctx, "update "+table+" set tenant_id = $2 where tenant_id = $1", own.tenantID, other.tenantID)
+			if err != nil {
+				t.Fatalf("updating rows: %v", err)
+			}
+			if n, _ := res.RowsAffected(); n != 0 {
+				t.Errorf("other tenant updates %d rows, want 0", n)
+			}
+			res, err = tx.ExecContext(ctx, "delete from "+table+" where tenant_id = $1", own.tenantID)
+			if err != nil {
+				t.Fatalf("deleting rows: %v", err)
+			}
+			if n, _ := res.RowsAffected(); n != 0 {
+				t.Errorf("other tenant deletes %d rows, want 0", n)
+			}
+
+			if workerTables[table] {
+				if _, err := tx.ExecContext(ctx, "select set_config('app.all_tenants', 'on', true)"); err != nil {
+					t.Fatalf("setting access to all tenants: %v", err)
+				}
+				if n := count(); n == 0 {
+					t.Error("workers read 0 rows, want rows of all tenants")
+				}
+				if _, err := tx.ExecContext(ctx, "select set_config('app.all_tenants', '', true)"); err != nil {
+					t.Fatalf("resetting access to all tenants: %v", err)
+				}
+			}
+
+			setTenant(own.tenantID)
+			if n := count(); n == 0 {
+				t.Error("tenant reads 0 own rows, want all")
+			}
+		})
+	}
+}
+
+// seedTenantTables creates rows of the tenant in all tenant tables.
+func seedTenantTables(t *testing.T, a *Adapter, f tenantFixture) {
+	t.Helper()
+	now := time.Now()
+
+	if err := a.DamageReports().Create(f.ctx, bikerental.DamageReport{
+		ID:                 uuid.NewString(),
+		ReservationID:      f.reservationID,
+		BikeID:             f.bikeID,
+		Description:        "flat tire",
+		Severity:           bikerental.DamageSeverityLow,
+		RepairCostEstimate: bikerental.NewMoney(100, "EUR"),
+		CreatedAt:          now,
+	}, nil); err != nil {
+		t.Fatalf("creating damage report: %v", err)
+	}
+
+	code := bikerental.PromoCode{Code: "SEED", Type: bikerental.PromoCodeTypePercentage, Percent: 10, CreatedAt: now}
+	if err := a.PromoCodes().Create(f.ctx, code); err != nil {
+		t.Fatalf("creating promo code: %v", err)
+	}
+
+	if err := inTenantTx(f.ctx, a.db, a.log, func(tx *sqlx.Tx) error {
+		if err := a.PromoCodes().RedeemInTx(f.ctx, tx, code.Code, f.customerID, f.reservationID); err != nil {
+			return err
+		}
+		if err := a.Loyalty().AppendInTx(f.ctx, tx, []bikerental.LoyaltyEntry{{
+			ID:            uuid.NewString(),
+			CustomerID:    f.customerID,
+			ReservationID: f.reservationID,
+			Kind:          bikerental.LoyaltyEntryKindAccrual,
+			Points:        10,
+			CreatedAt:     now,
+		}}); err != nil {
+			return err
+		}
+		if err := a.Notifications().EnqueueInTx(f.ctx, tx, []bikerental.Notification{{
+			ID:            uuid.NewString(),
+			Type:          bikerental.NotificationTypeReservationConfirmed,
+			ReservationID: f.reservationID,
+			Recipient:     "customer@example.com",
+			SendAt:        now.Add(time.Hour),
+			Status:        bikerental.NotificationStatusPending,
+			CreatedAt:     now,
+		}}); err != nil {
+			return err
+		}
+		return a.Events().AppendInTx(f.ctx, tx, []bikerental.Event{newTestEvent(f)})
+	}); err != nil {
+		t.Fatalf("creating reservation data: %v", err)
+	}
+
+	if err := a.Companies().Create(f.ctx, bikerental.Company{ID: uuid.NewString(), Name: "Acme"}); err != nil {
+		t.Fatalf("creating company: %v", err)
+	}
+	if err := a.Blocklist().Create(f.ctx, bikerental.BlocklistEntry{
+		ID:        uuid.NewString(),
+		Kind:      bikerental.BlocklistEntryKindEmailDomain,
+		Value:     "spam.example.com",
+		CreatedAt: now,
+	}); err != nil {
+		t.Fatalf("creating blocklist entry: %v", err)
+	}
+
+	sub := newTestSubscription()
+	if err := a.Webhooks().CreateSubscription(f.ctx, sub); err != nil {
+		t.Fatalf("creating webhook subscription: %v", err)
+	}
+	d := bikerental.WebhookDelivery{
+		ID:             uuid.NewString(),
+		SubscriptionID: sub.ID,
+		Event:          newTestEvent(f),
+		Status:         bikerental.WebhookDeliveryStatusPending,
+		NextAttemptAt:  now.Add(time.Hour),
+		CreatedAt:      now,
+	}
+	if err := a.Webhooks().CreateDeliveries(f.ctx, []bikerental.WebhookDelivery{d}); err != nil {
+		t.Fatalf("creating webhook delivery: %v", err)
+	}
+	d.NextAttemptAt = now.Add(2 * time.Hour)
+	if err := a.Webhooks().RecordAttempt(f.ctx, d, bikerental.WebhookAttempt{AttemptedAt: now, StatusCode: 500}); err != nil {
+		t.Fatalf("recording webhook delivery attempt: %v", err)
+	}
+}
+
+func newTestSubscription() bikerental.WebhookSubscription {
+	return bikerental.WebhookSubscription{
+		ID:        uuid.NewString(),
+		URL:       "https://example.com/webhooks",
+		Secret:    "whsec_test",
+		CreatedAt: time.Now(),
+	}
+}
+
+// newTestEvent returns bike event of the tenant, with unique id.
+func newTestEvent(f tenantFixture) bikerental.Event {
+	return bikerental.Event{
+		ID:            time.Now().UnixNano(),
+		TenantID:      f.tenantID,
+		Type:          bikerental.EventTypeBikeUpdated,
+		AggregateType: bikerental.AggregateTypeBike,
+		AggregateID:   f.bikeID,
+		Payload:       []byte("{}"),
+		OccurredAt:    time.Now(),
+	}
+}
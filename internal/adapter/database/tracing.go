@@ -0,0 +1,34 @@
+package database
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/nglogic/go-application-guide/internal/adapter/database"
+
+// startSpan starts span of a repository method, e.g. "BikesRepository.List".
+// It has to be ended with endSpan.
+func startSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(
+		ctx,
+		name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL),
+	)
+}
+
+// endSpan ends span of a repository method, marking it as failed if the method returned an error.
+// Not found errors are expected, they don't fail spans.
+func endSpan(span trace.Span, err error) {
+	if err != nil && !app.IsNotFoundError(err) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/nglogic/go-application-guide/internal/app"
@@ -25,3 +26,82 @@ func commitTx(ctx context.Context, tx *sqlx.Tx, l logrus.FieldLogger) error {
 	app.AugmentLogFromCtx(ctx, l).Info("postgres tx committed")
 	return nil
 }
+
+// errNoTenant is returned by tenant-scoped repositories called without tenant in context.
+var errNoTenant = errors.New("tenant id missing in context")
+
+// tenantFromCtx returns tenant id from context, or errNoTenant.
+func tenantFromCtx(ctx context.Context) (string, error) {
+	tenantID, ok := app.TenantIDFromCtx(ctx)
+	if !ok {
+		return "", errNoTenant
+	}
+	return tenantID, nil
+}
+
+// beginTenantTx begins transaction scoped to the tenant from context.
+// Tenant is stored in app.tenant_id setting, used by row-level security policies.
+// Repositories set tenant_id columns and filter by tenant in queries too, because policies are not enforced
+// for roles with SUPERUSER or BYPASSRLS attributes.
+func beginTenantTx(ctx context.Context, db *sqlx.DB, opts *sql.TxOptions) (*sqlx.Tx, error) {
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTxx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "select set_config('app.tenant_id', $1, true)", tenantID); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("setting postgresql transaction tenant: %w", err)
+	}
+	return tx, nil
+}
+
+// inTenantTx calls f in transaction scoped to the tenant from context.
+// Transaction is committed if f succeeds.
+func inTenantTx(ctx context.Context, db *sqlx.DB, l logrus.FieldLogger, f func(tx *sqlx.Tx) error) error {
+	tx, err := beginTenantTx(ctx, db, nil)
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(ctx, tx, l) // This will be noop after successful commit.
+
+	if err := f(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing postgres transaction: %w", err)
+	}
+	return nil
+}
+
+// txBeginner begins transactions. It's implemented by db and by its dedicated connections.
+type txBeginner interface {
+	BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error)
+}
+
+// inAllTenantsTx calls f in transaction with access to rows of all tenants, with app.all_tenants setting.
+// Only row-level security policies of worker tables (outbox, notifications, webhook deliveries) allow it.
+// Workers use it to claim rows and process each of them in transaction of its tenant.
+// Transaction is committed if f succeeds.
+func inAllTenantsTx(ctx context.Context, db txBeginner, l logrus.FieldLogger, f func(tx *sqlx.Tx) error) error {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, l) // This will be noop after successful commit.
+
+	if _, err := tx.ExecContext(ctx, "select set_config('app.all_tenants', 'on', true)"); err != nil {
+		return fmt.Errorf("setting postgresql transaction access to all tenants: %w", err)
+	}
+	if err := f(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing postgres transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,528 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+// WebhooksRepository manages webhook subscriptions and deliveries in db.
+type WebhooksRepository struct {
+	db  *sqlx.DB
+	log logrus.FieldLogger
+}
+
+// ListSubscriptions returns all subscriptions of the tenant from context, from the oldest.
+func (r *WebhooksRepository) ListSubscriptions(ctx context.Context) (_ []bikerental.WebhookSubscription, err error) {
+	ctx, span := startSpan(ctx, "WebhooksRepository.ListSubscriptions")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ms []webhookSubscriptionModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(
+			ctx,
+			&ms,
+			"select * from webhook_subscriptions where tenant_id=$1 order by created_at asc",
+			tenantID,
+		)
+	}); err != nil {
+		return nil, fmt.Errorf("querying for webhook subscriptions in postgresql: %w", err)
+	}
+
+	result := make([]bikerental.WebhookSubscription, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, m.ToAppWebhookSubscription())
+	}
+	return result, nil
+}
+
+// GetSubscription returns subscription of the tenant from context by id.
+// Returns app.ErrNotFound if subscription doesn't exist.
+func (r *WebhooksRepository) GetSubscription(ctx context.Context, id string) (_ *bikerental.WebhookSubscription, err error) {
+	ctx, span := startSpan(ctx, "WebhooksRepository.GetSubscription")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var m webhookSubscriptionModel
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.GetContext(ctx, &m, "select * from webhook_subscriptions where id=$1 and tenant_id=$2", id, tenantID)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, app.ErrNotFound
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := m.ToAppWebhookSubscription()
+	return &result, nil
+}
+
+// CreateSubscription creates new subscription of the tenant from context in db.
+func (r *WebhooksRepository) CreateSubscription(ctx context.Context, s bikerental.WebhookSubscription) (err error) {
+	ctx, span := startSpan(ctx, "WebhooksRepository.CreateSubscription")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		_, err := tx.NamedExecContext(
+			ctx,
+			`insert into webhook_subscriptions (id, tenant_id, url, event_types, secret, created_at)
+			values (:id, :tenant_id, :url, :event_types, :secret, :created_at)`,
+			newWebhookSubscriptionModel(tenantID, s),
+		)
+		return err
+	}); err != nil {
+		return fmt.Errorf("inserting webhook subscription row into postgres: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", s.ID).Info("webhook subscription created in db")
+
+	return nil
+}
+
+// DeleteSubscription deletes subscription of the tenant from context with all its deliveries.
+// Returns app.ErrNotFound if subscription doesn't exist.
+func (r *WebhooksRepository) DeleteSubscription(ctx context.Context, id string) (err error) {
+	ctx, span := startSpan(ctx, "WebhooksRepository.DeleteSubscription")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	var rows int64
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		res, err := tx.ExecContext(ctx, "delete from webhook_subscriptions where id=$1 and tenant_id=$2", id, tenantID)
+		if err != nil {
+			return err
+		}
+		rows, _ = res.RowsAffected()
+		return nil
+	}); err != nil {
+		return fmt.Errorf("deleting webhook subscription row from postgres: %w", err)
+	}
+	if rows == 0 {
+		return app.ErrNotFound
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("webhook subscription deleted from db")
+
+	return nil
+}
+
+// CreateDeliveries stores new deliveries of the tenant from context.
+// Deliveries of an event already stored for a subscription are ignored, so events published again are not duplicated.
+// Deliveries for subscriptions of other tenants are rejected by the foreign key.
+func (r *WebhooksRepository) CreateDeliveries(ctx context.Context, deliveries []bikerental.WebhookDelivery) (err error) {
+	ctx, span := startSpan(ctx, "WebhooksRepository.CreateDeliveries")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := beginTenantTx(ctx, r.db, nil)
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	for _, d := range deliveries {
+		if _, err := tx.NamedExecContext(
+			ctx,
+			`insert into webhook_deliveries (
+				id, tenant_id, subscription_id, event_id, event_type, aggregate_type, aggregate_id, payload, occurred_at,
+				status, attempts, next_attempt_at, last_error, created_at
+			) values (
+				:id, :tenant_id, :subscription_id, :event_id, :event_type, :aggregate_type, :aggregate_id, :payload, :occurred_at,
+				:status, :attempts, :next_attempt_at, :last_error, :created_at
+			)
+			on conflict (subscription_id, event_id) do nothing`,
+			newWebhookDeliveryModel(tenantID, d),
+		); err != nil {
+			return fmt.Errorf("inserting webhook delivery row into postgres: %w", err)
+		}
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return fmt.Errorf("committing postgres transaction: %w", err)
+	}
+	return nil
+}
+
+// GetDelivery returns delivery of the tenant from context by id, with its log.
+// Returns app.ErrNotFound if delivery doesn't exist.
+func (r *WebhooksRepository) GetDelivery(ctx context.Context, id string) (_ *bikerental.WebhookDelivery, err error) {
+	ctx, span := startSpan(ctx, "WebhooksRepository.GetDelivery")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ds []bikerental.WebhookDelivery
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		var m webhookDeliveryModel
+		if err := tx.GetContext(ctx, &m, "select * from webhook_deliveries where id=$1 and tenant_id=$2", id, tenantID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return app.ErrNotFound
+			}
+			return fmt.Errorf("querying postgres: %w", err)
+		}
+
+		ds, err = r.withLogs(ctx, tx, tenantID, []webhookDeliveryModel{m})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return &ds[0], nil
+}
+
+// ListDeliveries returns deliveries of the tenant from context with their logs, from the newest.
+func (r *WebhooksRepository) ListDeliveries(
+	ctx context.Context,
+	req bikerental.ListWebhookDeliveriesRequest,
+) (_ []bikerental.WebhookDelivery, err error) {
+	ctx, span := startSpan(ctx, "WebhooksRepository.ListDeliveries")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlq := sqlBuilder.
+		Select("*").
+		From("webhook_deliveries").
+		Where(squirrel.Eq{"subscription_id": req.SubscriptionID, "tenant_id": tenantID}).
+		OrderBy("created_at desc", "event_id desc").
+		Limit(uint64(req.Limit))
+	if req.Status != "" {
+		sqlq = sqlq.Where(squirrel.Eq{"status": req.Status})
+	}
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var result []bikerental.WebhookDelivery
+	if err := inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		var ms []webhookDeliveryModel
+		if err := tx.SelectContext(ctx, &ms, q, args...); err != nil {
+			return fmt.Errorf("querying for webhook deliveries in postgresql: %w", err)
+		}
+		result, err = r.withLogs(ctx, tx, tenantID, ms)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ClaimDue returns pending deliveries of all tenants due at given time, from the oldest, and locks them
+// for lease duration, so concurrent workers don't send them twice. Logs of claimed deliveries are not loaded.
+// Deliveries not updated before the lease expires are returned again.
+// Claimed deliveries have to be updated in context of their tenant, it's set in their events.
+func (r *WebhooksRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+	lease time.Duration,
+) (_ []bikerental.WebhookDelivery, err error) {
+	ctx, span := startSpan(ctx, "WebhooksRepository.ClaimDue")
+	defer func() { endSpan(span, err) }()
+
+	var ms []webhookDeliveryModel
+	if err := inAllTenantsTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return tx.SelectContext(
+			ctx,
+			&ms,
+			`update webhook_deliveries set locked_until = $2
+			where id in (
+				select id from webhook_deliveries
+				where status = 'pending' and next_attempt_at <= $1 and (locked_until is null or locked_until <= $1)
+				order by next_attempt_at
+				limit $3
+				for update skip locked
+			)
+			returning *`,
+			now, now.Add(lease), limit,
+		)
+	}); err != nil {
+		return nil, fmt.Errorf("claiming webhook deliveries in postgres: %w", err)
+	}
+
+	result := make([]bikerental.WebhookDelivery, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, m.ToAppWebhookDelivery())
+	}
+	return result, nil
+}
+
+// RecordAttempt stores status of delivery of the tenant from context
+// and appends the attempt to its log in one transaction. Delivery lock is released.
+// Returns app.ErrNotFound if delivery doesn't exist.
+func (r *WebhooksRepository) RecordAttempt(
+	ctx context.Context,
+	d bikerental.WebhookDelivery,
+	attempt bikerental.WebhookAttempt,
+) (err error) {
+	ctx, span := startSpan(ctx, "WebhooksRepository.RecordAttempt")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := beginTenantTx(ctx, r.db, nil)
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	if err := r.updateDelivery(ctx, tx, tenantID, d); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`insert into webhook_delivery_attempts (tenant_id, delivery_id, attempted_at, status_code, error, duration_ms)
+		values ($1, $2, $3, $4, $5, $6)`,
+		tenantID, d.ID, attempt.AttemptedAt, attempt.StatusCode, attempt.Error, attempt.Duration.Milliseconds(),
+	); err != nil {
+		return fmt.Errorf("inserting webhook delivery attempt row into postgres: %w", err)
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("id", d.ID).
+		WithField("status", d.Status).
+		WithField("statusCode", attempt.StatusCode).
+		Info("webhook delivery attempt recorded in db")
+
+	return nil
+}
+
+// Reschedule stores status and schedule of delivery of the tenant from context, without log entry.
+// Delivery lock is released.
+// Returns app.ErrNotFound if delivery doesn't exist.
+func (r *WebhooksRepository) Reschedule(ctx context.Context, d bikerental.WebhookDelivery) (err error) {
+	ctx, span := startSpan(ctx, "WebhooksRepository.Reschedule")
+	defer func() { endSpan(span, err) }()
+
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	return inTenantTx(ctx, r.db, r.log, func(tx *sqlx.Tx) error {
+		return r.updateDelivery(ctx, tx, tenantID, d)
+	})
+}
+
+func (r *WebhooksRepository) updateDelivery(ctx context.Context, tx *sqlx.Tx, tenantID string, d bikerental.WebhookDelivery) error {
+	res, err := tx.NamedExecContext(
+		ctx,
+		`update webhook_deliveries set
+			status=:status, attempts=:attempts, next_attempt_at=:next_attempt_at, last_error=:last_error,
+			delivered_at=:delivered_at, locked_until=null
+		where id=:id and tenant_id=:tenant_id`,
+		newWebhookDeliveryModel(tenantID, d),
+	)
+	if err != nil {
+		return fmt.Errorf("updating webhook delivery in postgres: %w", err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return app.ErrNotFound
+	}
+	return nil
+}
+
+// withLogs returns deliveries with their attempts logs, using existing transaction.
+func (r *WebhooksRepository) withLogs(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	tenantID string,
+	ms []webhookDeliveryModel,
+) ([]bikerental.WebhookDelivery, error) {
+	result := make([]bikerental.WebhookDelivery, 0, len(ms))
+	if len(ms) == 0 {
+		return result, nil
+	}
+
+	ids := make([]string, 0, len(ms))
+	for _, m := range ms {
+		ids = append(ids, m.ID)
+	}
+	q, args, err := sqlBuilder.
+		Select("*").
+		From("webhook_delivery_attempts").
+		Where(squirrel.Eq{"delivery_id": ids, "tenant_id": tenantID}).
+		OrderBy("attempted_at asc", "id asc").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var attempts []webhookAttemptModel
+	if err := tx.SelectContext(ctx, &attempts, q, args...); err != nil {
+		return nil, fmt.Errorf("querying for webhook delivery attempts in postgresql: %w", err)
+	}
+	logs := make(map[string][]bikerental.WebhookAttempt)
+	for _, a := range attempts {
+		logs[a.DeliveryID] = append(logs[a.DeliveryID], a.ToAppWebhookAttempt())
+	}
+
+	for _, m := range ms {
+		d := m.ToAppWebhookDelivery()
+		d.Log = logs[m.ID]
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+type webhookSubscriptionModel struct {
+	ID         string         `db:"id"`
+	TenantID   string         `db:"tenant_id"`
+	URL        string         `db:"url"`
+	EventTypes pq.StringArray `db:"event_types"`
+	Secret     string         `db:"secret"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
+
+func newWebhookSubscriptionModel(tenantID string, s bikerental.WebhookSubscription) webhookSubscriptionModel {
+	types := make(pq.StringArray, 0, len(s.EventTypes))
+	for _, t := range s.EventTypes {
+		types = append(types, string(t))
+	}
+	return webhookSubscriptionModel{
+		ID:         s.ID,
+		TenantID:   tenantID,
+		URL:        s.URL,
+		EventTypes: types,
+		Secret:     s.Secret,
+		CreatedAt:  s.CreatedAt,
+	}
+}
+
+func (m *webhookSubscriptionModel) ToAppWebhookSubscription() bikerental.WebhookSubscription {
+	var types []bikerental.EventType
+	for _, t := range m.EventTypes {
+		types = append(types, bikerental.EventType(t))
+	}
+	return bikerental.WebhookSubscription{
+		ID:         m.ID,
+		URL:        m.URL,
+		EventTypes: types,
+		Secret:     m.Secret,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+type webhookDeliveryModel struct {
+	ID             string       `db:"id"`
+	TenantID       string       `db:"tenant_id"`
+	SubscriptionID string       `db:"subscription_id"`
+	EventID        int64        `db:"event_id"`
+	EventType      string       `db:"event_type"`
+	AggregateType  string       `db:"aggregate_type"`
+	AggregateID    string       `db:"aggregate_id"`
+	Payload        []byte       `db:"payload"`
+	OccurredAt     time.Time    `db:"occurred_at"`
+	Status         string       `db:"status"`
+	Attempts       int          `db:"attempts"`
+	NextAttemptAt  time.Time    `db:"next_attempt_at"`
+	LastError      string       `db:"last_error"`
+	LockedUntil    sql.NullTime `db:"locked_until"`
+	CreatedAt      time.Time    `db:"created_at"`
+	DeliveredAt    sql.NullTime `db:"delivered_at"`
+}
+
+func newWebhookDeliveryModel(tenantID string, d bikerental.WebhookDelivery) webhookDeliveryModel {
+	return webhookDeliveryModel{
+		ID:             d.ID,
+		TenantID:       tenantID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.Event.ID,
+		EventType:      string(d.Event.Type),
+		AggregateType:  d.Event.AggregateType,
+		AggregateID:    d.Event.AggregateID,
+		Payload:        d.Event.Payload,
+		OccurredAt:     d.Event.OccurredAt,
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    sql.NullTime{Time: d.DeliveredAt, Valid: !d.DeliveredAt.IsZero()},
+	}
+}
+
+func (m *webhookDeliveryModel) ToAppWebhookDelivery() bikerental.WebhookDelivery {
+	return bikerental.WebhookDelivery{
+		ID:             m.ID,
+		SubscriptionID: m.SubscriptionID,
+		Event: bikerental.Event{
+			ID:            m.EventID,
+			TenantID:      m.TenantID,
+			Type:          bikerental.EventType(m.EventType),
+			AggregateType: m.AggregateType,
+			AggregateID:   m.AggregateID,
+			Payload:       m.Payload,
+			OccurredAt:    m.OccurredAt,
+		},
+		Status:        bikerental.WebhookDeliveryStatus(m.Status),
+		Attempts:      m.Attempts,
+		NextAttemptAt: m.NextAttemptAt,
+		LastError:     m.LastError,
+		CreatedAt:     m.CreatedAt,
+		DeliveredAt:   m.DeliveredAt.Time,
+	}
+}
+
+type webhookAttemptModel struct {
+	ID          int64     `db:"id"`
+	DeliveryID  string    `db:"delivery_id"`
+	AttemptedAt time.Time `db:"attempted_at"`
+	StatusCode  int       `db:"status_code"`
+	Error       string    `db:"error"`
+	DurationMs  int64     `db:"duration_ms"`
+}
+
+func (m *webhookAttemptModel) ToAppWebhookAttempt() bikerental.WebhookAttempt {
+	return bikerental.WebhookAttempt{
+		AttemptedAt: m.AttemptedAt,
+		StatusCode:  m.StatusCode,
+		Error:       m.Error,
+		Duration:    time.Duration(m.DurationMs) * time.Millisecond,
+	}
+}
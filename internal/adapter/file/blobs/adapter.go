@@ -0,0 +1,104 @@
+package blobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+const (
+	dirPerm  = 0o750
+	filePerm = 0o640
+)
+
+// Adapter is a blob store keeping objects as files in local filesystem.
+// Object key is a relative path of the file inside root directory.
+type Adapter struct {
+	rootDir string
+}
+
+// NewAdapter creates new adapter instance.
+// Root directory is created if it doesn't exist.
+func NewAdapter(rootDir string) (*Adapter, error) {
+	if rootDir == "" {
+		return nil, errors.New("root directory is required")
+	}
+	if err := os.MkdirAll(rootDir, dirPerm); err != nil {
+		return nil, fmt.Errorf("creating root directory: %w", err)
+	}
+
+	return &Adapter{
+		rootDir: rootDir,
+	}, nil
+}
+
+// Put saves an object under the key. Existing object is overwritten.
+func (a *Adapter) Put(ctx context.Context, key string, data []byte) error {
+	p, err := a.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), dirPerm); err != nil {
+		return fmt.Errorf("creating object directory: %w", err)
+	}
+
+	// Write to temporary file first, so readers never see partially written object.
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, data, filePerm); err != nil {
+		return fmt.Errorf("writing object file: %w", err)
+	}
+	if err := os.Rename(tmp, p); err != nil {
+		return fmt.Errorf("renaming object file: %w", err)
+	}
+	return nil
+}
+
+// Get returns an object by key.
+// Returns app.ErrNotFound if object doesn't exist.
+func (a *Adapter) Get(ctx context.Context, key string) ([]byte, error) {
+	p, err := a.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, app.ErrNotFound
+		}
+		return nil, fmt.Errorf("reading object file: %w", err)
+	}
+	return data, nil
+}
+
+// Delete deletes an object by key. Deleting object that doesn't exist is not an error.
+func (a *Adapter) Delete(ctx context.Context, key string) error {
+	p, err := a.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("removing object file: %w", err)
+	}
+	return nil
+}
+
+// path returns absolute path of the object file.
+// It makes sure that key doesn't point outside the root directory.
+func (a *Adapter) path(key string) (string, error) {
+	if key == "" {
+		return "", errors.New("empty key")
+	}
+	clean := filepath.Clean(filepath.FromSlash(key))
+	if filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid key: '%s'", key)
+	}
+	return filepath.Join(a.rootDir, clean), nil
+}
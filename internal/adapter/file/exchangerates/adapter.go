@@ -0,0 +1,77 @@
+package exchangerates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/adapter/file"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Adapter provides static exchange rates defined in a json file.
+// File is read once, when adapter is created. See configs/exchangerates/rates.json for an example.
+type Adapter struct {
+	// rates are prices of one unit of base currency in other currencies.
+	rates map[bikerental.Currency]float64
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(path string) (*Adapter, error) {
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+
+	var data ratesFile
+	if err := file.ReadJSON(path, &data); err != nil {
+		return nil, fmt.Errorf("reading exchange rates: %w", err)
+	}
+
+	base := bikerental.Currency(data.Base)
+	if err := base.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid base currency: %w", err)
+	}
+
+	a := &Adapter{
+		rates: map[bikerental.Currency]float64{base: 1},
+	}
+	for code, rate := range data.Rates {
+		currency := bikerental.Currency(code)
+		if err := currency.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid rate: %w", err)
+		}
+		if rate <= 0 {
+			return nil, fmt.Errorf("invalid rate of %s: has to be positive", code)
+		}
+		if currency == base && rate != 1 {
+			return nil, fmt.Errorf("invalid rate of base currency %s: has to be 1", code)
+		}
+		a.rates[currency] = rate
+	}
+
+	return a, nil
+}
+
+// GetRate returns a price of one unit of `from` currency in `to` currency.
+// Returns app.ErrNotFound if any of currencies is unknown.
+func (a *Adapter) GetRate(ctx context.Context, from, to bikerental.Currency) (float64, error) {
+	fromRate, ok := a.rates[from]
+	if !ok {
+		return 0, fmt.Errorf("currency %s: %w", from, app.ErrNotFound)
+	}
+	toRate, ok := a.rates[to]
+	if !ok {
+		return 0, fmt.Errorf("currency %s: %w", to, app.ErrNotFound)
+	}
+	if from == to {
+		return 1, nil
+	}
+	return toRate / fromRate, nil
+}
+
+type ratesFile struct {
+	// Base is a currency code, rates are prices of its one unit.
+	Base  string             `json:"base"`
+	Rates map[string]float64 `json:"rates"`
+}
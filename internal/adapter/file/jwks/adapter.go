@@ -0,0 +1,281 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// Supported signing algorithms.
+const (
+	algHS256 = "HS256"
+	algRS256 = "RS256"
+)
+
+// leeway is a tolerance of clock differences when checking token times.
+const leeway = time.Minute
+
+// Adapter verifies HS256 and RS256 JWTs with keys from a JWKS file.
+// File is read once, when adapter is created. See configs/auth/jwks.json for an example.
+//
+// Tokens have to contain "sub", "tenant_id", "role" and "exp" claims.
+// Subject of tokens with "customer" role is a customer id.
+// Only "admin" tokens can skip "tenant_id", they are platform admin tokens valid for all tenants.
+type Adapter struct {
+	keys []key
+
+	// Optional expected "iss" and "aud" claims.
+	issuer   string
+	audience string
+}
+
+type key struct {
+	id        string
+	alg       string
+	secret    []byte
+	publicKey *rsa.PublicKey
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(path, issuer, audience string) (*Adapter, error) {
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+
+	// Unknown fields are allowed, JWKS exported from identity providers contain fields we don't need.
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading jwks file '%s': %w", path, err)
+	}
+	var data jwksFile
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("decoding jwks from file '%s': %w", path, err)
+	}
+
+	a := &Adapter{
+		issuer:   issuer,
+		audience: audience,
+	}
+	for i, jk := range data.Keys {
+		k, err := jk.toKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key #%d: %w", i, err)
+		}
+		a.keys = append(a.keys, k)
+	}
+	return a, nil
+}
+
+// VerifyToken verifies token signature and claims, and returns identity of the token subject.
+// Returns app.ErrUnauthenticated if token is invalid.
+func (a *Adapter) VerifyToken(_ context.Context, token string) (app.Identity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return app.Identity{}, unauthenticated("malformed token")
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return app.Identity{}, unauthenticated("malformed token header")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return app.Identity{}, unauthenticated("malformed token signature")
+	}
+	if !a.verifySignature(h, []byte(parts[0]+"."+parts[1]), sig) {
+		return app.Identity{}, unauthenticated("invalid token signature")
+	}
+
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return app.Identity{}, unauthenticated("malformed token claims")
+	}
+	if err := a.validateClaims(c, time.Now()); err != nil {
+		return app.Identity{}, err
+	}
+
+	return app.Identity{
+		Subject:  c.Subject,
+		TenantID: c.TenantID,
+		Method:   app.AuthMethodJWT,
+		Role:     c.Role,
+	}, nil
+}
+
+// verifySignature checks signature with keys matching token algorithm and key id.
+// Key algorithm has to match, so public RSA keys can't be used as HMAC secrets.
+func (a *Adapter) verifySignature(h header, input, sig []byte) bool {
+	for _, k := range a.keys {
+		if k.alg != h.Alg || (h.Kid != "" && k.id != h.Kid) {
+			continue
+		}
+		switch k.alg {
+		case algHS256:
+			mac := hmac.New(sha256.New, k.secret)
+			mac.Write(input)
+			if hmac.Equal(mac.Sum(nil), sig) {
+				return true
+			}
+		case algRS256:
+			digest := sha256.Sum256(input)
+			if rsa.VerifyPKCS1v15(k.publicKey, crypto.SHA256, digest[:], sig) == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (a *Adapter) validateClaims(c claims, now time.Time) error {
+	if c.Subject == "" {
+		return unauthenticated("token has no subject")
+	}
+	if err := c.Role.Validate(); err != nil {
+		return unauthenticated("token has invalid role")
+	}
+	if c.TenantID == "" && c.Role != app.RoleAdmin {
+		return unauthenticated("token has no tenant")
+	}
+	if c.ExpiresAt == 0 {
+		return unauthenticated("token has no expiration time")
+	}
+	if now.After(time.Unix(c.ExpiresAt, 0).Add(leeway)) {
+		return unauthenticated("token expired")
+	}
+	if c.NotBefore != 0 && now.Before(time.Unix(c.NotBefore, 0).Add(-leeway)) {
+		return unauthenticated("token not valid yet")
+	}
+	if a.issuer != "" && c.Issuer != a.issuer {
+		return unauthenticated("invalid token issuer")
+	}
+	if a.audience != "" && !c.Audience.contains(a.audience) {
+		return unauthenticated("invalid token audience")
+	}
+	return nil
+}
+
+func unauthenticated(reason string) error {
+	return fmt.Errorf("%w: %s", app.ErrUnauthenticated, reason)
+}
+
+func decodeSegment(s string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type claims struct {
+	Subject   string   `json:"sub"`
+	TenantID  string   `json:"tenant_id"`
+	Role      app.Role `json:"role"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+}
+
+// audience is "aud" claim, which can be a string or an array of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*a = many
+	return nil
+}
+
+func (a audience) contains(s string) bool {
+	for _, v := range a {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+type jwksFile struct {
+	Keys []jwk `json:"keys"`
+}
+
+// jwk is a JSON Web Key, as defined in RFC 7517. Only "oct" and "RSA" key types are supported.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+
+	// K is a secret of symmetric key.
+	K string `json:"k"`
+
+	// N and E are modulus and exponent of RSA public key.
+	N string `json:"n"`
+	E string `json:"e"`
+}
+
+func (k jwk) toKey() (key, error) {
+	switch k.Kty {
+	case "oct":
+		if k.Alg != "" && k.Alg != algHS256 {
+			return key{}, fmt.Errorf("unsupported algorithm '%s' of oct key", k.Alg)
+		}
+		secret, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil {
+			return key{}, fmt.Errorf("decoding secret: %w", err)
+		}
+		if len(secret) < sha256.Size {
+			return key{}, fmt.Errorf("secret has to have at least %d bytes", sha256.Size)
+		}
+		return key{id: k.Kid, alg: algHS256, secret: secret}, nil
+	case "RSA":
+		if k.Alg != "" && k.Alg != algRS256 {
+			return key{}, fmt.Errorf("unsupported algorithm '%s' of RSA key", k.Alg)
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return key{}, fmt.Errorf("decoding modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return key{}, fmt.Errorf("decoding exponent: %w", err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 3 {
+			return key{}, errors.New("invalid exponent")
+		}
+		return key{
+			id:  k.Kid,
+			alg: algRS256,
+			publicKey: &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(exponent.Int64()),
+			},
+		}, nil
+	default:
+		return key{}, fmt.Errorf("unsupported key type '%s'", k.Kty)
+	}
+}
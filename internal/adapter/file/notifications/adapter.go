@@ -0,0 +1,100 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Names of templates that each template file has to define.
+const (
+	subjectTemplate = "subject"
+	bodyTemplate    = "body"
+)
+
+// notificationTypes are types of notifications that need templates.
+var notificationTypes = []bikerental.NotificationType{
+	bikerental.NotificationTypeReservationConfirmed,
+	bikerental.NotificationTypeReservationReminder,
+	bikerental.NotificationTypeReservationCanceled,
+	bikerental.NotificationTypeLateReturnWarning,
+}
+
+// Adapter renders notification messages with templates from a directory.
+// Each notification type has its own "<type>.tmpl" file defining "subject" and "body" templates,
+// see configs/notifications. Templates are read once, when adapter is created.
+type Adapter struct {
+	templates map[bikerental.NotificationType]*template.Template
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(dir string) (*Adapter, error) {
+	if dir == "" {
+		return nil, errors.New("templates directory is required")
+	}
+
+	a := &Adapter{
+		templates: make(map[bikerental.NotificationType]*template.Template, len(notificationTypes)),
+	}
+	for _, t := range notificationTypes {
+		path := filepath.Join(dir, string(t)+".tmpl")
+		tmpl, err := template.New(filepath.Base(path)).Funcs(templateFuncs).ParseFiles(path)
+		if err != nil {
+			return nil, fmt.Errorf("parsing template of %s notification: %w", t, err)
+		}
+		for _, name := range []string{subjectTemplate, bodyTemplate} {
+			if tmpl.Lookup(name) == nil {
+				return nil, fmt.Errorf("template of %s notification doesn't define '%s'", t, name)
+			}
+		}
+		a.templates[t] = tmpl
+	}
+	return a, nil
+}
+
+// templateData is passed to templates.
+type templateData struct {
+	Notification bikerental.Notification
+	Reservation  bikerental.Reservation
+}
+
+// Render returns message for the notification.
+func (a *Adapter) Render(ctx context.Context, n bikerental.Notification, r bikerental.Reservation) (*bikerental.Message, error) {
+	tmpl, ok := a.templates[n.Type]
+	if !ok {
+		return nil, fmt.Errorf("no template for notification type: '%s'", n.Type)
+	}
+
+	data := templateData{
+		Notification: n,
+		Reservation:  r,
+	}
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, subjectTemplate, data); err != nil {
+		return nil, fmt.Errorf("executing subject template: %w", err)
+	}
+	if err := tmpl.ExecuteTemplate(&body, bodyTemplate, data); err != nil {
+		return nil, fmt.Errorf("executing body template: %w", err)
+	}
+
+	return &bikerental.Message{
+		To:      n.Recipient,
+		Subject: strings.TrimSpace(subject.String()),
+		Body:    strings.TrimSpace(body.String()) + "\n",
+	}, nil
+}
+
+// templateFuncs are functions available in templates.
+var templateFuncs = template.FuncMap{
+	"money": bikerental.Money.Format,
+	"time": func(t time.Time) string {
+		return t.UTC().Format("2006-01-02 15:04 UTC")
+	},
+}
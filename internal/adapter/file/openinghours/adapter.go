@@ -0,0 +1,166 @@
+package openinghours
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/adapter/file"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Adapter provides opening hours calendars defined in a json file.
+// File is read once, when adapter is created. See configs/openinghours/calendars.json for an example.
+type Adapter struct {
+	byStationID map[string]bikerental.OpeningHours
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(path string) (*Adapter, error) {
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+
+	var data calendarsFile
+	if err := file.ReadJSON(path, &data); err != nil {
+		return nil, fmt.Errorf("reading opening hours: %w", err)
+	}
+
+	a := &Adapter{
+		byStationID: make(map[string]bikerental.OpeningHours),
+	}
+	for i, c := range data.Calendars {
+		calendar, err := c.ToAppOpeningHours()
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar #%d: %w", i, err)
+		}
+		if _, ok := a.byStationID[calendar.StationID]; ok {
+			return nil, fmt.Errorf("invalid calendar #%d: duplicated station id '%s'", i, calendar.StationID)
+		}
+		a.byStationID[calendar.StationID] = calendar
+	}
+
+	return a, nil
+}
+
+// GetOpeningHours returns calendar of a station. Empty station id means global calendar.
+// Returns app.ErrNotFound if there is no calendar for the station.
+func (a *Adapter) GetOpeningHours(ctx context.Context, stationID string) (*bikerental.OpeningHours, error) {
+	if c, ok := a.byStationID[stationID]; ok {
+		return &c, nil
+	}
+	return nil, app.ErrNotFound
+}
+
+type calendarsFile struct {
+	Calendars []calendarEntry `json:"calendars"`
+}
+
+type calendarEntry struct {
+	// StationID is empty for global calendar.
+	StationID string `json:"stationId"`
+	TimeZone  string `json:"timeZone"`
+
+	// Weekly is keyed by lowercase english weekday names, e.g. "monday".
+	Weekly   map[string][]clockRangeEntry `json:"weekly"`
+	Holidays []holidayEntry               `json:"holidays"`
+}
+
+type clockRangeEntry struct {
+	// From and To are in "HH:MM" format. "24:00" means the end of a day.
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type holidayEntry struct {
+	Name string `json:"name"`
+	// Date is in "YYYY-MM-DD" format.
+	Date  string            `json:"date"`
+	Hours []clockRangeEntry `json:"hours"`
+}
+
+func (e *calendarEntry) ToAppOpeningHours() (bikerental.OpeningHours, error) {
+	loc := time.UTC
+	if e.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(e.TimeZone); err != nil {
+			return bikerental.OpeningHours{}, fmt.Errorf("loading time zone: %w", err)
+		}
+	}
+
+	c := bikerental.OpeningHours{
+		StationID: e.StationID,
+		Location:  loc,
+		Weekly:    make(map[time.Weekday][]bikerental.ClockRange),
+	}
+	for name, ranges := range e.Weekly {
+		wd, err := parseWeekday(name)
+		if err != nil {
+			return bikerental.OpeningHours{}, err
+		}
+		if c.Weekly[wd], err = parseClockRanges(ranges); err != nil {
+			return bikerental.OpeningHours{}, fmt.Errorf("invalid hours on %s: %w", name, err)
+		}
+	}
+	for _, h := range e.Holidays {
+		date, err := time.Parse("2006-01-02", h.Date)
+		if err != nil {
+			return bikerental.OpeningHours{}, fmt.Errorf("invalid holiday '%s' date, expected YYYY-MM-DD format: %w", h.Name, err)
+		}
+		hours, err := parseClockRanges(h.Hours)
+		if err != nil {
+			return bikerental.OpeningHours{}, fmt.Errorf("invalid holiday '%s' hours: %w", h.Name, err)
+		}
+		c.Holidays = append(c.Holidays, bikerental.Holiday{
+			Name:  h.Name,
+			Year:  date.Year(),
+			Month: date.Month(),
+			Day:   date.Day(),
+			Hours: hours,
+		})
+	}
+
+	return c, nil
+}
+
+func parseWeekday(v string) (time.Weekday, error) {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if strings.EqualFold(wd.String(), v) {
+			return wd, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday '%s'", v)
+}
+
+func parseClockRanges(entries []clockRangeEntry) ([]bikerental.ClockRange, error) {
+	result := make([]bikerental.ClockRange, 0, len(entries))
+	for _, e := range entries {
+		from, err := parseClock(e.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := parseClock(e.To)
+		if err != nil {
+			return nil, err
+		}
+		if from.Hour*60+from.Minute >= to.Hour*60+to.Minute {
+			return nil, fmt.Errorf("invalid range %s-%s", e.From, e.To)
+		}
+		result = append(result, bikerental.ClockRange{From: from, To: to})
+	}
+	return result, nil
+}
+
+func parseClock(v string) (bikerental.Clock, error) {
+	var hour, minute int
+	if _, err := fmt.Sscanf(v, "%02d:%02d", &hour, &minute); err != nil {
+		return bikerental.Clock{}, fmt.Errorf("parsing '%s', expected HH:MM format: %w", v, err)
+	}
+	if hour < 0 || minute < 0 || minute > 59 || hour > 24 || (hour == 24 && minute != 0) {
+		return bikerental.Clock{}, fmt.Errorf("invalid time '%s'", v)
+	}
+	return bikerental.Clock{Hour: hour, Minute: minute}, nil
+}
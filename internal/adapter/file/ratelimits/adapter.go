@@ -0,0 +1,75 @@
+package ratelimits
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/adapter/file"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Adapter provides rate limit policy defined in a json file.
+// File is read once, when adapter is created. See configs/ratelimits/policy.json for an example.
+type Adapter struct {
+	policy bikerental.RateLimitPolicy
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(path string) (*Adapter, error) {
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+
+	var data policyFile
+	if err := file.ReadJSON(path, &data); err != nil {
+		return nil, fmt.Errorf("reading rate limit policy: %w", err)
+	}
+
+	policy := data.ToAppRateLimitPolicy()
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid rate limit policy: %w", err)
+	}
+	return &Adapter{
+		policy: policy,
+	}, nil
+}
+
+// Policy returns rate limit policy.
+func (a *Adapter) Policy() bikerental.RateLimitPolicy {
+	return a.policy
+}
+
+type policyFile struct {
+	IP      limitEntry `json:"ip"`
+	Default limitEntry `json:"default"`
+
+	// Methods are keyed by rpc method names.
+	Methods    map[string]limitEntry `json:"methods"`
+	DailyQuota int64                 `json:"dailyQuota"`
+}
+
+type limitEntry struct {
+	// Rate is a number of calls per second.
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+func (f policyFile) ToAppRateLimitPolicy() bikerental.RateLimitPolicy {
+	p := bikerental.RateLimitPolicy{
+		IP:         f.IP.ToAppRateLimit(),
+		Default:    f.Default.ToAppRateLimit(),
+		Methods:    make(map[string]bikerental.RateLimit, len(f.Methods)),
+		DailyQuota: f.DailyQuota,
+	}
+	for m, l := range f.Methods {
+		p.Methods[m] = l.ToAppRateLimit()
+	}
+	return p
+}
+
+func (e limitEntry) ToAppRateLimit() bikerental.RateLimit {
+	return bikerental.RateLimit{
+		Rate:  e.Rate,
+		Burst: e.Burst,
+	}
+}
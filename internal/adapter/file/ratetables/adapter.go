@@ -0,0 +1,152 @@
+package ratetables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/adapter/file"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Adapter provides rate tables defined in a json file.
+// File is read once, when adapter is created. See configs/pricing/ratetables.json for an example.
+type Adapter struct {
+	byBikeID    map[string]bikerental.RateTable
+	byModelName map[string]bikerental.RateTable
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(path string) (*Adapter, error) {
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+
+	var data rateTablesFile
+	if err := file.ReadJSON(path, &data); err != nil {
+		return nil, fmt.Errorf("reading rate tables: %w", err)
+	}
+
+	a := &Adapter{
+		byBikeID:    make(map[string]bikerental.RateTable),
+		byModelName: make(map[string]bikerental.RateTable),
+	}
+	for i, t := range data.RateTables {
+		table, err := t.ToAppRateTable()
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate table #%d: %w", i, err)
+		}
+		switch {
+		case table.BikeID != "":
+			a.byBikeID[table.BikeID] = table
+		case table.ModelName != "":
+			a.byModelName[table.ModelName] = table
+		default:
+			return nil, fmt.Errorf("invalid rate table #%d: bike id or model name is required", i)
+		}
+	}
+
+	return a, nil
+}
+
+// GetRateTable returns rate table for a bike.
+// Returns app.ErrNotFound if there is no rate table for the bike.
+func (a *Adapter) GetRateTable(ctx context.Context, bike bikerental.Bike) (*bikerental.RateTable, error) {
+	if t, ok := a.byBikeID[bike.ID]; ok {
+		return &t, nil
+	}
+	if t, ok := a.byModelName[bike.ModelName]; ok {
+		return &t, nil
+	}
+	return nil, app.ErrNotFound
+}
+
+type rateTablesFile struct {
+	RateTables []rateTableEntry `json:"rateTables"`
+}
+
+type rateTableEntry struct {
+	BikeID                 string           `json:"bikeId"`
+	ModelName              string           `json:"modelName"`
+	TimeZone               string           `json:"timeZone"`
+	PricePerHour           int64            `json:"pricePerHour"`
+	PeakHours              []hourRangeEntry `json:"peakHours"`
+	PeakMultiplier         float64          `json:"peakMultiplier"`
+	OffPeakMultiplier      float64          `json:"offPeakMultiplier"`
+	WeekendMultiplier      float64          `json:"weekendMultiplier"`
+	Seasons                []seasonEntry    `json:"seasons"`
+	MaxChargedHoursPerDay  float64          `json:"maxChargedHoursPerDay"`
+	MaxChargedHoursPerWeek float64          `json:"maxChargedHoursPerWeek"`
+}
+
+type hourRangeEntry struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+type seasonEntry struct {
+	Name string `json:"name"`
+	// From and To are in "MM-DD" format.
+	From       string  `json:"from"`
+	To         string  `json:"to"`
+	Multiplier float64 `json:"multiplier"`
+}
+
+func (e *rateTableEntry) ToAppRateTable() (bikerental.RateTable, error) {
+	loc := time.UTC
+	if e.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(e.TimeZone); err != nil {
+			return bikerental.RateTable{}, fmt.Errorf("loading time zone: %w", err)
+		}
+	}
+
+	t := bikerental.RateTable{
+		BikeID:                 e.BikeID,
+		ModelName:              e.ModelName,
+		Location:               loc,
+		PricePerHour:           e.PricePerHour,
+		PeakMultiplier:         e.PeakMultiplier,
+		OffPeakMultiplier:      e.OffPeakMultiplier,
+		WeekendMultiplier:      e.WeekendMultiplier,
+		MaxChargedHoursPerDay:  e.MaxChargedHoursPerDay,
+		MaxChargedHoursPerWeek: e.MaxChargedHoursPerWeek,
+	}
+	for _, r := range e.PeakHours {
+		if r.From < 0 || r.To > 24 || r.From >= r.To {
+			return bikerental.RateTable{}, fmt.Errorf("invalid peak hours: %d-%d", r.From, r.To)
+		}
+		t.PeakHours = append(t.PeakHours, bikerental.HourRange{From: r.From, To: r.To})
+	}
+	for _, s := range e.Seasons {
+		from, err := parseMonthDay(s.From)
+		if err != nil {
+			return bikerental.RateTable{}, fmt.Errorf("invalid season '%s' start: %w", s.Name, err)
+		}
+		to, err := parseMonthDay(s.To)
+		if err != nil {
+			return bikerental.RateTable{}, fmt.Errorf("invalid season '%s' end: %w", s.Name, err)
+		}
+		t.Seasons = append(t.Seasons, bikerental.Season{
+			Name:       s.Name,
+			From:       from,
+			To:         to,
+			Multiplier: s.Multiplier,
+		})
+	}
+
+	return t, nil
+}
+
+func parseMonthDay(v string) (bikerental.MonthDay, error) {
+	var month, day int
+	if _, err := fmt.Sscanf(v, "%02d-%02d", &month, &day); err != nil {
+		return bikerental.MonthDay{}, fmt.Errorf("parsing '%s', expected MM-DD format: %w", v, err)
+	}
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return bikerental.MonthDay{}, fmt.Errorf("invalid date '%s'", v)
+	}
+	return bikerental.MonthDay{Month: time.Month(month), Day: day}, nil
+}
@@ -0,0 +1,25 @@
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ReadJSON reads json data from a file.
+// Json is unmarshalled to the `result` object (it usually should be a pointer!).
+func ReadJSON(path string, result interface{}) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening file '%s': %w", path, err)
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(result); err != nil {
+		return fmt.Errorf("decoding json from file '%s': %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package receipts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Template file names in brand directories.
+const (
+	htmlTemplateFile = "receipt.html"
+	textTemplateFile = "receipt.txt"
+)
+
+// defaultBrand is a name of directory with default templates.
+const defaultBrand = "default"
+
+// Adapter renders receipts with templates from a directory.
+// Each brand has its own subdirectory with templates overriding default ones, see configs/receipts/default.
+// HTML receipts use html/template, PDF receipts are plain text documents rendered with text/template.
+// Templates are read once, when adapter is created.
+type Adapter struct {
+	brands map[string]brandTemplates
+}
+
+type brandTemplates struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(dir string) (*Adapter, error) {
+	if dir == "" {
+		return nil, errors.New("templates directory is required")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading templates directory: %w", err)
+	}
+
+	a := &Adapter{
+		brands: make(map[string]brandTemplates),
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		t, err := parseBrandTemplates(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("parsing templates of brand '%s': %w", e.Name(), err)
+		}
+		a.brands[e.Name()] = t
+	}
+
+	def, ok := a.brands[defaultBrand]
+	if !ok || def.html == nil || def.text == nil {
+		return nil, fmt.Errorf("default brand needs both %s and %s templates", htmlTemplateFile, textTemplateFile)
+	}
+	return a, nil
+}
+
+// Render returns receipt document in given format, using templates of the brand.
+// Templates missing for the brand, or unknown brand, fall back to default templates.
+func (a *Adapter) Render(ctx context.Context, receipt bikerental.Receipt, format bikerental.ReceiptFormat, brand string) (*bikerental.Document, error) {
+	var buf bytes.Buffer
+	switch format {
+	case bikerental.ReceiptFormatHTML:
+		if err := a.templates(brand).html.Execute(&buf, receipt); err != nil {
+			return nil, fmt.Errorf("executing html template: %w", err)
+		}
+		return &bikerental.Document{
+			ContentType: "text/html; charset=utf-8",
+			Data:        buf.Bytes(),
+		}, nil
+
+	case bikerental.ReceiptFormatPDF:
+		if err := a.templates(brand).text.Execute(&buf, receipt); err != nil {
+			return nil, fmt.Errorf("executing text template: %w", err)
+		}
+		return &bikerental.Document{
+			ContentType: "application/pdf",
+			Data:        renderPDF(strings.Split(buf.String(), "\n")),
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported receipt format: '%s'", format)
+	}
+}
+
+// templates returns templates of the brand with default templates in place of missing ones.
+func (a *Adapter) templates(brand string) brandTemplates {
+	result := a.brands[defaultBrand]
+	if t, ok := a.brands[brand]; ok {
+		if t.html != nil {
+			result.html = t.html
+		}
+		if t.text != nil {
+			result.text = t.text
+		}
+	}
+	return result
+}
+
+// parseBrandTemplates parses templates from brand directory. Missing templates are nil.
+func parseBrandTemplates(dir string) (brandTemplates, error) {
+	var result brandTemplates
+
+	data, err := readOptionalFile(filepath.Join(dir, htmlTemplateFile))
+	if err != nil {
+		return result, err
+	}
+	if data != nil {
+		if result.html, err = htmltemplate.New(htmlTemplateFile).Funcs(templateFuncs).Parse(string(data)); err != nil {
+			return result, fmt.Errorf("parsing %s: %w", htmlTemplateFile, err)
+		}
+	}
+
+	data, err = readOptionalFile(filepath.Join(dir, textTemplateFile))
+	if err != nil {
+		return result, err
+	}
+	if data != nil {
+		if result.text, err = texttemplate.New(textTemplateFile).Funcs(templateFuncs).Parse(string(data)); err != nil {
+			return result, fmt.Errorf("parsing %s: %w", textTemplateFile, err)
+		}
+	}
+
+	return result, nil
+}
+
+func readOptionalFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// templateFuncs are functions available in templates.
+var templateFuncs = map[string]interface{}{
+	"money": bikerental.Money.Format,
+	"time": func(t time.Time) string {
+		return t.UTC().Format("2006-01-02 15:04 UTC")
+	},
+}
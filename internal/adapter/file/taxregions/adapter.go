@@ -0,0 +1,107 @@
+package taxregions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/adapter/file"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Adapter provides tax regions of stations defined in a json file.
+// File is read once, when adapter is created. See configs/tax/regions.json for an example.
+type Adapter struct {
+	byStationID map[string]bikerental.TaxRegion
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(path string) (*Adapter, error) {
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+
+	var data regionsFile
+	if err := file.ReadJSON(path, &data); err != nil {
+		return nil, fmt.Errorf("reading tax regions: %w", err)
+	}
+
+	byCountry := make(map[string]bikerental.TaxRegion)
+	for i, c := range data.Countries {
+		region, err := c.ToAppTaxRegion()
+		if err != nil {
+			return nil, fmt.Errorf("invalid country #%d: %w", i, err)
+		}
+		if _, ok := byCountry[region.Country]; ok {
+			return nil, fmt.Errorf("invalid country #%d: duplicated country '%s'", i, region.Country)
+		}
+		byCountry[region.Country] = region
+	}
+
+	a := &Adapter{
+		byStationID: make(map[string]bikerental.TaxRegion),
+	}
+	for stationID, country := range data.Stations {
+		region, ok := byCountry[country]
+		if !ok {
+			return nil, fmt.Errorf("invalid station '%s': unknown country '%s'", stationID, country)
+		}
+		a.byStationID[stationID] = region
+	}
+
+	return a, nil
+}
+
+// GetTaxRegion returns tax region of a station. Empty station id means default region.
+// Returns app.ErrNotFound if there is no region for the station.
+func (a *Adapter) GetTaxRegion(ctx context.Context, stationID string) (*bikerental.TaxRegion, error) {
+	if r, ok := a.byStationID[stationID]; ok {
+		return &r, nil
+	}
+	return nil, app.ErrNotFound
+}
+
+type regionsFile struct {
+	Countries []countryEntry `json:"countries"`
+
+	// Stations maps station ids to country codes. Empty station id is a default for other stations.
+	Stations map[string]string `json:"stations"`
+}
+
+type countryEntry struct {
+	Country string  `json:"country"`
+	Rate    float64 `json:"rate"`
+
+	// CustomerTypeRates are keyed by customer type: "individual" or "business".
+	CustomerTypeRates map[string]float64 `json:"customerTypeRates"`
+}
+
+func (e *countryEntry) ToAppTaxRegion() (bikerental.TaxRegion, error) {
+	if len(e.Country) != 2 {
+		return bikerental.TaxRegion{}, fmt.Errorf("invalid country code: '%s'", e.Country)
+	}
+	if e.Rate < 0 || e.Rate > 100 {
+		return bikerental.TaxRegion{}, fmt.Errorf("invalid rate: %v", e.Rate)
+	}
+
+	r := bikerental.TaxRegion{
+		Country:           e.Country,
+		Rate:              e.Rate,
+		CustomerTypeRates: make(map[bikerental.CustomerType]float64),
+	}
+	for name, rate := range e.CustomerTypeRates {
+		if rate < 0 || rate > 100 {
+			return bikerental.TaxRegion{}, fmt.Errorf("invalid %s rate: %v", name, rate)
+		}
+		switch name {
+		case "individual":
+			r.CustomerTypeRates[bikerental.CustomerTypeIndividual] = rate
+		case "business":
+			r.CustomerTypeRates[bikerental.CustomerTypeBusiness] = rate
+		default:
+			return bikerental.TaxRegion{}, fmt.Errorf("invalid customer type: '%s'", name)
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,52 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	ahttp "github.com/nglogic/go-application-guide/internal/adapter/http"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Adapter publishes events by posting them as JSON to a webhook url.
+// Any 2xx response means that the event was delivered.
+// Event id is also sent in Idempotency-Key header, so receiver can deduplicate redelivered events.
+type Adapter struct {
+	// url valid value can be "https://events.example.com/bikerental"
+	url      string
+	timeout  time.Duration
+	httpDoer ahttp.Doer
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(url string, timeout time.Duration, httpDoer ahttp.Doer) (*Adapter, error) {
+	if url == "" {
+		return nil, errors.New("url is required")
+	}
+	if timeout == 0 {
+		return nil, errors.New("timeout is required")
+	}
+	if httpDoer == nil {
+		return nil, errors.New("http doer is required")
+	}
+
+	return &Adapter{
+		url:      url,
+		timeout:  timeout,
+		httpDoer: httpDoer,
+	}, nil
+}
+
+// Publish posts the event to the webhook.
+func (a *Adapter) Publish(ctx context.Context, e bikerental.Event) error {
+	header := http.Header{}
+	header.Set("Idempotency-Key", strconv.FormatInt(e.ID, 10))
+	if err := ahttp.PostJSON(ctx, a.httpDoer, a.timeout, a.url, header, e, nil); err != nil {
+		return fmt.Errorf("posting event to webhook: %w", err)
+	}
+	return nil
+}
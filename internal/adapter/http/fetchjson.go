@@ -1,18 +1,38 @@
 package http
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
+const tracerName = "github.com/nglogic/go-application-guide/internal/adapter/http"
+
 // Doer is a utility interface. It's implemented by http.Client.
 type Doer interface {
 	Do(*http.Request) (*http.Response, error)
 }
 
+// StatusError is returned when server responds with unexpected http status.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e StatusError) Error() string {
+	return fmt.Sprintf("invalid http status: %d", e.StatusCode)
+}
+
 // GetJSON fetches json data using HTTP GET request.
 // Json from response is unmarshalled to the `result` object (it usually should be a pointer!).
 func GetJSON(
@@ -22,27 +42,117 @@ func GetJSON(
 	url string,
 	result interface{},
 ) error {
+	_, err := doJSON(ctx, doer, timeout, http.MethodGet, url, nil, nil, result)
+	return err
+}
+
+// PostJSON sends `body` as json using HTTP POST request.
+// Json from response is unmarshalled to the `result` object, unless it's nil.
+// Headers are optional, they can be used e.g. for authorization or idempotency keys.
+func PostJSON(
+	ctx context.Context,
+	doer Doer,
+	timeout time.Duration,
+	url string,
+	header http.Header,
+	body interface{},
+	result interface{},
+) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("encoding json request body: %w", err)
+	}
+	_, err = doJSON(ctx, doer, timeout, http.MethodPost, url, header, data, result)
+	return err
+}
+
+// PostRawJSON sends already encoded json `body` using HTTP POST request and returns http status of the response.
+// It's used when exactly the same bytes have to be sent as encoded by the caller, e.g. to sign the body.
+// Response body is discarded. Non 2xx statuses are returned as StatusError together with the status.
+func PostRawJSON(
+	ctx context.Context,
+	doer Doer,
+	timeout time.Duration,
+	url string,
+	header http.Header,
+	body []byte,
+) (int, error) {
+	return doJSON(ctx, doer, timeout, http.MethodPost, url, header, body, nil)
+}
+
+// Ping checks if server responds to HTTP HEAD request.
+// Any response other than server error means that the server is reachable.
+func Ping(ctx context.Context, doer Doer, timeout time.Duration, url string) error {
+	_, err := doJSON(ctx, doer, timeout, http.MethodHead, url, nil, nil, nil)
+	var statusErr StatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode < 500 {
+		return nil
+	}
+	return err
+}
+
+// doJSON is the only place where http requests are made, so response body is always closed.
+// Each request is traced, and trace context is sent in traceparent header.
+// It returns http status of the response, or 0 if there was no response.
+func doJSON(
+	ctx context.Context,
+	doer Doer,
+	timeout time.Duration,
+	method string,
+	url string,
+	header http.Header,
+	body []byte,
+	result interface{},
+) (status int, err error) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "HTTP "+method, trace.WithSpanKind(trace.SpanKindClient))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 	if err != nil {
-		return fmt.Errorf("couldn't create http request: %w", err)
+		return 0, fmt.Errorf("couldn't create http request: %w", err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
 	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	span.SetAttributes(semconv.HTTPClientAttributesFromHTTPRequest(req)...)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
 
 	resp, err := doer.Do(req)
 	if err != nil {
-		return fmt.Errorf("http get '%s': %w", url, err)
+		return 0, fmt.Errorf("http %s '%s': %w", method, url, err)
 	}
 	defer resp.Body.Close()
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(resp.StatusCode)...)
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("invalid http status: %d", resp.StatusCode)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, StatusError{StatusCode: resp.StatusCode}
 	}
 
+	if result == nil {
+		// Reading not used body allows reusing the connection.
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		return resp.StatusCode, nil
+	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return fmt.Errorf("decoding json response from metaweather: %w", err)
+		return resp.StatusCode, fmt.Errorf("decoding json response: %w", err)
 	}
 
-	return nil
+	return resp.StatusCode, nil
 }
@@ -79,6 +79,11 @@ func (a *Adapter) GetIncidents(ctx context.Context, req bikerental.BikeIncidents
 	}, nil
 }
 
+// Ping checks if bikewise service is reachable.
+func (a *Adapter) Ping(ctx context.Context) error {
+	return http.Ping(ctx, a.httpDoer, a.timeout, a.address)
+}
+
 type bikewiseLocationsResponse struct {
 	// Features is just a list of some object. We only care about count, so internal structure is irrelevant.
 	Features []json.RawMessage `json:"features"`
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// errorCode is a code label of requests failed without response, e.g. on timeout.
+const errorCode = "error"
+
+// ClientMetrics are prometheus metrics of requests to external services.
+type ClientMetrics struct {
+	duration *prometheus.HistogramVec
+}
+
+// NewClientMetrics creates new metrics instance, and registers its metrics.
+func NewClientMetrics(reg prometheus.Registerer) (*ClientMetrics, error) {
+	if reg == nil {
+		return nil, errors.New("registerer is required")
+	}
+
+	m := &ClientMetrics{
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "bikerental",
+			Name:      "http_client_request_duration_seconds",
+			Help:      "Duration of requests to external services by service and response status code, or \"error\" if there was no response.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "code"}),
+	}
+	if err := reg.Register(m.duration); err != nil {
+		return nil, fmt.Errorf("registering http client metrics: %w", err)
+	}
+	return m, nil
+}
+
+// DoerWithMetrics returns doer measuring duration and status codes of requests to the service.
+// Request ends when response body is closed, so reading and decoding the response is measured too.
+func DoerWithMetrics(doer Doer, metrics *ClientMetrics, service string) Doer {
+	return &measuredDoer{
+		doer:     doer,
+		duration: metrics.duration.MustCurryWith(prometheus.Labels{"service": service}),
+	}
+}
+
+type measuredDoer struct {
+	doer     Doer
+	duration prometheus.ObserverVec
+}
+
+func (d *measuredDoer) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := d.doer.Do(req)
+	if err != nil {
+		d.duration.WithLabelValues(errorCode).Observe(time.Since(start).Seconds())
+		return resp, err
+	}
+
+	code := strconv.Itoa(resp.StatusCode)
+	resp.Body = &measuredBody{
+		ReadCloser: resp.Body,
+		observe: func() {
+			d.duration.WithLabelValues(code).Observe(time.Since(start).Seconds())
+		},
+	}
+	return resp, nil
+}
+
+// measuredBody observes duration of the request when it's closed.
+type measuredBody struct {
+	io.ReadCloser
+	once    sync.Once
+	observe func()
+}
+
+func (b *measuredBody) Close() error {
+	b.once.Do(b.observe)
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,154 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	ahttp "github.com/nglogic/go-application-guide/internal/adapter/http"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Payment statuses returned by the provider.
+const (
+	statusAuthorized = "authorized"
+	statusDeclined   = "declined"
+)
+
+// Adapter uses payment provider HTTP API for processing reservation payments.
+//
+// Provider API:
+//
+//	POST /v1/payments                 - authorize, responds with payment id and status
+//	POST /v1/payments/{id}/capture    - capture authorized amount
+//	POST /v1/payments/{id}/refund     - refund captured amount
+//	POST /v1/payments/{id}/void       - release authorization
+//
+// Declined authorizations are reported with "declined" status or 402 http status.
+type Adapter struct {
+	// address valid value can be "https://payments.example.com"
+	address  string
+	apiKey   string
+	timeout  time.Duration
+	httpDoer ahttp.Doer
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(address, apiKey string, timeout time.Duration, httpDoer ahttp.Doer) (*Adapter, error) {
+	if address == "" {
+		return nil, errors.New("address is required")
+	}
+	if apiKey == "" {
+		return nil, errors.New("api key is required")
+	}
+	if timeout == 0 {
+		return nil, errors.New("timeout is required")
+	}
+	if httpDoer == nil {
+		return nil, errors.New("http doer is required")
+	}
+
+	return &Adapter{
+		address:  address,
+		apiKey:   apiKey,
+		timeout:  timeout,
+		httpDoer: httpDoer,
+	}, nil
+}
+
+// Authorize reserves the amount on customer account and returns provider payment id.
+// Returns bikerental.ErrPaymentDeclined if provider declines the payment.
+func (a *Adapter) Authorize(ctx context.Context, req bikerental.PaymentRequest) (string, error) {
+	var resp paymentResponse
+	err := a.post(ctx, "/v1/payments", fmt.Sprintf("authorize-%s-%s", req.Purpose, req.ReservationID), authorizeRequest{
+		Reference:     req.ReservationID,
+		Purpose:       string(req.Purpose),
+		CustomerID:    req.CustomerID,
+		CustomerEmail: req.CustomerEmail,
+		Amount:        req.Amount.Amount,
+		Currency:      string(req.Amount.Currency),
+	}, &resp)
+	if err != nil {
+		var statusErr ahttp.StatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusPaymentRequired {
+			return "", bikerental.ErrPaymentDeclined
+		}
+		return "", fmt.Errorf("authorizing payment in payment provider: %w", err)
+	}
+
+	switch resp.Status {
+	case statusAuthorized:
+		return resp.ID, nil
+	case statusDeclined:
+		return "", fmt.Errorf("%w: %s", bikerental.ErrPaymentDeclined, resp.DeclineReason)
+	default:
+		return "", fmt.Errorf("unexpected payment status: '%s'", resp.Status)
+	}
+}
+
+// Capture charges authorized amount.
+func (a *Adapter) Capture(ctx context.Context, paymentID string, amount bikerental.Money) error {
+	if err := a.post(ctx, a.paymentPath(paymentID, "capture"), "capture-"+paymentID, newAmountRequest(amount), nil); err != nil {
+		return fmt.Errorf("capturing payment in payment provider: %w", err)
+	}
+	return nil
+}
+
+// Refund returns captured amount to the customer.
+func (a *Adapter) Refund(ctx context.Context, paymentID string, amount bikerental.Money) error {
+	if err := a.post(ctx, a.paymentPath(paymentID, "refund"), "refund-"+paymentID, newAmountRequest(amount), nil); err != nil {
+		return fmt.Errorf("refunding payment in payment provider: %w", err)
+	}
+	return nil
+}
+
+// Void releases authorization without charging the customer.
+func (a *Adapter) Void(ctx context.Context, paymentID string) error {
+	if err := a.post(ctx, a.paymentPath(paymentID, "void"), "void-"+paymentID, struct{}{}, nil); err != nil {
+		return fmt.Errorf("voiding payment in payment provider: %w", err)
+	}
+	return nil
+}
+
+func (a *Adapter) paymentPath(paymentID, action string) string {
+	return fmt.Sprintf("/v1/payments/%s/%s", url.PathEscape(paymentID), action)
+}
+
+// post sends request to the provider. Idempotency key makes retries of the same operation safe.
+func (a *Adapter) post(ctx context.Context, path, idempotencyKey string, body, result interface{}) error {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+a.apiKey)
+	header.Set("Idempotency-Key", idempotencyKey)
+
+	return ahttp.PostJSON(ctx, a.httpDoer, a.timeout, a.address+path, header, body, result)
+}
+
+type authorizeRequest struct {
+	Reference     string `json:"reference"`
+	Purpose       string `json:"purpose"`
+	CustomerID    string `json:"customer_id,omitempty"`
+	CustomerEmail string `json:"customer_email,omitempty"`
+	Amount        int64  `json:"amount"`
+	Currency      string `json:"currency"`
+}
+
+type amountRequest struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func newAmountRequest(m bikerental.Money) amountRequest {
+	return amountRequest{
+		Amount:   m.Amount,
+		Currency: string(m.Currency),
+	}
+}
+
+type paymentResponse struct {
+	ID            string `json:"id"`
+	Status        string `json:"status"`
+	DeclineReason string `json:"decline_reason"`
+}
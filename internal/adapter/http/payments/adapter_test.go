@@ -0,0 +1,252 @@
+package payments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	ahttp "github.com/nglogic/go-application-guide/internal/adapter/http"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+const testAPIKey = "test-key"
+
+// fakeProvider is a payment provider API. Payments are created once per idempotency key.
+type fakeProvider struct {
+	mu       sync.Mutex
+	payments map[string]paymentResponse // by idempotency key
+	captures map[string]int             // by payment id
+	captured map[string]bool            // idempotency keys of processed captures
+
+	// lostResponses is a number of authorizations processed by provider, which responses don't reach the client.
+	lostResponses int
+
+	// handle overrides responses of the provider, if set.
+	handle func(w http.ResponseWriter, r *http.Request) bool
+}
+
+func newFakeProvider() *fakeProvider {
+	return &fakeProvider{
+		payments: map[string]paymentResponse{},
+		captures: map[string]int{},
+		captured: map[string]bool{},
+	}
+}
+
+func (p *fakeProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+testAPIKey {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if p.handle != nil && p.handle(w, r) {
+		return
+	}
+
+	key := r.Header.Get("Idempotency-Key")
+	if key == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch {
+	case r.URL.Path == "/v1/payments":
+		var req authorizeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		payment, ok := p.payments[key]
+		if !ok {
+			payment = paymentResponse{ID: fmt.Sprintf("pay-%d", len(p.payments)+1), Status: statusAuthorized}
+			if req.Amount > 100000 {
+				payment = paymentResponse{ID: fmt.Sprintf("pay-%d", len(p.payments)+1), Status: statusDeclined, DeclineReason: "insufficient funds"}
+			}
+			p.payments[key] = payment
+		}
+		if p.lostResponses > 0 {
+			p.lostResponses--
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(payment)
+	case r.URL.Path == "/v1/payments/pay-1/capture":
+		if !p.captured[key] {
+			p.captured[key] = true
+			p.captures["pay-1"]++
+		}
+		_, _ = w.Write([]byte("{}"))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (p *fakeProvider) paymentsCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.payments)
+}
+
+func (p *fakeProvider) capturesCount(paymentID string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.captures[paymentID]
+}
+
+func newTestAdapter(t *testing.T, h http.Handler, timeout time.Duration) *Adapter {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	a, err := NewAdapter(srv.URL, testAPIKey, timeout, srv.Client())
+	if err != nil {
+		t.Fatalf("creating adapter: %v", err)
+	}
+	return a
+}
+
+func testPaymentRequest(amount int64) bikerental.PaymentRequest {
+	return bikerental.PaymentRequest{
+		ReservationID: "reservation-1",
+		Purpose:       bikerental.PaymentPurposeRental,
+		CustomerEmail: "customer@example.com",
+		Amount:        bikerental.NewMoney(amount, "EUR"),
+	}
+}
+
+func TestAdapterAuthorize(t *testing.T) {
+	provider := newFakeProvider()
+	a := newTestAdapter(t, provider, time.Second)
+
+	id, err := a.Authorize(context.Background(), testPaymentRequest(1000))
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	if id != "pay-1" {
+		t.Errorf("Authorize() = %s, want pay-1", id)
+	}
+	if err := a.Capture(context.Background(), id, bikerental.NewMoney(1000, "EUR")); err != nil {
+		t.Fatalf("Capture() error = %v", err)
+	}
+	if n := provider.capturesCount("pay-1"); n != 1 {
+		t.Errorf("captures = %d, want 1", n)
+	}
+}
+
+func TestAdapterAuthorizeDeclined(t *testing.T) {
+	tests := []struct {
+		name   string
+		handle func(w http.ResponseWriter, r *http.Request) bool
+		amount int64
+	}{
+		{
+			name:   "declined status",
+			amount: 200000,
+		},
+		{
+			name: "payment required http status",
+			handle: func(w http.ResponseWriter, r *http.Request) bool {
+				w.WriteHeader(http.StatusPaymentRequired)
+				return true
+			},
+			amount: 1000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newFakeProvider()
+			provider.handle = tt.handle
+			a := newTestAdapter(t, provider, time.Second)
+
+			_, err := a.Authorize(context.Background(), testPaymentRequest(tt.amount))
+			if !errors.Is(err, bikerental.ErrPaymentDeclined) {
+				t.Errorf("Authorize() error = %v, want %v", err, bikerental.ErrPaymentDeclined)
+			}
+		})
+	}
+}
+
+func TestAdapterProviderError(t *testing.T) {
+	provider := newFakeProvider()
+	provider.handle = func(w http.ResponseWriter, r *http.Request) bool {
+		w.WriteHeader(http.StatusInternalServerError)
+		return true
+	}
+	a := newTestAdapter(t, provider, time.Second)
+
+	_, err := a.Authorize(context.Background(), testPaymentRequest(1000))
+	var statusErr ahttp.StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("Authorize() error = %v, want status error 500", err)
+	}
+	if errors.Is(err, bikerental.ErrPaymentDeclined) {
+		t.Errorf("Authorize() error = %v, provider error reported as declined payment", err)
+	}
+
+	err = a.Void(context.Background(), "pay-1")
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Void() error = %v, want status error 500", err)
+	}
+}
+
+func TestAdapterTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	provider := newFakeProvider()
+	provider.handle = func(w http.ResponseWriter, r *http.Request) bool {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+		return true
+	}
+	a := newTestAdapter(t, provider, 50*time.Millisecond)
+
+	start := time.Now()
+	_, err := a.Authorize(context.Background(), testPaymentRequest(1000))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Authorize() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Authorize() took %s, timeout not applied", elapsed)
+	}
+}
+
+// TestAdapterRetryIdempotent checks that retried operations use the same idempotency key,
+// so the customer isn't charged twice when the response of the first attempt is lost.
+func TestAdapterRetryIdempotent(t *testing.T) {
+	provider := newFakeProvider()
+	provider.lostResponses = 1
+	a := newTestAdapter(t, provider, time.Second)
+	req := testPaymentRequest(1000)
+
+	if _, err := a.Authorize(context.Background(), req); err == nil {
+		t.Fatal("Authorize() first attempt error = nil, want error")
+	}
+	id, err := a.Authorize(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Authorize() retry error = %v", err)
+	}
+	if id != "pay-1" {
+		t.Errorf("Authorize() retry = %s, want payment from the first attempt pay-1", id)
+	}
+	if n := provider.paymentsCount(); n != 1 {
+		t.Errorf("payments = %d, want 1", n)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := a.Capture(context.Background(), id, req.Amount); err != nil {
+			t.Fatalf("Capture() error = %v", err)
+		}
+	}
+	if n := provider.capturesCount("pay-1"); n != 1 {
+		t.Errorf("captures = %d, want 1", n)
+	}
+}
@@ -60,6 +60,11 @@ func (a *Adapter) GetWeather(ctx context.Context, req bikerental.WeatherRequest)
 	}, nil
 }
 
+// Ping checks if metaweather service is reachable.
+func (a *Adapter) Ping(ctx context.Context) error {
+	return ahttp.Ping(ctx, a.httpDoer, a.timeout, a.address)
+}
+
 func (a *Adapter) fetchLocationID(ctx context.Context, loc bikerental.Location) (int, error) {
 	urlVal := fmt.Sprintf("%s/api/location/search/", a.address)
 	query := url.Values{
@@ -0,0 +1,161 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	ahttp "github.com/nglogic/go-application-guide/internal/adapter/http"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Webhook request headers.
+const (
+	headerEventID   = "Webhook-Id"
+	headerEventType = "Webhook-Event"
+
+	// headerSignature has format "t=<unix timestamp>,v1=<signature>".
+	// Signature is hex encoded HMAC-SHA256 of "<unix timestamp>.<request body>" with subscription secret as a key.
+	// Subscribers should reject requests with old timestamps to prevent replays.
+	headerSignature = "Webhook-Signature"
+)
+
+// errBlockedAddress is returned when subscriber host resolves to address in private network.
+var errBlockedAddress = errors.New("address of webhook subscriber is not public")
+
+// blockedNetworks are not public networks, in addition to those checked by net.IP methods.
+var blockedNetworks = mustParseCIDRs(
+	"0.0.0.0/8",      // "this" network
+	"100.64.0.0/10",  // carrier-grade NAT, used in some cloud networks
+	"192.0.0.0/24",   // IETF protocol assignments
+	"198.18.0.0/15",  // benchmarking
+	"64:ff9b::/96",   // NAT64, can map to private IPv4 addresses
+	"64:ff9b:1::/48", // local-use NAT64
+	"2001:db8::/32",  // documentation
+	"240.0.0.0/4",    // reserved, with broadcast
+)
+
+// Adapter sends events to webhook subscribers as signed JSON POST requests.
+type Adapter struct {
+	timeout  time.Duration
+	httpDoer ahttp.Doer
+}
+
+// NewAdapter creates new adapter instance.
+// Subscription urls are set by callers, so they could be used for reaching internal services (SSRF).
+// In production http doer has to use client created with NewHTTPClient, which blocks such requests.
+func NewAdapter(timeout time.Duration, httpDoer ahttp.Doer) (*Adapter, error) {
+	if timeout == 0 {
+		return nil, errors.New("timeout is required")
+	}
+	if httpDoer == nil {
+		return nil, errors.New("http doer is required")
+	}
+
+	return &Adapter{
+		timeout:  timeout,
+		httpDoer: httpDoer,
+	}, nil
+}
+
+// NewHTTPClient returns client for sending webhooks.
+// Connections to loopback, private and link-local addresses are blocked, unless private networks are allowed
+// for development. Addresses are checked when connecting, after host name is resolved,
+// so private addresses can't be reached with DNS names resolving to them or with redirects.
+// Proxy from environment is not used, because address of the proxy would be checked instead of the subscriber's.
+func NewHTTPClient(allowPrivateNetworks bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if !allowPrivateNetworks {
+		dialer.Control = checkDialAddress
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		},
+	}
+}
+
+// checkDialAddress is called before connecting to resolved address, it returns error for not public addresses.
+func checkDialAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid address '%s': %w", address, err)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("invalid address '%s'", address)
+	}
+	if !isPublicIP(ip) {
+		return fmt.Errorf("%w: %s", errBlockedAddress, ip)
+	}
+	return nil
+}
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, n := range blockedNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	result := make([]*net.IPNet, 0, len(cidrs))
+	for _, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		result = append(result, n)
+	}
+	return result
+}
+
+// Send sends the event and returns http status of the response.
+// Non 2xx statuses are returned as ahttp.StatusError together with the status.
+func (a *Adapter) Send(ctx context.Context, req bikerental.WebhookRequest) (int, error) {
+	body, err := json.Marshal(req.Event)
+	if err != nil {
+		return 0, fmt.Errorf("encoding event: %w", err)
+	}
+
+	header := http.Header{}
+	header.Set(headerEventID, strconv.FormatInt(req.Event.ID, 10))
+	header.Set(headerEventType, string(req.Event.Type))
+	header.Set(headerSignature, signature(req.Secret, time.Now(), body))
+
+	// Body is sent as encoded here, so it matches the signature.
+	return ahttp.PostRawJSON(ctx, a.httpDoer, a.timeout, req.URL, header, body)
+}
+
+// signature returns value of signature header for the request body.
+func signature(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%s,v1=%s", ts, hex.EncodeToString(mac.Sum(nil)))
+}
@@ -0,0 +1,147 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestAdapterSendBlocksPrivateAddresses(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer srv.Close()
+
+	// Host name resolving to loopback is blocked too, not only ip in the url.
+	urls := []string{
+		srv.URL,
+		strings.Replace(srv.URL, "127.0.0.1", "localhost", 1),
+	}
+	a, err := NewAdapter(time.Second, NewHTTPClient(false))
+	if err != nil {
+		t.Fatalf("creating adapter: %v", err)
+	}
+	for _, u := range urls {
+		_, err := a.Send(context.Background(), bikerental.WebhookRequest{URL: u, Secret: "secret", Event: bikerental.Event{ID: 1}})
+		if !errors.Is(err, errBlockedAddress) {
+			t.Errorf("Send(%s) error = %v, want %v", u, err, errBlockedAddress)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("server got %d requests, want 0", requests)
+	}
+}
+
+func TestAdapterSendAllowedPrivateAddresses(t *testing.T) {
+	var gotSignature, gotEventID string
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSignature = r.Header.Get(headerSignature)
+		gotEventID = r.Header.Get(headerEventID)
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	a, err := NewAdapter(time.Second, NewHTTPClient(true))
+	if err != nil {
+		t.Fatalf("creating adapter: %v", err)
+	}
+	status, err := a.Send(context.Background(), bikerental.WebhookRequest{
+		URL:    srv.URL,
+		Secret: "secret",
+		Event:  bikerental.Event{ID: 7, Type: bikerental.EventTypeBikeCreated},
+	})
+	if err != nil || status != http.StatusAccepted {
+		t.Fatalf("Send() = %d, %v, want %d", status, err, http.StatusAccepted)
+	}
+	if gotEventID != "7" {
+		t.Errorf("event id header = %s, want 7", gotEventID)
+	}
+
+	ts := strings.TrimPrefix(strings.Split(gotSignature, ",")[0], "t=")
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		t.Fatalf("invalid signature header '%s'", gotSignature)
+	}
+	if want := signature("secret", time.Unix(unix, 0), gotBody); gotSignature != want {
+		t.Errorf("signature = %s, want %s", gotSignature, want)
+	}
+}
+
+// TestAdapterSendTraced checks that webhooks are sent with shared http helper, as other outgoing requests.
+func TestAdapterSendTraced(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	}()
+
+	var gotTraceparent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTraceparent = r.Header.Get("traceparent")
+	}))
+	defer srv.Close()
+
+	a, err := NewAdapter(time.Second, NewHTTPClient(true))
+	if err != nil {
+		t.Fatalf("creating adapter: %v", err)
+	}
+	if _, err := a.Send(context.Background(), bikerental.WebhookRequest{URL: srv.URL, Secret: "secret", Event: bikerental.Event{ID: 1}}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 || spans[0].SpanKind() != trace.SpanKindClient {
+		t.Fatalf("recorded spans = %v, want one client span", spans)
+	}
+	sc := spans[0].SpanContext()
+	if want := "00-" + sc.TraceID().String() + "-" + sc.SpanID().String() + "-01"; gotTraceparent != want {
+		t.Errorf("traceparent header = %s, want %s", gotTraceparent, want)
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false}, // cloud metadata service
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"100.64.0.1", false},
+		{"::ffff:127.0.0.1", false},
+		{"64:ff9b::a00:1", false},
+	}
+	for _, tt := range tests {
+		if got := isPublicIP(net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("isPublicIP(%s) = %t, want %t", tt.ip, got, tt.want)
+		}
+	}
+}
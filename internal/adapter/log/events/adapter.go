@@ -0,0 +1,40 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Adapter publishes events as JSON lines to a writer, e.g. stdout.
+// It's useful for development and for log based event collectors.
+type Adapter struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(w io.Writer) (*Adapter, error) {
+	if w == nil {
+		return nil, errors.New("writer is required")
+	}
+	return &Adapter{
+		enc: json.NewEncoder(w),
+	}, nil
+}
+
+// Publish writes the event as one line of JSON.
+func (a *Adapter) Publish(ctx context.Context, e bikerental.Event) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.enc.Encode(e); err != nil {
+		return fmt.Errorf("writing event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package broker
+
+import (
+	"sync"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Adapter is an in-process broker of reservation changes.
+// Subscribers get only changes published by this process, so with many app instances
+// watchers see only reservations made through the instance they are connected to.
+type Adapter struct {
+	// buffer is a number of changes queued for a subscriber, before it's dropped.
+	buffer int
+
+	mu          sync.Mutex
+	subscribers map[int]chan bikerental.ReservationChange
+	lastID      int
+	closed      bool
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(buffer int) *Adapter {
+	return &Adapter{
+		buffer:      buffer,
+		subscribers: map[int]chan bikerental.ReservationChange{},
+	}
+}
+
+// PublishReservationChange sends change to all subscribers.
+// It never blocks: subscribers with full buffer are dropped, and their channels are closed.
+func (a *Adapter) PublishReservationChange(c bikerental.ReservationChange) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for id, ch := range a.subscribers {
+		select {
+		case ch <- c:
+		default:
+			close(ch)
+			delete(a.subscribers, id)
+		}
+	}
+}
+
+// Subscribe returns channel of changes published from now on, and function canceling the subscription.
+// If broker is closed, returned channel is closed too.
+func (a *Adapter) Subscribe() (<-chan bikerental.ReservationChange, func()) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ch := make(chan bikerental.ReservationChange, a.buffer)
+	if a.closed {
+		close(ch)
+		return ch, func() {}
+	}
+
+	a.lastID++
+	id := a.lastID
+	a.subscribers[id] = ch
+
+	return ch, func() {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		// Subscription could have been already dropped.
+		if _, ok := a.subscribers[id]; ok {
+			close(ch)
+			delete(a.subscribers, id)
+		}
+	}
+}
+
+// Close closes all subscriptions, so watchers can finish before server shutdown.
+func (a *Adapter) Close() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for id, ch := range a.subscribers {
+		close(ch)
+		delete(a.subscribers, id)
+	}
+	a.closed = true
+}
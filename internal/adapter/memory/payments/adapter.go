@@ -0,0 +1,128 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Adapter is a fake payment provider keeping payments in memory.
+// It's deterministic, so it can be used for local runs and tests:
+// payment ids are sequential and only amounts above the limit are declined.
+type Adapter struct {
+	// declineAbove is a max amount in minor units, of any currency, that can be authorized. Zero means no limit.
+	declineAbove int64
+
+	mu       sync.Mutex
+	payments map[string]*payment
+	// byReference makes authorization idempotent, like in real providers.
+	byReference map[string]string
+	lastID      int
+}
+
+type payment struct {
+	status     bikerental.PaymentStatus
+	authorized bikerental.Money
+	captured   bikerental.Money
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter(declineAbove int64) *Adapter {
+	return &Adapter{
+		declineAbove: declineAbove,
+		payments:     map[string]*payment{},
+		byReference:  map[string]string{},
+	}
+}
+
+// Authorize reserves the amount and returns new payment id.
+// Returns bikerental.ErrPaymentDeclined if amount is above the limit.
+func (a *Adapter) Authorize(_ context.Context, req bikerental.PaymentRequest) (string, error) {
+	if req.Amount.Amount <= 0 {
+		return "", app.NewValidationError("amount has to be positive")
+	}
+	if err := req.Amount.Currency.Validate(); err != nil {
+		return "", err
+	}
+	if a.declineAbove > 0 && req.Amount.Amount > a.declineAbove {
+		return "", fmt.Errorf("%w: amount above %d", bikerental.ErrPaymentDeclined, a.declineAbove)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ref := fmt.Sprintf("%s/%s", req.ReservationID, req.Purpose)
+	if id, ok := a.byReference[ref]; ok {
+		return id, nil
+	}
+
+	a.lastID++
+	id := fmt.Sprintf("fake-%06d", a.lastID)
+	a.payments[id] = &payment{
+		status:     bikerental.PaymentStatusAuthorized,
+		authorized: req.Amount,
+	}
+	a.byReference[ref] = id
+	return id, nil
+}
+
+// Capture charges authorized amount.
+func (a *Adapter) Capture(_ context.Context, paymentID string, amount bikerental.Money) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	p, err := a.get(paymentID, bikerental.PaymentStatusAuthorized)
+	if err != nil {
+		return err
+	}
+	if amount.Currency != p.authorized.Currency || p.authorized.Less(amount) {
+		return app.NewValidationError(fmt.Sprintf("can't capture more than authorized %s", p.authorized))
+	}
+	p.status = bikerental.PaymentStatusCaptured
+	p.captured = amount
+	return nil
+}
+
+// Refund returns captured amount to the customer.
+func (a *Adapter) Refund(_ context.Context, paymentID string, amount bikerental.Money) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	p, err := a.get(paymentID, bikerental.PaymentStatusCaptured)
+	if err != nil {
+		return err
+	}
+	if amount.Currency != p.captured.Currency || p.captured.Less(amount) {
+		return app.NewValidationError(fmt.Sprintf("can't refund more than captured %s", p.captured))
+	}
+	p.status = bikerental.PaymentStatusRefunded
+	return nil
+}
+
+// Void releases authorization without charging the customer.
+func (a *Adapter) Void(_ context.Context, paymentID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	p, err := a.get(paymentID, bikerental.PaymentStatusAuthorized)
+	if err != nil {
+		return err
+	}
+	p.status = bikerental.PaymentStatusVoided
+	return nil
+}
+
+// get returns payment in expected status. Caller has to hold the lock.
+func (a *Adapter) get(paymentID string, status bikerental.PaymentStatus) (*payment, error) {
+	p, ok := a.payments[paymentID]
+	if !ok {
+		return nil, app.ErrNotFound
+	}
+	if p.status != status {
+		return nil, app.NewConflictError(fmt.Sprintf("payment is %s, expected %s", p.status, status))
+	}
+	return p, nil
+}
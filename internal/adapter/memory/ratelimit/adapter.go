@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+const (
+	// maxBuckets is a number of buckets after which idle buckets are removed.
+	maxBuckets = 10000
+
+	// idleTimeout is a time after which unused bucket is removed. Buckets are full long before that.
+	idleTimeout = time.Hour
+)
+
+// Adapter keeps token buckets and quota counters in memory.
+// Limits are enforced separately by each app instance, use db adapter for multi-instance deployments.
+type Adapter struct {
+	mu      sync.Mutex
+	buckets map[string]bikerental.TokenBucket
+
+	// counters are calls of the day, keyed by key. Counters of previous days are dropped.
+	day      string
+	counters map[string]int64
+}
+
+// NewAdapter creates new adapter instance.
+func NewAdapter() *Adapter {
+	return &Adapter{
+		buckets:  map[string]bikerental.TokenBucket{},
+		counters: map[string]int64{},
+	}
+}
+
+// Take takes one token from the bucket of the key.
+func (a *Adapter) Take(_ context.Context, key string, limit bikerental.RateLimit, now time.Time) (bikerental.RateLimitDecision, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	b, ok := a.buckets[key]
+	if !ok {
+		a.removeIdleBuckets(now)
+		b = bikerental.NewTokenBucket(limit, now)
+	}
+	b, decision := b.Take(limit, now)
+	a.buckets[key] = b
+	return decision, nil
+}
+
+func (a *Adapter) removeIdleBuckets(now time.Time) {
+	if len(a.buckets) < maxBuckets {
+		return
+	}
+	for k, b := range a.buckets {
+		if now.Sub(b.UpdatedAt) > idleTimeout {
+			delete(a.buckets, k)
+		}
+	}
+}
+
+// DeleteIdle removes buckets not used since given time, and returns number of removed buckets.
+func (a *Adapter) DeleteIdle(_ context.Context, before time.Time) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var n int
+	for k, b := range a.buckets {
+		if b.UpdatedAt.Before(before) {
+			delete(a.buckets, k)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// Increment increments counter of the key for the day of given time, and returns the new value.
+func (a *Adapter) Increment(_ context.Context, key string, at time.Time) (int64, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	day := at.UTC().Format("2006-01-02")
+	if day != a.day {
+		a.day = day
+		a.counters = map[string]int64{}
+	}
+	a.counters[key]++
+	return a.counters[key], nil
+}
+
+// DeleteBefore removes counters of days before the day of given time, and returns number of removed counters.
+func (a *Adapter) DeleteBefore(_ context.Context, at time.Time) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.day >= at.UTC().Format("2006-01-02") {
+		return 0, nil
+	}
+	n := len(a.counters)
+	a.day = ""
+	a.counters = map[string]int64{}
+	return n, nil
+}
@@ -0,0 +1,82 @@
+package reservations
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Adapter counts reservations and discounts in prometheus metrics.
+type Adapter struct {
+	reservations    *prometheus.CounterVec
+	discounts       *prometheus.CounterVec
+	discountAmounts *prometheus.CounterVec
+}
+
+// NewAdapter creates new adapter instance, and registers its metrics.
+func NewAdapter(reg prometheus.Registerer) (*Adapter, error) {
+	if reg == nil {
+		return nil, errors.New("registerer is required")
+	}
+
+	a := &Adapter{
+		reservations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bikerental",
+			Name:      "reservations_total",
+			Help:      "Number of reservation requests by status, and rejection reason of rejected ones.",
+		}, []string{"status", "rejection"}),
+		discounts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bikerental",
+			Name:      "discounts_total",
+			Help:      "Number of reservations with discount by discount rule.",
+		}, []string{"rule"}),
+		discountAmounts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bikerental",
+			Name:      "discount_amount_total",
+			Help:      "Discount amount of reservations in base currency major units by discount rule.",
+		}, []string{"rule"}),
+	}
+	for _, c := range []prometheus.Collector{a.reservations, a.discounts, a.discountAmounts} {
+		if err := reg.Register(c); err != nil {
+			return nil, fmt.Errorf("registering reservation metrics: %w", err)
+		}
+	}
+	return a, nil
+}
+
+// ReservationApproved counts created reservation and its discount.
+func (a *Adapter) ReservationApproved(r bikerental.Reservation) {
+	a.reservations.WithLabelValues(string(r.Status), "").Inc()
+
+	if r.AppliedDiscount.IsZero() {
+		return
+	}
+	rule := discountRuleLabel(r.DiscountRule)
+	amount := r.AppliedDiscount.Convert(r.ExchangeRate, bikerental.BaseCurrency)
+	a.discounts.WithLabelValues(rule).Inc()
+	a.discountAmounts.WithLabelValues(rule).Add(float64(amount.Amount) / math.Pow10(amount.Currency.MinorUnits()))
+}
+
+// ReservationRejected counts rejected reservation request.
+func (a *Adapter) ReservationRejected(rejection bikerental.ReservationRejection) {
+	a.reservations.WithLabelValues(string(bikerental.ReservationStatusRejected), string(rejection)).Inc()
+}
+
+// discountRuleLabel returns discount rule without names of promo codes and companies,
+// so number of label values is limited.
+func discountRuleLabel(rule string) string {
+	rules := strings.Split(rule, " + ")
+	for i, r := range rules {
+		switch {
+		case strings.HasPrefix(r, "promo code "):
+			rules[i] = "promo code"
+		case strings.HasPrefix(r, "company "):
+			rules[i] = "company"
+		}
+	}
+	return bikerental.CombineDiscountRules(rules...)
+}
@@ -0,0 +1,128 @@
+package notifications
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Adapter sends notifications as plain text emails through SMTP server.
+// STARTTLS is used if server supports it. Credentials are optional, so the adapter works
+// with local fake SMTP servers like MailHog (docker run -p 1025:1025 -p 8025:8025 mailhog/mailhog).
+type Adapter struct {
+	// addr valid value can be "localhost:1025"
+	addr     string
+	from     string
+	username string
+	password string
+	timeout  time.Duration
+}
+
+// NewAdapter creates new adapter instance.
+// Username and password can be empty if server doesn't require authentication.
+func NewAdapter(addr, from, username, password string, timeout time.Duration) (*Adapter, error) {
+	if addr == "" {
+		return nil, errors.New("address is required")
+	}
+	if from == "" {
+		return nil, errors.New("sender address is required")
+	}
+	if timeout == 0 {
+		return nil, errors.New("timeout is required")
+	}
+
+	return &Adapter{
+		addr:     addr,
+		from:     from,
+		username: username,
+		password: password,
+		timeout:  timeout,
+	}, nil
+}
+
+// Send sends the message as email.
+func (a *Adapter) Send(ctx context.Context, msg bikerental.Message) error {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", a.addr)
+	if err != nil {
+		return fmt.Errorf("connecting to smtp server: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return fmt.Errorf("setting connection deadline: %w", err)
+		}
+	}
+
+	host, _, err := net.SplitHostPort(a.addr)
+	if err != nil {
+		return fmt.Errorf("invalid smtp server address: %w", err)
+	}
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return fmt.Errorf("creating smtp client: %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("starting tls: %w", err)
+		}
+	}
+	if a.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", a.username, a.password, host)); err != nil {
+			return fmt.Errorf("authenticating: %w", err)
+		}
+	}
+
+	if err := c.Mail(a.from); err != nil {
+		return fmt.Errorf("setting sender: %w", err)
+	}
+	if err := c.Rcpt(msg.To); err != nil {
+		return fmt.Errorf("setting recipient: %w", err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("starting message data: %w", err)
+	}
+	if _, err := w.Write(a.buildMessage(msg)); err != nil {
+		return fmt.Errorf("writing message data: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("finishing message data: %w", err)
+	}
+	return c.Quit()
+}
+
+// buildMessage returns RFC 5322 message with headers and body with CRLF line endings.
+func (a *Adapter) buildMessage(msg bikerental.Message) []byte {
+	var b strings.Builder
+	headers := [][2]string{
+		{"From", a.from},
+		{"To", msg.To},
+		{"Subject", mime.QEncoding.Encode("utf-8", msg.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/plain; charset=utf-8"},
+		{"Content-Transfer-Encoding", "8bit"},
+	}
+	for _, h := range headers {
+		fmt.Fprintf(&b, "%s: %s\r\n", h[0], h[1])
+	}
+	b.WriteString("\r\n")
+
+	body := strings.ReplaceAll(msg.Body, "\r\n", "\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return []byte(b.String())
+}
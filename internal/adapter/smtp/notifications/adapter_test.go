@@ -0,0 +1,265 @@
+package notifications
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+type receivedMessage struct {
+	from string
+	to   []string
+	data []byte
+}
+
+// fakeSMTPServer is a minimal SMTP server receiving messages, without TLS.
+type fakeSMTPServer struct {
+	ln net.Listener
+
+	// credentials are required in "username:password" format, if set.
+	credentials string
+	rejectRcpt  bool
+	// silent server accepts connections, but never responds.
+	silent bool
+
+	mu       sync.Mutex
+	messages []receivedMessage
+}
+
+func newFakeSMTPServer(t *testing.T, setup func(*fakeSMTPServer)) *fakeSMTPServer {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	s := &fakeSMTPServer{ln: ln}
+	if setup != nil {
+		setup(s)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSMTPServer) addr() string {
+	return s.ln.Addr().String()
+}
+
+func (s *fakeSMTPServer) received() []receivedMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]receivedMessage(nil), s.messages...)
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+	if s.silent {
+		_, _ = io.Copy(io.Discard, conn)
+		return
+	}
+
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost ESMTP fake")
+
+	var msg receivedMessage
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO":
+			_ = tp.PrintfLine("250-localhost")
+			_ = tp.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			parts := strings.Fields(line)
+			decoded, _ := base64.StdEncoding.DecodeString(parts[len(parts)-1])
+			creds := strings.Split(string(decoded), "\x00")
+			if len(creds) != 3 || creds[1]+":"+creds[2] != s.credentials {
+				_ = tp.PrintfLine("535 authentication failed")
+				continue
+			}
+			_ = tp.PrintfLine("235 authenticated")
+		case "MAIL":
+			msg.from = smtpPath(line)
+			_ = tp.PrintfLine("250 ok")
+		case "RCPT":
+			if s.rejectRcpt {
+				_ = tp.PrintfLine("550 no such user")
+				continue
+			}
+			msg.to = append(msg.to, smtpPath(line))
+			_ = tp.PrintfLine("250 ok")
+		case "DATA":
+			_ = tp.PrintfLine("354 end data with <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			msg.data = data
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			msg = receivedMessage{}
+			_ = tp.PrintfLine("250 ok")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+// smtpPath returns address from "MAIL FROM:<address>" or "RCPT TO:<address>" command.
+func smtpPath(line string) string {
+	start, end := strings.Index(line, "<"), strings.Index(line, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return line[start+1 : end]
+}
+
+func TestAdapterSend(t *testing.T) {
+	srv := newFakeSMTPServer(t, nil)
+	a, err := NewAdapter(srv.addr(), "rentals@example.com", "", "", time.Second)
+	if err != nil {
+		t.Fatalf("creating adapter: %v", err)
+	}
+
+	err = a.Send(context.Background(), bikerental.Message{
+		To:      "customer@example.com",
+		Subject: "Rezerwacja potwierdzona – rower",
+		Body:    "Hello,\nyour reservation is confirmed.\n.\nSee you!",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	received := srv.received()
+	if len(received) != 1 {
+		t.Fatalf("received %d messages, want 1", len(received))
+	}
+	got := received[0]
+	if got.from != "rentals@example.com" {
+		t.Errorf("envelope sender = %s, want rentals@example.com", got.from)
+	}
+	if len(got.to) != 1 || got.to[0] != "customer@example.com" {
+		t.Errorf("envelope recipients = %v, want [customer@example.com]", got.to)
+	}
+
+	m, err := mail.ReadMessage(strings.NewReader(string(got.data)))
+	if err != nil {
+		t.Fatalf("parsing received message: %v", err)
+	}
+	if from := m.Header.Get("From"); from != "rentals@example.com" {
+		t.Errorf("From = %s, want rentals@example.com", from)
+	}
+	if to := m.Header.Get("To"); to != "customer@example.com" {
+		t.Errorf("To = %s, want customer@example.com", to)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	if err != nil || subject != "Rezerwacja potwierdzona – rower" {
+		t.Errorf("Subject = %s (%v), want decoded subject", subject, err)
+	}
+	if ct := m.Header.Get("Content-Type"); ct != "text/plain; charset=utf-8" {
+		t.Errorf("Content-Type = %s, want text/plain; charset=utf-8", ct)
+	}
+	if _, err := m.Header.Date(); err != nil {
+		t.Errorf("invalid Date header: %v", err)
+	}
+	body, _ := io.ReadAll(m.Body)
+	// ReadDotBytes converts CRLF line endings and unescapes leading dots.
+	if want := "Hello,\nyour reservation is confirmed.\n.\nSee you!\n"; string(body) != want {
+		t.Errorf("body = %q, want %q", body, want)
+	}
+}
+
+func TestAdapterSendAuth(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{name: "valid credentials", password: "secret"},
+		{name: "invalid credentials", password: "wrong", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newFakeSMTPServer(t, func(s *fakeSMTPServer) { s.credentials = "user:secret" })
+			a, err := NewAdapter(srv.addr(), "rentals@example.com", "user", tt.password, time.Second)
+			if err != nil {
+				t.Fatalf("creating adapter: %v", err)
+			}
+
+			err = a.Send(context.Background(), bikerental.Message{To: "customer@example.com", Subject: "Hi", Body: "Hi"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Send() error = %v, want error: %t", err, tt.wantErr)
+			}
+			wantMessages := 1
+			if tt.wantErr {
+				wantMessages = 0
+			}
+			if n := len(srv.received()); n != wantMessages {
+				t.Errorf("received %d messages, want %d", n, wantMessages)
+			}
+		})
+	}
+}
+
+func TestAdapterSendErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*fakeSMTPServer)
+	}{
+		{
+			name:  "recipient rejected",
+			setup: func(s *fakeSMTPServer) { s.rejectRcpt = true },
+		},
+		{
+			name:  "server not responding",
+			setup: func(s *fakeSMTPServer) { s.silent = true },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newFakeSMTPServer(t, tt.setup)
+			a, err := NewAdapter(srv.addr(), "rentals@example.com", "", "", 100*time.Millisecond)
+			if err != nil {
+				t.Fatalf("creating adapter: %v", err)
+			}
+
+			start := time.Now()
+			err = a.Send(context.Background(), bikerental.Message{To: "customer@example.com", Subject: "Hi", Body: "Hi"})
+			if err == nil {
+				t.Fatal("Send() error = nil, want error")
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("Send() took %s, timeout not applied", elapsed)
+			}
+			if n := len(srv.received()); n != 0 {
+				t.Errorf("received %d messages, want 0", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package bikerental
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// CheckCustomerAccess returns app.ForbiddenError if the caller is a customer other than the given one.
+// Staff and admins can access data of all customers.
+// Calls without identity in context are made by the app itself, so they are allowed.
+func CheckCustomerAccess(ctx context.Context, customerID string) error {
+	caller, ok := app.IdentityFromCtx(ctx)
+	if !ok {
+		return nil
+	}
+	if id, isCustomer := caller.CustomerID(); isCustomer && id != customerID {
+		return app.NewForbiddenError("customers can access only their own data")
+	}
+	return nil
+}
+
+// CallerCustomerID returns id of the calling customer, or false if the caller is not a customer.
+func CallerCustomerID(ctx context.Context) (string, bool) {
+	caller, ok := app.IdentityFromCtx(ctx)
+	if !ok {
+		return "", false
+	}
+	return caller.CustomerID()
+}
+
+// CheckCustomerCancelAccess returns app.ForbiddenError if the caller is a customer who can't cancel the reservation.
+// Customers can cancel only their own reservations that are still approved.
+// Completed or rejected reservations can be changed only by staff.
+func CheckCustomerCancelAccess(ctx context.Context, reservation Reservation) error {
+	if err := CheckCustomerAccess(ctx, reservation.Customer.ID); err != nil {
+		return err
+	}
+	if _, isCustomer := CallerCustomerID(ctx); !isCustomer {
+		return nil
+	}
+	switch reservation.Status {
+	case ReservationStatusApproved, ReservationStatusCanceled:
+		return nil
+	default:
+		return app.NewForbiddenError(fmt.Sprintf("customers can't cancel %s reservations", reservation.Status))
+	}
+}
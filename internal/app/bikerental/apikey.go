@@ -0,0 +1,88 @@
+package bikerental
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// APIKeyPrefix starts every API key, so keys can be told apart from bearer tokens.
+const APIKeyPrefix = "brk_"
+
+// APIKey is a key authenticating calls of a tenant system.
+// Only hash of the key is stored, the key itself is shown once, when it's issued.
+type APIKey struct {
+	ID       string
+	TenantID string
+	Name     string
+	Role     app.Role
+
+	// Prefix is a beginning of the key, to help recognizing it.
+	Prefix string
+	Hash   string
+
+	CreatedAt time.Time
+
+	// RevokedAt is zero for active keys.
+	RevokedAt time.Time
+}
+
+// IsRevoked returns true if key can't be used anymore.
+func (k APIKey) IsRevoked() bool {
+	return !k.RevokedAt.IsZero()
+}
+
+// HashAPIKey returns hash of API key stored in place of the key.
+// Keys are long and random, so fast hash is enough.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IssueAPIKeyRequest is a request for issuing new API key.
+type IssueAPIKeyRequest struct {
+	// Name describes what the key is used for.
+	Name string
+
+	// Role is admin or staff. Customers authenticate with JWTs.
+	Role app.Role
+}
+
+// Validate validates request data.
+func (r IssueAPIKeyRequest) Validate() error {
+	if r.Name == "" {
+		return app.NewValidationError("api key name can't be empty")
+	}
+	if r.Role != app.RoleAdmin && r.Role != app.RoleStaff {
+		return app.NewValidationError("api key role has to be admin or staff")
+	}
+	return nil
+}
+
+// IssuedAPIKey is a new API key with the key itself.
+type IssuedAPIKey struct {
+	Key    APIKey
+	Secret string
+}
+
+// APIKeyService manages API keys of the caller's tenant.
+type APIKeyService interface {
+	ListAPIKeys(context.Context) ([]APIKey, error)
+	IssueAPIKey(context.Context, IssueAPIKeyRequest) (*IssuedAPIKey, error)
+	RevokeAPIKey(ctx context.Context, id string) error
+}
+
+// Authenticator verifies caller credentials: API key or bearer token.
+// Returns app.ErrUnauthenticated if credentials are missing or invalid.
+type Authenticator interface {
+	Authenticate(ctx context.Context, credentials string) (app.Identity, error)
+}
+
+// TokenVerifier is a port for verifying bearer tokens, like JWTs.
+// Returns app.ErrUnauthenticated if token is invalid.
+type TokenVerifier interface {
+	VerifyToken(ctx context.Context, token string) (app.Identity, error)
+}
@@ -0,0 +1,24 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// APIKeyRepository manages API keys.
+type APIKeyRepository interface {
+	// List returns keys of a tenant, revoked too.
+	List(ctx context.Context, tenantID string) ([]bikerental.APIKey, error)
+
+	// GetByHash returns key by hash of the key.
+	// Returns app.ErrNotFound if key doesn't exist.
+	GetByHash(ctx context.Context, hash string) (*bikerental.APIKey, error)
+
+	Create(context.Context, bikerental.APIKey) error
+
+	// Revoke marks key of a tenant as revoked, if it's not revoked yet.
+	// Returns app.ErrNotFound if tenant has no such key.
+	Revoke(ctx context.Context, tenantID, id string, at time.Time) error
+}
@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// keyPrefixLength is a length of key beginning stored in plain text, including APIKeyPrefix.
+const keyPrefixLength = len(bikerental.APIKeyPrefix) + 6
+
+// Service authenticates callers and manages API keys.
+type Service struct {
+	tokenVerifier bikerental.TokenVerifier
+	keysRepo      APIKeyRepository
+}
+
+// NewService creates new service instance.
+func NewService(tokenVerifier bikerental.TokenVerifier, keysRepo APIKeyRepository) (*Service, error) {
+	if tokenVerifier == nil {
+		return nil, errors.New("empty token verifier")
+	}
+	if keysRepo == nil {
+		return nil, errors.New("empty api keys repository")
+	}
+	return &Service{
+		tokenVerifier: tokenVerifier,
+		keysRepo:      keysRepo,
+	}, nil
+}
+
+// Authenticate returns identity of the caller with given API key or bearer token.
+func (s *Service) Authenticate(ctx context.Context, credentials string) (app.Identity, error) {
+	switch {
+	case credentials == "":
+		return app.Identity{}, fmt.Errorf("%w: missing credentials", app.ErrUnauthenticated)
+	case strings.HasPrefix(credentials, bikerental.APIKeyPrefix):
+		return s.authenticateAPIKey(ctx, credentials)
+	default:
+		return s.tokenVerifier.VerifyToken(ctx, credentials)
+	}
+}
+
+func (s *Service) authenticateAPIKey(ctx context.Context, key string) (app.Identity, error) {
+	k, err := s.keysRepo.GetByHash(ctx, bikerental.HashAPIKey(key))
+	if err != nil {
+		if app.IsNotFoundError(err) {
+			return app.Identity{}, fmt.Errorf("%w: invalid api key", app.ErrUnauthenticated)
+		}
+		return app.Identity{}, fmt.Errorf("fetching api key from repository: %w", err)
+	}
+	if k.IsRevoked() {
+		return app.Identity{}, fmt.Errorf("%w: api key revoked", app.ErrUnauthenticated)
+	}
+	return app.Identity{
+		Subject:  k.ID,
+		TenantID: k.TenantID,
+		Method:   app.AuthMethodAPIKey,
+		Role:     k.Role,
+	}, nil
+}
+
+// ListAPIKeys returns keys of the caller's tenant.
+func (s *Service) ListAPIKeys(ctx context.Context) ([]bikerental.APIKey, error) {
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.keysRepo.List(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching api keys from repository: %w", err)
+	}
+	return keys, nil
+}
+
+// IssueAPIKey creates new key for the caller's tenant.
+// Returned secret is the key itself, it can't be retrieved later.
+func (s *Service) IssueAPIKey(ctx context.Context, req bikerental.IssueAPIKeyRequest) (*bikerental.IssuedAPIKey, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := newKeySecret()
+	if err != nil {
+		return nil, err
+	}
+	key := bikerental.APIKey{
+		ID:        uuid.NewString(),
+		TenantID:  tenantID,
+		Name:      req.Name,
+		Role:      req.Role,
+		Prefix:    secret[:keyPrefixLength],
+		Hash:      bikerental.HashAPIKey(secret),
+		CreatedAt: time.Now(),
+	}
+	if err := s.keysRepo.Create(ctx, key); err != nil {
+		return nil, fmt.Errorf("adding api key to repository: %w", err)
+	}
+	return &bikerental.IssuedAPIKey{
+		Key:    key,
+		Secret: secret,
+	}, nil
+}
+
+// RevokeAPIKey revokes key of the caller's tenant.
+// Returns app.ErrNotFound if tenant has no such key.
+func (s *Service) RevokeAPIKey(ctx context.Context, id string) error {
+	if id == "" {
+		return app.NewValidationError("empty id")
+	}
+	tenantID, err := tenantFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.keysRepo.Revoke(ctx, tenantID, id, time.Now()); err != nil {
+		return fmt.Errorf("revoking api key in repository: %w", err)
+	}
+	return nil
+}
+
+func tenantFromCtx(ctx context.Context) (string, error) {
+	if _, ok := app.IdentityFromCtx(ctx); !ok {
+		return "", app.ErrUnauthenticated
+	}
+	tenantID, ok := app.TenantIDFromCtx(ctx)
+	if !ok {
+		return "", app.NewValidationError("unknown tenant")
+	}
+	return tenantID, nil
+}
+
+// newKeySecret generates random API key.
+func newKeySecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating api key: %w", err)
+	}
+	return bikerental.APIKeyPrefix + hex.EncodeToString(b), nil
+}
@@ -2,17 +2,33 @@ package bikerental
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/nglogic/go-application-guide/internal/app"
 )
 
+// BikeType describes kind of a bike. Security deposit depends on it.
+type BikeType string
+
+// Bike types.
+const (
+	BikeTypeStandard BikeType = "standard"
+	BikeTypeElectric BikeType = "electric"
+	BikeTypeCargo    BikeType = "cargo"
+)
+
 // Bike represents a bike for rent.
 type Bike struct {
 	ID        string
+	Type      BikeType
 	ModelName string
 	Weight    float64
-	// PricePerHour in eurocents
-	PricePerHour int
+	// PricePerHour determines currency of the bike reservations.
+	PricePerHour Money
+	// OutOfService is true when the bike can't be rented, for example because it's damaged.
+	OutOfService bool
+	// StationID is id of a station where the bike is picked up and returned.
+	StationID string
 }
 
 // Validate validates bike data.
@@ -23,6 +39,17 @@ func (b *Bike) Validate() error {
 	if b.Weight == 0 {
 		return app.NewValidationError("empty weight")
 	}
+	if err := b.PricePerHour.Validate(); err != nil {
+		return fmt.Errorf("invalid price per hour: %w", err)
+	}
+	if err := b.PricePerHour.Currency.Validate(); err != nil {
+		return fmt.Errorf("invalid price per hour: %w", err)
+	}
+	switch b.Type {
+	case BikeTypeStandard, BikeTypeElectric, BikeTypeCargo:
+	default:
+		return app.NewValidationError("invalid bike type")
+	}
 
 	return nil
 }
@@ -7,10 +7,13 @@ import (
 )
 
 // Repository can manage bike data.
+// Events passed to write methods are stored in outbox in the same transaction as bike changes.
 type Repository interface {
 	List(context.Context) ([]bikerental.Bike, error)
 	Get(ctx context.Context, id string) (*bikerental.Bike, error)
-	Create(context.Context, bikerental.Bike) error
-	Update(ctx context.Context, id string, b bikerental.Bike) error
-	Delete(ctx context.Context, id string) error
+	Create(context.Context, bikerental.Bike, bikerental.Event) error
+	Update(ctx context.Context, id string, b bikerental.Bike, event bikerental.Event) error
+
+	// Delete deletes a bike. Event is stored only if the bike existed.
+	Delete(ctx context.Context, id string, event bikerental.Event) error
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nglogic/go-application-guide/internal/app"
@@ -54,7 +55,11 @@ func (s *Service) Add(ctx context.Context, b bikerental.Bike) (*bikerental.Bike,
 	}
 
 	b.ID = uuid.NewString()
-	if err := s.repository.Create(ctx, b); err != nil {
+	event, err := bikerental.NewBikeEvent(bikerental.EventTypeBikeCreated, b, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.Create(ctx, b, event); err != nil {
 		return nil, fmt.Errorf("adding bike to repository: %w", err)
 	}
 
@@ -79,7 +84,11 @@ func (s *Service) Update(ctx context.Context, id string, b bikerental.Bike) erro
 	}
 
 	b.ID = id
-	if err := s.repository.Update(ctx, id, b); err != nil {
+	event, err := bikerental.NewBikeEvent(bikerental.EventTypeBikeUpdated, b, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := s.repository.Update(ctx, id, b, event); err != nil {
 		return fmt.Errorf("updating bike in repository: %w", err)
 	}
 	return nil
@@ -90,7 +99,11 @@ func (s *Service) Delete(ctx context.Context, id string) error {
 	if id == "" {
 		return app.NewValidationError("empty id")
 	}
-	if err := s.repository.Delete(ctx, id); err != nil {
+	event, err := bikerental.NewBikeEvent(bikerental.EventTypeBikeDeleted, bikerental.Bike{ID: id}, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := s.repository.Delete(ctx, id, event); err != nil {
 		if app.IsNotFoundError(err) {
 			return nil
 		}
@@ -0,0 +1,16 @@
+package bikerental
+
+import "context"
+
+// BlobStore stores binary objects, like photos, by key.
+type BlobStore interface {
+	// Put saves an object under the key. Existing object is overwritten.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Get returns an object by key.
+	// Returns app.ErrNotFound if object doesn't exist.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Delete deletes an object by key. Deleting object that doesn't exist is not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,74 @@
+package bikerental
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// BookingPolicy defines limits for reservation time range.
+// Zero value of any attribute means there is no such limit.
+type BookingPolicy struct {
+	MinDuration time.Duration
+	MaxDuration time.Duration
+
+	// MaxAdvance limits how far in the future a reservation can start.
+	MaxAdvance time.Duration
+
+	// SlotGranularity forces reservation start and end to be aligned, for example to 15-minute boundaries.
+	// Slots are aligned to local wall clock time of the station, for example 1 day slots start at local midnight.
+	SlotGranularity time.Duration
+}
+
+// Check returns ValidationError if reservation time range violates the policy.
+// Location is a time zone of the station, it's used for aligning slots.
+func (p BookingPolicy) Check(start, end, now time.Time, loc *time.Location) error {
+	if p.SlotGranularity > 0 {
+		if !isAligned(start, p.SlotGranularity, loc) {
+			return app.NewValidationError(fmt.Sprintf("start time has to be aligned to %s slots", p.SlotGranularity))
+		}
+		if !isAligned(end, p.SlotGranularity, loc) {
+			return app.NewValidationError(fmt.Sprintf("end time has to be aligned to %s slots", p.SlotGranularity))
+		}
+	}
+
+	duration := end.Sub(start)
+	if p.MinDuration > 0 && duration < p.MinDuration {
+		return app.NewValidationError(fmt.Sprintf("reservation is too short: %s, minimum duration is %s", duration, p.MinDuration))
+	}
+	if p.MaxDuration > 0 && duration > p.MaxDuration {
+		return app.NewValidationError(fmt.Sprintf("reservation is too long: %s, maximum duration is %s", duration, p.MaxDuration))
+	}
+
+	if advance := start.Sub(now); p.MaxAdvance > 0 && advance > p.MaxAdvance {
+		return app.NewValidationError(fmt.Sprintf("reservation starts too far in the future, it can be made at most %s in advance", p.MaxAdvance))
+	}
+
+	return nil
+}
+
+// isAligned returns true if local wall clock time in given location is aligned to slots of given size.
+// Time.Truncate aligns to UTC, so wall clock time is truncated as if it was UTC time.
+func isAligned(t time.Time, slot time.Duration, loc *time.Location) bool {
+	if loc == nil {
+		loc = time.UTC
+	}
+	_, offset := t.In(loc).Zone()
+	wall := t.Add(time.Duration(offset) * time.Second).UTC()
+	return wall.Truncate(slot).Equal(wall)
+}
+
+// BookingPolicies defines booking policies for each customer type.
+type BookingPolicies struct {
+	Individual BookingPolicy
+	Business   BookingPolicy
+}
+
+// For returns booking policy for a customer type.
+func (p BookingPolicies) For(t CustomerType) BookingPolicy {
+	if t == CustomerTypeBusiness {
+		return p.Business
+	}
+	return p.Individual
+}
@@ -0,0 +1,82 @@
+package bikerental
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+func TestBookingPolicyCheckSlots(t *testing.T) {
+	kolkata := loadLocation(t, "Asia/Kolkata")
+	warsaw := loadLocation(t, "Europe/Warsaw")
+	now := utc("2021-06-01T00:00:00Z")
+	tests := []struct {
+		name    string
+		slot    time.Duration
+		loc     *time.Location
+		start   time.Time
+		end     time.Time
+		wantErr bool
+	}{
+		{
+			name:  "utc by default",
+			slot:  time.Hour,
+			start: utc("2021-06-07T10:00:00Z"),
+			end:   utc("2021-06-07T12:00:00Z"),
+		},
+		{
+			name:    "not aligned",
+			slot:    15 * time.Minute,
+			start:   utc("2021-06-07T10:05:00Z"),
+			end:     utc("2021-06-07T12:00:00Z"),
+			wantErr: true,
+		},
+		{
+			name:  "hours aligned to half hour offset",
+			slot:  time.Hour,
+			loc:   kolkata,
+			start: utc("2021-06-07T04:30:00Z"), // 10:00 local
+			end:   utc("2021-06-07T06:30:00Z"),
+		},
+		{
+			name:    "utc hours in half hour offset",
+			slot:    time.Hour,
+			loc:     kolkata,
+			start:   utc("2021-06-07T04:00:00Z"), // 09:30 local
+			end:     utc("2021-06-07T06:00:00Z"),
+			wantErr: true,
+		},
+		{
+			name:  "days from local midnight",
+			slot:  24 * time.Hour,
+			loc:   warsaw,
+			start: utc("2021-06-06T22:00:00Z"),
+			end:   utc("2021-06-08T22:00:00Z"),
+		},
+		{
+			name:    "days from utc midnight",
+			slot:    24 * time.Hour,
+			loc:     warsaw,
+			start:   utc("2021-06-07T00:00:00Z"),
+			end:     utc("2021-06-09T00:00:00Z"),
+			wantErr: true,
+		},
+		{
+			name:  "days across time change",
+			slot:  24 * time.Hour,
+			loc:   warsaw,
+			start: utc("2021-10-29T22:00:00Z"), // October 30th local midnight, summer time
+			end:   utc("2021-10-31T23:00:00Z"), // November 1st local midnight, winter time
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := BookingPolicy{SlotGranularity: tt.slot}
+			err := p.Check(tt.start, tt.end, now, tt.loc)
+			if tt.wantErr != app.IsValidationError(err) || (!tt.wantErr && err != nil) {
+				t.Errorf("Check() error = %v, want validation error: %t", err, tt.wantErr)
+			}
+		})
+	}
+}
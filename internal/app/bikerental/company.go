@@ -0,0 +1,76 @@
+package bikerental
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// ErrCompanySpendingLimitReached is returned when reservation would exceed monthly spending limit of a company.
+var ErrCompanySpendingLimitReached = app.ConflictError{Err: errors.New("company spending limit reached")}
+
+// Company is a corporate account. Company employees are business customers, members of the company.
+type Company struct {
+	ID   string
+	Name string
+
+	// DiscountPercent is a negotiated discount for all company members.
+	DiscountPercent float64
+
+	// SpendingLimit is a limit of total value of members reservations in one calendar month, in BaseCurrency.
+	// Zero means no limit.
+	SpendingLimit Money
+}
+
+// Validate validates company data.
+func (c Company) Validate() error {
+	if c.Name == "" {
+		return app.NewValidationError("empty name")
+	}
+	if c.DiscountPercent < 0 || c.DiscountPercent > 100 {
+		return app.NewValidationError("discount percent has to be in [0, 100] range")
+	}
+	if err := c.SpendingLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid spending limit: %w", err)
+	}
+	if !c.SpendingLimit.IsZero() && c.SpendingLimit.Currency != BaseCurrency {
+		return app.NewValidationError(fmt.Sprintf("spending limit has to be in %s", BaseCurrency))
+	}
+	return nil
+}
+
+// CompanyStatement is a monthly consolidated statement of all company members reservations.
+// Reservations are included in the month of their start time, in UTC.
+type CompanyStatement struct {
+	Company      Company
+	Year         int
+	Month        time.Month
+	Reservations []Reservation
+
+	// TotalValue in BaseCurrency, converted with reservations exchange rates.
+	TotalValue Money
+
+	// AppliedDiscount in BaseCurrency, converted with reservations exchange rates.
+	AppliedDiscount Money
+}
+
+// CompanyStatementPeriod returns time range of a monthly statement: [from, to).
+func CompanyStatementPeriod(year int, month time.Month) (from, to time.Time) {
+	from = time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return from, from.AddDate(0, 1, 0)
+}
+
+// CompanyService manages corporate accounts.
+type CompanyService interface {
+	ListCompanies(context.Context) ([]Company, error)
+	GetCompany(ctx context.Context, id string) (*Company, error)
+	CreateCompany(context.Context, Company) (*Company, error)
+	UpdateCompany(ctx context.Context, id string, c Company) error
+	ListMembers(ctx context.Context, companyID string) ([]Customer, error)
+	AddMember(ctx context.Context, companyID, customerID string) (*Customer, error)
+	RemoveMember(ctx context.Context, companyID, customerID string) error
+	GetStatement(ctx context.Context, companyID string, year int, month time.Month) (*CompanyStatement, error)
+}
@@ -0,0 +1,43 @@
+package company
+
+import (
+	"context"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Repository provides methods for reading/writing companies.
+type Repository interface {
+	// List returns all companies.
+	List(context.Context) ([]bikerental.Company, error)
+
+	// Get returns company by id.
+	// Returns app.ErrNotFound if company doesn't exist.
+	Get(ctx context.Context, id string) (*bikerental.Company, error)
+
+	// Create creates new company.
+	Create(context.Context, bikerental.Company) error
+
+	// Update updates company by id.
+	// Returns app.ErrNotFound if company doesn't exist.
+	Update(ctx context.Context, id string, c bikerental.Company) error
+
+	// ListMembers returns all customers that are members of the company.
+	ListMembers(ctx context.Context, companyID string) ([]bikerental.Customer, error)
+
+	// ListMemberReservations returns approved and completed reservations of all company members,
+	// starting in given time range: [from, to).
+	ListMemberReservations(ctx context.Context, companyID string, from, to time.Time) ([]bikerental.Reservation, error)
+}
+
+// CustomerRepository provides methods for reading/writing customer data.
+type CustomerRepository interface {
+	// Get returns customer by id.
+	// Returns app.ErrNotFound if customer doesn't exist.
+	Get(ctx context.Context, id string) (*bikerental.Customer, error)
+
+	// Update updates customer by id.
+	// Returns app.ErrNotFound if customer doesn't exist.
+	Update(ctx context.Context, id string, c bikerental.Customer) error
+}
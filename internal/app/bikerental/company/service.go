@@ -0,0 +1,164 @@
+package company
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service manages corporate accounts.
+type Service struct {
+	repository    Repository
+	customersRepo CustomerRepository
+}
+
+// NewService creates new service instance.
+func NewService(repository Repository, customersRepo CustomerRepository) (*Service, error) {
+	if repository == nil {
+		return nil, errors.New("empty companies repository")
+	}
+	if customersRepo == nil {
+		return nil, errors.New("empty customers repository")
+	}
+	return &Service{
+		repository:    repository,
+		customersRepo: customersRepo,
+	}, nil
+}
+
+// ListCompanies returns all companies.
+func (s *Service) ListCompanies(ctx context.Context) ([]bikerental.Company, error) {
+	companies, err := s.repository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching companies from repository: %w", err)
+	}
+	return companies, nil
+}
+
+// GetCompany returns company by id.
+func (s *Service) GetCompany(ctx context.Context, id string) (*bikerental.Company, error) {
+	c, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("fetching company from repository: %w", err)
+	}
+	return c, nil
+}
+
+// CreateCompany creates new company.
+// Returns created company with new id.
+func (s *Service) CreateCompany(ctx context.Context, c bikerental.Company) (*bikerental.Company, error) {
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid company: %w", err)
+	}
+	c.ID = uuid.NewString()
+
+	if err := s.repository.Create(ctx, c); err != nil {
+		return nil, fmt.Errorf("creating company in repository: %w", err)
+	}
+	return &c, nil
+}
+
+// UpdateCompany updates company by id.
+func (s *Service) UpdateCompany(ctx context.Context, id string, c bikerental.Company) error {
+	if err := c.Validate(); err != nil {
+		return fmt.Errorf("invalid company: %w", err)
+	}
+	if err := s.repository.Update(ctx, id, c); err != nil {
+		return fmt.Errorf("updating company in repository: %w", err)
+	}
+	return nil
+}
+
+// ListMembers returns all customers that are members of the company.
+func (s *Service) ListMembers(ctx context.Context, companyID string) ([]bikerental.Customer, error) {
+	if _, err := s.repository.Get(ctx, companyID); err != nil {
+		return nil, fmt.Errorf("fetching company from repository: %w", err)
+	}
+
+	members, err := s.repository.ListMembers(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching company members from repository: %w", err)
+	}
+	return members, nil
+}
+
+// AddMember makes customer a member of the company.
+// Customer becomes a business customer. Customer can be a member of one company only.
+func (s *Service) AddMember(ctx context.Context, companyID, customerID string) (*bikerental.Customer, error) {
+	if _, err := s.repository.Get(ctx, companyID); err != nil {
+		return nil, fmt.Errorf("fetching company from repository: %w", err)
+	}
+
+	customer, err := s.customersRepo.Get(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching customer from repository: %w", err)
+	}
+	if customer.CompanyID != "" && customer.CompanyID != companyID {
+		return nil, app.NewConflictError("customer is a member of another company")
+	}
+
+	customer.CompanyID = companyID
+	customer.Type = bikerental.CustomerTypeBusiness
+	if err := s.customersRepo.Update(ctx, customerID, *customer); err != nil {
+		return nil, fmt.Errorf("updating customer in repository: %w", err)
+	}
+	return customer, nil
+}
+
+// RemoveMember removes customer from the company.
+// Returns app.ErrNotFound if customer is not a member of the company.
+func (s *Service) RemoveMember(ctx context.Context, companyID, customerID string) error {
+	customer, err := s.customersRepo.Get(ctx, customerID)
+	if err != nil {
+		return fmt.Errorf("fetching customer from repository: %w", err)
+	}
+	if customer.CompanyID != companyID {
+		return app.ErrNotFound
+	}
+
+	customer.CompanyID = ""
+	if err := s.customersRepo.Update(ctx, customerID, *customer); err != nil {
+		return fmt.Errorf("updating customer in repository: %w", err)
+	}
+	return nil
+}
+
+// GetStatement returns monthly consolidated statement of all company members reservations.
+func (s *Service) GetStatement(ctx context.Context, companyID string, year int, month time.Month) (*bikerental.CompanyStatement, error) {
+	if month < time.January || month > time.December {
+		return nil, app.NewValidationError("invalid month")
+	}
+	if year < 2000 || year > 9999 {
+		return nil, app.NewValidationError("invalid year")
+	}
+
+	company, err := s.repository.Get(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching company from repository: %w", err)
+	}
+
+	from, to := bikerental.CompanyStatementPeriod(year, month)
+	reservations, err := s.repository.ListMemberReservations(ctx, companyID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("fetching company reservations from repository: %w", err)
+	}
+
+	statement := &bikerental.CompanyStatement{
+		Company:      *company,
+		Year:         year,
+		Month:        month,
+		Reservations: reservations,
+	}
+	statement.TotalValue = bikerental.NewMoney(0, bikerental.BaseCurrency)
+	statement.AppliedDiscount = bikerental.NewMoney(0, bikerental.BaseCurrency)
+	for _, r := range reservations {
+		statement.TotalValue = statement.TotalValue.Add(r.ToBase(r.TotalValue))
+		statement.AppliedDiscount = statement.AppliedDiscount.Add(r.ToBase(r.AppliedDiscount))
+	}
+	return statement, nil
+}
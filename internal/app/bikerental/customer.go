@@ -24,6 +24,12 @@ type Customer struct {
 	FirstName string
 	Surname   string
 	Email     string
+
+	// CompanyID is set for business customers that are members of a company.
+	CompanyID string
+
+	// VATID is optional VAT identification number of business customers, e.g. "DE123456789".
+	VATID string
 }
 
 // Validate validates customer data.
@@ -49,5 +55,14 @@ func (c Customer) Validate() error {
 		}
 	}
 
+	if c.VATID != "" {
+		if c.Type != CustomerTypeBusiness {
+			return app.NewValidationError("only business customers can have VAT id")
+		}
+		if err := ValidateVATID(c.VATID); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,144 @@
+package bikerental
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+const (
+	// maxDamagePhotoSize is a maximum size of a single damage report photo in bytes.
+	maxDamagePhotoSize = 10 << 20
+)
+
+// DamageSeverity describes how serious the damage of a bike is.
+type DamageSeverity string
+
+// Damage severities.
+const (
+	DamageSeverityUnknown DamageSeverity = ""
+	DamageSeverityLow     DamageSeverity = "low"
+	DamageSeverityMedium  DamageSeverity = "medium"
+	DamageSeverityHigh    DamageSeverity = "high"
+)
+
+// DamageReport represents damage of a bike, noticed during a rental.
+type DamageReport struct {
+	ID            string
+	ReservationID string
+	BikeID        string
+	Description   string
+	Severity      DamageSeverity
+
+	// RepairCostEstimate is in reservation currency.
+	RepairCostEstimate Money
+
+	// PhotoIDs are ids of damage photos kept in the blob store.
+	PhotoIDs []string
+
+	CreatedAt time.Time
+}
+
+// DamagePhoto is a photo attached to a damage report.
+type DamagePhoto struct {
+	// ContentType is a MIME type of the photo, for example "image/jpeg".
+	ContentType string
+	Data        []byte
+}
+
+// Validate validates photo data.
+func (p *DamagePhoto) Validate() error {
+	if _, ok := DamagePhotoExtension(p.ContentType); !ok {
+		return app.NewValidationError(fmt.Sprintf("unsupported photo content type: '%s'", p.ContentType))
+	}
+	if len(p.Data) == 0 {
+		return app.NewValidationError("empty photo data")
+	}
+	if len(p.Data) > maxDamagePhotoSize {
+		return app.NewValidationError(fmt.Sprintf("photo is too big, max size is %d bytes", maxDamagePhotoSize))
+	}
+	return nil
+}
+
+// DamagePhotoExtension returns file extension for supported photo content type.
+func DamagePhotoExtension(contentType string) (string, bool) {
+	switch contentType {
+	case "image/jpeg":
+		return ".jpg", true
+	case "image/png":
+		return ".png", true
+	default:
+		return "", false
+	}
+}
+
+// DamageReportService manages damage reports.
+type DamageReportService interface {
+	FileReport(ctx context.Context, req FileDamageReportRequest) (*DamageReport, error)
+	ListReports(ctx context.Context, req ListDamageReportsRequest) ([]DamageReport, error)
+	GetPhoto(ctx context.Context, bikeID, reportID, photoID string) (*DamagePhoto, error)
+}
+
+// FileDamageReportRequest is a request for filing new damage report.
+type FileDamageReportRequest struct {
+	ReservationID string
+	BikeID        string
+	Description   string
+	Severity      DamageSeverity
+
+	// RepairCostEstimate has to be in reservation currency. If currency is empty, reservation currency is used.
+	RepairCostEstimate Money
+
+	Photos []DamagePhoto
+}
+
+// Validate validates request data.
+func (r *FileDamageReportRequest) Validate() error {
+	if r.ReservationID == "" {
+		return app.NewValidationError("reservation id is empty")
+	}
+	if r.BikeID == "" {
+		return app.NewValidationError("bike id is empty")
+	}
+	if r.Description == "" {
+		return app.NewValidationError("description is empty")
+	}
+	switch r.Severity {
+	case DamageSeverityLow, DamageSeverityMedium, DamageSeverityHigh:
+	default:
+		return app.NewValidationError("invalid damage severity")
+	}
+	if r.RepairCostEstimate.Amount < 0 {
+		return app.NewValidationError("repair cost estimate can't be negative")
+	}
+	if r.RepairCostEstimate.Currency != "" {
+		if err := r.RepairCostEstimate.Currency.Validate(); err != nil {
+			return fmt.Errorf("invalid repair cost estimate: %w", err)
+		}
+	}
+	for i := range r.Photos {
+		if err := r.Photos[i].Validate(); err != nil {
+			return fmt.Errorf("invalid photo #%d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// ListDamageReportsRequest is a request for listing damage reports.
+type ListDamageReportsRequest struct {
+	BikeID string
+
+	// ReservationID is optional. If set, only reports for that reservation are returned.
+	ReservationID string
+}
+
+// Validate validates request data.
+func (r *ListDamageReportsRequest) Validate() error {
+	if r.BikeID == "" {
+		return app.NewValidationError("bike id can't be empty")
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package damage
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Repository provides methods for reading/writing damage reports.
+type Repository interface {
+	// List returns damage reports matching query criteria, sorted from the newest.
+	List(context.Context, ListReportsQuery) ([]bikerental.DamageReport, error)
+
+	// Get returns damage report by id.
+	// Returns app.ErrNotFound if report doesn't exist.
+	Get(ctx context.Context, id string) (*bikerental.DamageReport, error)
+
+	// Create creates new damage report.
+	// If bike event is given, the bike is put out of service and the event is stored in outbox,
+	// in the same transaction as the report.
+	Create(ctx context.Context, report bikerental.DamageReport, outOfServiceEvent *bikerental.Event) error
+}
+
+// ListReportsQuery is a set of filters for damage reports result.
+type ListReportsQuery struct {
+	BikeID        string
+	ReservationID string
+}
+
+// ReservationRepository provides methods for reading reservation data.
+type ReservationRepository interface {
+	// Get returns a reservation by id.
+	// Returns app.ErrNotFound if reservation doesn't exists.
+	Get(ctx context.Context, id string) (*bikerental.Reservation, error)
+}
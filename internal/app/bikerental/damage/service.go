@@ -0,0 +1,225 @@
+package damage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+const (
+	// photosKeyPrefix is a prefix for all damage photo keys in blob store.
+	photosKeyPrefix = "damagereports"
+)
+
+// Service provides methods for managing damage reports.
+type Service struct {
+	repository       Repository
+	reservationsRepo ReservationRepository
+	bikeService      bikerental.BikeService
+	blobStore        bikerental.BlobStore
+}
+
+// NewService creates new service instance.
+func NewService(
+	repository Repository,
+	reservationsRepo ReservationRepository,
+	bikeService bikerental.BikeService,
+	blobStore bikerental.BlobStore,
+) (*Service, error) {
+	if repository == nil {
+		return nil, errors.New("empty damage reports repository")
+	}
+	if reservationsRepo == nil {
+		return nil, errors.New("empty reservations repository")
+	}
+	if bikeService == nil {
+		return nil, errors.New("empty bike service")
+	}
+	if blobStore == nil {
+		return nil, errors.New("empty blob store")
+	}
+
+	return &Service{
+		repository:       repository,
+		reservationsRepo: reservationsRepo,
+		bikeService:      bikeService,
+		blobStore:        blobStore,
+	}, nil
+}
+
+// FileReport creates new damage report for a reservation.
+// If damage is severe, the bike is put out of service.
+func (s *Service) FileReport(ctx context.Context, req bikerental.FileDamageReportRequest) (_ *bikerental.DamageReport, err error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	reservation, err := s.reservationsRepo.Get(ctx, req.ReservationID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching reservation by id from repository: %w", err)
+	}
+	// If the bike id doesn't match it's basically the same as invalid reservation id.
+	if reservation.Bike.ID != req.BikeID {
+		return nil, app.ErrNotFound
+	}
+
+	// Repair cost is covered from security deposit, so it has to be in reservation currency.
+	repairCost := req.RepairCostEstimate
+	currency := reservation.TotalValue.Currency
+	if repairCost.Currency == "" {
+		repairCost.Currency = currency
+	}
+	if repairCost.Currency != currency {
+		return nil, app.NewValidationError(fmt.Sprintf("repair cost estimate has to be in reservation currency %s", currency))
+	}
+
+	report := bikerental.DamageReport{
+		ID:                 uuid.NewString(),
+		ReservationID:      req.ReservationID,
+		BikeID:             req.BikeID,
+		Description:        req.Description,
+		Severity:           req.Severity,
+		RepairCostEstimate: repairCost,
+		CreatedAt:          time.Now(),
+	}
+
+	// Bike event is prepared before photos are uploaded, so there is less to clean up if it fails.
+	var outOfServiceEvent *bikerental.Event
+	if report.Severity == bikerental.DamageSeverityHigh {
+		outOfServiceEvent, err = s.outOfServiceEvent(ctx, report.BikeID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Photos are not part of db transaction, so they are deleted if the report isn't saved.
+	var photoKeys []string
+	defer func() {
+		if err == nil {
+			return
+		}
+		if derr := s.deletePhotos(ctx, photoKeys); derr != nil {
+			err = fmt.Errorf("%w (%v)", err, derr)
+		}
+	}()
+
+	for _, p := range req.Photos {
+		ext, _ := bikerental.DamagePhotoExtension(p.ContentType)
+		photoID := uuid.NewString() + ext
+		key := photoKey(report.ID, photoID)
+		photoKeys = append(photoKeys, key)
+		if err := s.blobStore.Put(ctx, key, p.Data); err != nil {
+			return nil, fmt.Errorf("saving photo in blob store: %w", err)
+		}
+		report.PhotoIDs = append(report.PhotoIDs, photoID)
+	}
+
+	if err := s.repository.Create(ctx, report, outOfServiceEvent); err != nil {
+		return nil, fmt.Errorf("creating damage report in repository: %w", err)
+	}
+
+	return &report, nil
+}
+
+// ListReports returns damage reports for a bike.
+func (s *Service) ListReports(ctx context.Context, req bikerental.ListDamageReportsRequest) ([]bikerental.DamageReport, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	reports, err := s.repository.List(ctx, ListReportsQuery{
+		BikeID:        req.BikeID,
+		ReservationID: req.ReservationID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching damage reports from repository: %w", err)
+	}
+	return reports, nil
+}
+
+// GetPhoto returns a photo attached to damage report.
+// Returns app.ErrNotFound if report or photo doesn't exist.
+func (s *Service) GetPhoto(ctx context.Context, bikeID, reportID, photoID string) (*bikerental.DamagePhoto, error) {
+	report, err := s.repository.Get(ctx, reportID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching damage report from repository: %w", err)
+	}
+	if report.BikeID != bikeID || !containsString(report.PhotoIDs, photoID) {
+		return nil, app.ErrNotFound
+	}
+
+	data, err := s.blobStore.Get(ctx, photoKey(reportID, photoID))
+	if err != nil {
+		return nil, fmt.Errorf("fetching photo from blob store: %w", err)
+	}
+
+	return &bikerental.DamagePhoto{
+		ContentType: photoContentType(photoID),
+		Data:        data,
+	}, nil
+}
+
+// outOfServiceEvent returns bike update event putting the bike out of service.
+// Returns nil if the bike is already out of service.
+func (s *Service) outOfServiceEvent(ctx context.Context, bikeID string) (*bikerental.Event, error) {
+	bike, err := s.bikeService.Get(ctx, bikeID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching damaged bike: %w", err)
+	}
+	if bike.OutOfService {
+		return nil, nil
+	}
+
+	bike.OutOfService = true
+	event, err := bikerental.NewBikeEvent(bikerental.EventTypeBikeUpdated, *bike, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// deletePhotos deletes uploaded photos of a report that wasn't saved.
+func (s *Service) deletePhotos(ctx context.Context, keys []string) error {
+	// Photos have to be deleted even if the call failed because it was cancelled.
+	ctx = app.DetachedCtx(ctx)
+	var failed int
+	for _, key := range keys {
+		if err := s.blobStore.Delete(ctx, key); err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("deleting %d photos of not saved report failed", failed)
+	}
+	return nil
+}
+
+func photoKey(reportID, photoID string) string {
+	return path.Join(photosKeyPrefix, reportID, photoID)
+}
+
+func photoContentType(photoID string) string {
+	switch path.Ext(photoID) {
+	case ".jpg":
+		return "image/jpeg"
+	case ".png":
+		return "image/png"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+func containsString(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
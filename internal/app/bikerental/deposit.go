@@ -0,0 +1,38 @@
+package bikerental
+
+// DepositStatus describes state of reservation security deposit.
+type DepositStatus string
+
+// Deposit statuses.
+const (
+	// DepositStatusNone is a status of reservations of bikes without deposit.
+	DepositStatusNone DepositStatus = ""
+
+	// DepositStatusHeld means that deposit is authorized on customer account.
+	DepositStatusHeld DepositStatus = "held"
+
+	// DepositStatusReleased means that deposit was returned to the customer in full.
+	DepositStatusReleased DepositStatus = "released"
+
+	// DepositStatusForfeited means that deposit was captured, fully or partially, to cover bike damage.
+	DepositStatusForfeited DepositStatus = "forfeited"
+)
+
+// DepositPolicy contains security deposit amounts per bike type.
+// Bike types without an amount don't need deposit.
+type DepositPolicy map[BikeType]Money
+
+// For returns deposit amount for the bike. It has to be converted to bike currency.
+func (p DepositPolicy) For(b Bike) Money {
+	return p[b.Type]
+}
+
+// ForfeitedDeposit returns part of the deposit covering repair costs from damage reports.
+// Repair costs are in reservation currency, same as deposit.
+func ForfeitedDeposit(deposit Money, reports []DamageReport) Money {
+	var cost Money
+	for _, r := range reports {
+		cost = cost.Add(r.RepairCostEstimate)
+	}
+	return cost.Min(deposit)
+}
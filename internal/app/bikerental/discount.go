@@ -3,14 +3,22 @@ package bikerental
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/nglogic/go-application-guide/internal/app"
 )
 
 // Discount represents fixed discount for bike rental.
 type Discount struct {
-	// Amount is in eurocents.
-	Amount int
+	// Rule is a name of the rule that granted the discount, shown to customers.
+	Rule string
+
+	// Amount is in reservation currency.
+	Amount Money
+
+	// PromoCode is set if the discount includes promo code discount.
+	// The code has to be redeemed with reservation.
+	PromoCode string
 }
 
 // DiscountService provides methods for calculating discounts for a bike rentals.
@@ -23,8 +31,14 @@ type DiscountRequest struct {
 	Customer Customer
 	Location Location
 	Bike     Bike
-	// ReservationValue in eurocents.
-	ReservationValue int
+	// ReservationValue in bike currency.
+	ReservationValue Money
+	// PromoCode is optional code entered by the customer.
+	PromoCode string
+	// LoyaltyTier of the customer.
+	LoyaltyTier LoyaltyTier
+	// Company is set if the customer is a company member.
+	Company *Company
 }
 
 // Validate validates the request.
@@ -37,7 +51,7 @@ func (r DiscountRequest) Validate() error {
 		return app.NewValidationError("invalid location")
 	}
 
-	if r.ReservationValue == 0 {
+	if r.ReservationValue.IsZero() {
 		return app.NewValidationError("empty reservation value")
 	}
 
@@ -52,3 +66,14 @@ func (r DiscountRequest) Validate() error {
 type DiscountResponse struct {
 	Discount Discount
 }
+
+// CombineDiscountRules returns name of discount granted by many rules. Empty names are skipped.
+func CombineDiscountRules(rules ...string) string {
+	names := make([]string, 0, len(rules))
+	for _, r := range rules {
+		if r != "" {
+			names = append(names, r)
+		}
+	}
+	return strings.Join(names, " + ")
+}
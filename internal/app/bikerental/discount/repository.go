@@ -0,0 +1,14 @@
+package discount
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// PromoCodeRepository provides promo codes.
+type PromoCodeRepository interface {
+	// GetPromoCode returns promo code.
+	// Returns app.ErrNotFound if code doesn't exist.
+	GetPromoCode(ctx context.Context, code string) (*bikerental.PromoCode, error)
+}
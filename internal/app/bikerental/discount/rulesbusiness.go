@@ -3,26 +3,40 @@ package discount
 // This file contains all business rules for calculating discounts.
 
 import (
-	"math"
-
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
 )
 
 // newBusinessCustomerDiscount returns discounts for business customers.
 // Discount rules:
 // - business customers only
-// - minimum reservation value: 100 (10000 cents)
+// - minimum reservation value: 100 EUR (10000 cents), converted to reservation currency
 // - discount value: 5% of reservation value.
-func newBusinessCustomerDiscount(resValue int, customer bikerental.Customer) bikerental.Discount {
+func newBusinessCustomerDiscount(resValue, minValue bikerental.Money, customer bikerental.Customer) bikerental.Discount {
+	if customer.Type != bikerental.CustomerTypeBusiness {
+		return bikerental.Discount{}
+	}
+	if resValue.Less(minValue) {
+		return bikerental.Discount{}
+	}
+	return bikerental.Discount{
+		Rule:   "business customer",
+		Amount: resValue.Mul(0.05),
+	}
+}
+
+// newCompanyDiscount returns negotiated discount for company members.
+// Discount rules:
+// - business customers, members of a company only
+// - discount value: percent negotiated by the company.
+func newCompanyDiscount(resValue bikerental.Money, customer bikerental.Customer, company *bikerental.Company) bikerental.Discount {
 	if customer.Type != bikerental.CustomerTypeBusiness {
 		return bikerental.Discount{}
 	}
-	if resValue < 10000 {
+	if company == nil || customer.CompanyID != company.ID {
 		return bikerental.Discount{}
 	}
 	return bikerental.Discount{
-		Amount: int(math.Round(
-			0.05 * float64(resValue),
-		)),
+		Rule:   "company " + company.Name,
+		Amount: resValue.Mul(company.DiscountPercent / 100.0),
 	}
 }
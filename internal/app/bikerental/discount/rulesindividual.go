@@ -13,7 +13,7 @@ import (
 // - individual customers only
 // - bike weight >= 15kg
 // - maximum discount is 20% of reservation value.
-func newBikeWeightDiscount(resValue int, customer bikerental.Customer, bike bikerental.Bike) bikerental.Discount {
+func newBikeWeightDiscount(resValue bikerental.Money, customer bikerental.Customer, bike bikerental.Bike) bikerental.Discount {
 	if customer.Type != bikerental.CustomerTypeIndividual {
 		return bikerental.Discount{}
 	}
@@ -27,9 +27,8 @@ func newBikeWeightDiscount(resValue int, customer bikerental.Customer, bike bike
 	}
 
 	return bikerental.Discount{
-		Amount: int(math.Round(
-			(discountPercent / 100.0) * float64(resValue)),
-		),
+		Rule:   "bike weight",
+		Amount: resValue.Mul(discountPercent / 100.0),
 	}
 }
 
@@ -37,7 +36,7 @@ func newBikeWeightDiscount(resValue int, customer bikerental.Customer, bike bike
 // Discount rules:
 // - individual customers only
 // - low outside temperature.
-func newTemperatureDiscount(resValue int, customer bikerental.Customer, weather *bikerental.Weather) bikerental.Discount {
+func newTemperatureDiscount(resValue bikerental.Money, customer bikerental.Customer, weather *bikerental.Weather) bikerental.Discount {
 	if customer.Type != bikerental.CustomerTypeIndividual {
 		return bikerental.Discount{}
 	}
@@ -46,7 +45,8 @@ func newTemperatureDiscount(resValue int, customer bikerental.Customer, weather
 	}
 
 	return bikerental.Discount{
-		Amount: int(math.Round(float64(resValue) * 0.05)),
+		Rule:   "low temperature",
+		Amount: resValue.Mul(0.05),
 	}
 }
 
@@ -54,7 +54,7 @@ func newTemperatureDiscount(resValue int, customer bikerental.Customer, weather
 // Discount rules:
 // - individual customers only
 // - incidents in neighborhood present.
-func newIncidentsDiscount(resValue int, customer bikerental.Customer, incidents *bikerental.BikeIncidentsInfo) bikerental.Discount {
+func newIncidentsDiscount(resValue bikerental.Money, customer bikerental.Customer, incidents *bikerental.BikeIncidentsInfo) bikerental.Discount {
 	if customer.Type != bikerental.CustomerTypeIndividual {
 		return bikerental.Discount{}
 	}
@@ -70,9 +70,33 @@ func newIncidentsDiscount(resValue int, customer bikerental.Customer, incidents
 		discountPercent += 5.0
 	}
 	return bikerental.Discount{
-		Amount: int(math.Round(
-			float64(resValue) * (discountPercent / 100.0),
-		)),
+		Rule:   "incidents in the neighborhood",
+		Amount: resValue.Mul(discountPercent / 100.0),
+	}
+}
+
+// newLoyaltyTierDiscount creates discount unlocked by loyalty tier.
+// Discount rules:
+// - individual customers only
+// - silver tier: 3% of reservation value
+// - gold tier: 7% of reservation value.
+func newLoyaltyTierDiscount(resValue bikerental.Money, customer bikerental.Customer, tier bikerental.LoyaltyTier) bikerental.Discount {
+	if customer.Type != bikerental.CustomerTypeIndividual {
+		return bikerental.Discount{}
+	}
+
+	var discountPercent float64
+	switch tier {
+	case bikerental.LoyaltyTierSilver:
+		discountPercent = 3.0
+	case bikerental.LoyaltyTierGold:
+		discountPercent = 7.0
+	default:
+		return bikerental.Discount{}
+	}
+	return bikerental.Discount{
+		Rule:   "loyalty tier",
+		Amount: resValue.Mul(discountPercent / 100.0),
 	}
 }
 
@@ -80,12 +104,12 @@ func newIncidentsDiscount(resValue int, customer bikerental.Customer, incidents
 // Rules:
 // - select discount with greatest value.
 func selectOptimalDiscount(discounts ...bikerental.Discount) bikerental.Discount {
-	maxAmount := -math.MaxInt64
+	var maxAmount int64 = math.MinInt64
 	var result bikerental.Discount
 	for _, d := range discounts {
-		if d.Amount > maxAmount {
+		if d.Amount.Amount > maxAmount {
 			result = d
-			maxAmount = d.Amount
+			maxAmount = d.Amount.Amount
 		}
 	}
 	return result
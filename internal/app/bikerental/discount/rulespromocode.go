@@ -0,0 +1,35 @@
+package discount
+
+// This file contains all business rules for calculating discounts.
+
+import (
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// applyPromoCodeDiscount combines automatic discount with promo code discount.
+// Discount rules:
+// - stackable code discount is added to automatic discount,
+// - other codes compete with automatic discount, automatic discount wins a tie, so the code isn't redeemed for nothing,
+// - total discount can't be greater than reservation value.
+func applyPromoCodeDiscount(resValue bikerental.Money, automatic bikerental.Discount, promo *bikerental.PromoCode) bikerental.Discount {
+	if promo == nil {
+		return automatic
+	}
+
+	promoDiscount := bikerental.Discount{
+		Rule:      "promo code " + promo.Code,
+		Amount:    promo.DiscountAmount(resValue),
+		PromoCode: promo.Code,
+	}
+
+	if !promo.Stackable {
+		return selectOptimalDiscount(automatic, promoDiscount)
+	}
+
+	amount := automatic.Amount.Add(promoDiscount.Amount).Min(resValue)
+	return bikerental.Discount{
+		Rule:      bikerental.CombineDiscountRules(automatic.Rule, promoDiscount.Rule),
+		Amount:    amount,
+		PromoCode: promo.Code,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/nglogic/go-application-guide/internal/app"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
@@ -14,16 +15,23 @@ const (
 	incidentsProximity = 10.0
 )
 
+// businessCustomerMinValue is a minimum reservation value for business customers discount.
+var businessCustomerMinValue = bikerental.NewMoney(10000, bikerental.BaseCurrency)
+
 // Service provides methods for calculating discounts for a bike rental.
 type Service struct {
 	weatherService   bikerental.WeatherService
 	incidentsService bikerental.BikeIncidentsService
+	promoCodes       PromoCodeRepository
+	exchangeRates    bikerental.ExchangeRateService
 }
 
 // NewService creates new service instance.
 func NewService(
 	weather bikerental.WeatherService,
 	incidents bikerental.BikeIncidentsService,
+	promoCodes PromoCodeRepository,
+	exchangeRates bikerental.ExchangeRateService,
 ) (*Service, error) {
 	if weather == nil {
 		return nil, errors.New("empty weather service")
@@ -31,10 +39,18 @@ func NewService(
 	if incidents == nil {
 		return nil, errors.New("empty incidents service")
 	}
+	if promoCodes == nil {
+		return nil, errors.New("empty promo codes repository")
+	}
+	if exchangeRates == nil {
+		return nil, errors.New("empty exchange rates service")
+	}
 
 	return &Service{
 		weatherService:   weather,
 		incidentsService: incidents,
+		promoCodes:       promoCodes,
+		exchangeRates:    exchangeRates,
 	}, nil
 }
 
@@ -44,6 +60,11 @@ func (s *Service) CalculateDiscount(ctx context.Context, r bikerental.DiscountRe
 		return nil, fmt.Errorf("invalid request: %w", err)
 	}
 
+	promo, err := s.fetchPromoCode(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
 	weather, err := s.weatherService.GetWeather(ctx, bikerental.WeatherRequest{
 		Location: r.Location,
 	})
@@ -69,14 +90,52 @@ func (s *Service) CalculateDiscount(ctx context.Context, r bikerental.DiscountRe
 		}
 	}
 
+	minBusinessValue, err := bikerental.ConvertMoney(ctx, s.exchangeRates, businessCustomerMinValue, r.ReservationValue.Currency)
+	if err != nil {
+		return nil, err
+	}
+
 	discount := selectOptimalDiscount(
 		newBikeWeightDiscount(r.ReservationValue, r.Customer, r.Bike),
 		newTemperatureDiscount(r.ReservationValue, r.Customer, weather),
 		newIncidentsDiscount(r.ReservationValue, r.Customer, incidents),
-		newBusinessCustomerDiscount(r.ReservationValue, r.Customer),
+		newLoyaltyTierDiscount(r.ReservationValue, r.Customer, r.LoyaltyTier),
+		newBusinessCustomerDiscount(r.ReservationValue, minBusinessValue, r.Customer),
+		newCompanyDiscount(r.ReservationValue, r.Customer, r.Company),
 	)
+	discount = applyPromoCodeDiscount(r.ReservationValue, discount, promo)
 
 	return &bikerental.DiscountResponse{
 		Discount: discount,
 	}, nil
 }
+
+// fetchPromoCode returns promo code from the request, if it's set and can be used for the reservation.
+// Returns validation error with a reason if the code is unknown or not eligible.
+func (s *Service) fetchPromoCode(ctx context.Context, r bikerental.DiscountRequest) (*bikerental.PromoCode, error) {
+	if r.PromoCode == "" {
+		return nil, nil
+	}
+
+	code := bikerental.NormalizePromoCode(r.PromoCode)
+	promo, err := s.promoCodes.GetPromoCode(ctx, code)
+	if err != nil {
+		if app.IsNotFoundError(err) {
+			return nil, app.NewValidationError(fmt.Sprintf("promo code '%s' does not exist", code))
+		}
+		return nil, fmt.Errorf("fetching promo code: %w", err)
+	}
+
+	if err := promo.CheckEligibility(r.Customer, r.Bike, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// Fixed amount is defined in promo code currency, it has to match reservation currency.
+	if promo.Type == bikerental.PromoCodeTypeFixed {
+		promo.Amount, err = bikerental.ConvertMoney(ctx, s.exchangeRates, promo.Amount, r.ReservationValue.Currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return promo, nil
+}
@@ -0,0 +1,132 @@
+package bikerental
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// EventType is a type of domain event.
+type EventType string
+
+// Event types.
+const (
+	EventTypeBikeCreated EventType = "bike.created"
+	EventTypeBikeUpdated EventType = "bike.updated"
+
+	// EventTypeBikeDeleted has only bike id in the payload.
+	EventTypeBikeDeleted EventType = "bike.deleted"
+
+	EventTypeReservationCreated   EventType = "reservation.created"
+	EventTypeReservationCanceled  EventType = "reservation.canceled"
+	EventTypeReservationCompleted EventType = "reservation.completed"
+)
+
+// Aggregate types of events.
+const (
+	AggregateTypeBike        = "bike"
+	AggregateTypeReservation = "reservation"
+)
+
+// Event is a domain event for other systems.
+// Events are stored in outbox in the same transaction as aggregate changes and published after commit.
+// Delivery is at-least-once, consumers should deduplicate events by id.
+type Event struct {
+	// ID is an outbox sequence number, assigned when the event is stored.
+	// Events of one aggregate are published in order of ids.
+	ID int64 `json:"id"`
+
+	// TenantID is a tenant of the aggregate. It's set by the repository when the event is stored.
+	TenantID      string    `json:"tenant_id"`
+	Type          EventType `json:"type"`
+	AggregateType string    `json:"aggregate_type"`
+	AggregateID   string    `json:"aggregate_id"`
+
+	// Payload is a JSON snapshot of the aggregate after the change.
+	Payload    json.RawMessage `json:"payload"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+// NewBikeEvent creates event with bike data.
+func NewBikeEvent(t EventType, b Bike, now time.Time) (Event, error) {
+	return newEvent(t, AggregateTypeBike, b.ID, bikePayload{
+		ID:           b.ID,
+		Type:         string(b.Type),
+		ModelName:    b.ModelName,
+		Weight:       b.Weight,
+		PricePerHour: newMoneyPayload(b.PricePerHour),
+		OutOfService: b.OutOfService,
+		StationID:    b.StationID,
+	}, now)
+}
+
+// NewReservationEvent creates event with reservation data.
+// Customer data is not included, events can be sent outside of the company.
+func NewReservationEvent(t EventType, r Reservation, status ReservationStatus, now time.Time) (Event, error) {
+	return newEvent(t, AggregateTypeReservation, r.ID, reservationPayload{
+		ID:              r.ID,
+		Status:          string(status),
+		BikeID:          r.Bike.ID,
+		StationID:       r.Bike.StationID,
+		StartTime:       r.StartTime,
+		EndTime:         r.EndTime,
+		TotalValue:      newMoneyPayload(r.TotalValue),
+		AppliedDiscount: newMoneyPayload(r.AppliedDiscount),
+		PromoCode:       r.PromoCode,
+	}, now)
+}
+
+func newEvent(t EventType, aggregateType, aggregateID string, payload interface{}, now time.Time) (Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, fmt.Errorf("encoding %s event payload: %w", t, err)
+	}
+	return Event{
+		Type:          t,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       data,
+		OccurredAt:    now,
+	}, nil
+}
+
+type bikePayload struct {
+	ID           string       `json:"id"`
+	Type         string       `json:"type"`
+	ModelName    string       `json:"model_name"`
+	Weight       float64      `json:"weight"`
+	PricePerHour moneyPayload `json:"price_per_hour"`
+	OutOfService bool         `json:"out_of_service"`
+	StationID    string       `json:"station_id"`
+}
+
+type reservationPayload struct {
+	ID              string       `json:"id"`
+	Status          string       `json:"status"`
+	BikeID          string       `json:"bike_id"`
+	StationID       string       `json:"station_id"`
+	StartTime       time.Time    `json:"start_time"`
+	EndTime         time.Time    `json:"end_time"`
+	TotalValue      moneyPayload `json:"total_value"`
+	AppliedDiscount moneyPayload `json:"applied_discount"`
+	PromoCode       string       `json:"promo_code,omitempty"`
+}
+
+// moneyPayload has amount in minor units of the currency.
+type moneyPayload struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func newMoneyPayload(m Money) moneyPayload {
+	return moneyPayload{
+		Amount:   m.Amount,
+		Currency: string(m.Currency),
+	}
+}
+
+// EventPublisher is a port for publishing domain events to other systems.
+type EventPublisher interface {
+	Publish(context.Context, Event) error
+}
@@ -0,0 +1,15 @@
+package event
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// OutboxRepository provides access to stored domain events.
+type OutboxRepository interface {
+	// Relay passes unpublished events to publish func in id order and marks published ones.
+	// After publish fails, later events of the same aggregate are not passed in this call, so events are published in order.
+	// Returns number of published events.
+	Relay(ctx context.Context, limit int, publish func(context.Context, bikerental.Event) error) (int, error)
+}
@@ -0,0 +1,71 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+const (
+	// batchSize is a max number of events published in one relay run.
+	batchSize = 100
+
+	// publishTimeout limits time of publishing one event, so a slow publisher doesn't stall the relay.
+	publishTimeout = 30 * time.Second
+)
+
+// Service publishes domain events from outbox.
+type Service struct {
+	publishers []bikerental.EventPublisher
+	outboxRepo OutboxRepository
+}
+
+// NewService creates new service instance.
+// Every event is published to all publishers, in the given order.
+func NewService(outboxRepo OutboxRepository, publishers ...bikerental.EventPublisher) (*Service, error) {
+	if outboxRepo == nil {
+		return nil, errors.New("empty outbox repository")
+	}
+	if len(publishers) == 0 {
+		return nil, errors.New("empty event publishers")
+	}
+	for _, p := range publishers {
+		if p == nil {
+			return nil, errors.New("empty event publisher")
+		}
+	}
+	return &Service{
+		publishers: publishers,
+		outboxRepo: outboxRepo,
+	}, nil
+}
+
+// RelayEvents publishes stored events and returns number of published ones.
+// Events that failed are published again in the next run, before any later events of the same aggregate.
+func (s *Service) RelayEvents(ctx context.Context) (int, error) {
+	n, err := s.outboxRepo.Relay(ctx, batchSize, s.publish)
+	if err != nil {
+		return n, fmt.Errorf("relaying events from outbox: %w", err)
+	}
+	return n, nil
+}
+
+// publish sends event to all publishers, in the tenant of the event.
+// If any of them fails the event is relayed again to all of them, so publishers must tolerate duplicates.
+func (s *Service) publish(ctx context.Context, e bikerental.Event) error {
+	// Relay publishes events of all tenants.
+	ctx = app.CtxWithTenantID(ctx, e.TenantID)
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+
+	for _, p := range s.publishers {
+		if err := p.Publish(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
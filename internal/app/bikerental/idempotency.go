@@ -0,0 +1,85 @@
+package bikerental
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// maxIdempotencyKeyLength is a max length of idempotency key, uuid or similar random string is expected.
+const maxIdempotencyKeyLength = 255
+
+// IdempotentCall is a call of a method with an idempotency key chosen by the client.
+// Retries of the call with the same key get response of the first call, instead of running it again.
+type IdempotentCall struct {
+	Key    string
+	Method string
+
+	// Request is a serialized request of the call.
+	// Key can't be reused with a different request.
+	Request []byte
+}
+
+// Validate validates call data.
+func (c IdempotentCall) Validate() error {
+	if c.Key == "" {
+		return app.NewValidationError("idempotency key can't be empty")
+	}
+	if len(c.Key) > maxIdempotencyKeyLength {
+		return app.NewValidationError("idempotency key is too long")
+	}
+	if c.Method == "" {
+		return app.NewValidationError("method can't be empty")
+	}
+	return nil
+}
+
+// RequestHash returns hash of the request, stored in place of the request.
+func (c IdempotentCall) RequestHash() string {
+	sum := sha256.Sum256(c.Request)
+	return hex.EncodeToString(sum[:])
+}
+
+// IdempotencyRecord is a stored call with an idempotency key.
+// Keys are unique per client of a tenant.
+type IdempotencyRecord struct {
+	TenantID string
+	Client   string
+	Key      string
+
+	Method      string
+	RequestHash string
+
+	// Response is a serialized response of the call, set when the call is completed.
+	Response []byte
+
+	CreatedAt time.Time
+
+	// CompletedAt is zero while the call is running.
+	CompletedAt time.Time
+
+	// ExpiresAt is a time after which the key can be used again.
+	// Keys of running calls expire like keys of completed ones, so calls interrupted by a crash are not run again.
+	ExpiresAt time.Time
+}
+
+// IsCompleted returns true if response of the call is stored.
+func (r IdempotencyRecord) IsCompleted() bool {
+	return !r.CompletedAt.IsZero()
+}
+
+// IdempotencyService runs calls with idempotency keys.
+type IdempotencyService interface {
+	// Run runs the call once per idempotency key of the caller, and returns its serialized response.
+	// Retries of the call get response of the first call.
+	// Returns app.PreconditionError if the key was used with a different request or the first call was interrupted,
+	// and app.ConflictError if the first call is still running.
+	// Errors of run are returned unchanged, and the call can be retried with the same key.
+	Run(ctx context.Context, call IdempotentCall, run func(context.Context) ([]byte, error)) ([]byte, error)
+
+	// DeleteExpiredKeys removes expired keys of all tenants, and returns number of removed keys.
+	DeleteExpiredKeys(context.Context) (int, error)
+}
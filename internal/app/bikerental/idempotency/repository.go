@@ -0,0 +1,26 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Repository stores calls with idempotency keys.
+// Records are identified by tenant, client and key.
+type Repository interface {
+	// Reserve creates record of a running call, replacing expired record of the key.
+	// Returns existing record if the key is already used, or nil if the record was created.
+	Reserve(context.Context, bikerental.IdempotencyRecord) (*bikerental.IdempotencyRecord, error)
+
+	// Complete stores response, completion time and new expiry time of the call.
+	// Returns app.ErrNotFound if the record was replaced or removed in the meantime.
+	Complete(context.Context, bikerental.IdempotencyRecord) error
+
+	// Release removes record of a running call, so it can be run again.
+	Release(context.Context, bikerental.IdempotencyRecord) error
+
+	// DeleteExpired removes records expired before given time, and returns number of removed records.
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
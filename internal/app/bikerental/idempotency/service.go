@@ -0,0 +1,158 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// runningCallTimeout is a time after which running call is considered interrupted, e.g. by a crash.
+	// It's longer than any call should take. Outcome of interrupted call is unknown, so it's never run again with the same key.
+	runningCallTimeout = time.Minute
+
+	// completeAttempts is a number of attempts of storing the response.
+	// Until it's stored, retries of the call get errors instead of the response.
+	completeAttempts   = 3
+	completeRetryDelay = 100 * time.Millisecond
+)
+
+// Service runs calls with idempotency keys, storing their responses for ttl.
+type Service struct {
+	repo Repository
+	ttl  time.Duration
+	log  logrus.FieldLogger
+}
+
+// NewService creates new service instance.
+func NewService(repo Repository, ttl time.Duration, log logrus.FieldLogger) (*Service, error) {
+	if repo == nil {
+		return nil, errors.New("empty idempotency repository")
+	}
+	if ttl < runningCallTimeout {
+		return nil, fmt.Errorf("idempotency key ttl has to be at least %s", runningCallTimeout)
+	}
+	if log == nil {
+		return nil, errors.New("empty logger")
+	}
+	return &Service{
+		repo: repo,
+		ttl:  ttl,
+		log:  log,
+	}, nil
+}
+
+// Run runs the call once per idempotency key of the caller, and returns its serialized response.
+// Keys are unique per caller of a tenant, so clients can't see responses of each other.
+// Key is kept for ttl even if the call was interrupted, so the call is never run twice with the same key.
+func (s *Service) Run(
+	ctx context.Context,
+	call bikerental.IdempotentCall,
+	run func(context.Context) ([]byte, error),
+) ([]byte, error) {
+	if err := call.Validate(); err != nil {
+		return nil, err
+	}
+	tenantID, client, err := clientFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Db stores time with microseconds precision, records are matched by creation time.
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	record := bikerental.IdempotencyRecord{
+		TenantID:    tenantID,
+		Client:      client,
+		Key:         call.Key,
+		Method:      call.Method,
+		RequestHash: call.RequestHash(),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.ttl),
+	}
+	existing, err := s.repo.Reserve(ctx, record)
+	if err != nil {
+		return nil, fmt.Errorf("reserving idempotency key: %w", err)
+	}
+	if existing != nil {
+		return replay(*existing, record)
+	}
+
+	response, err := run(ctx)
+	// Key has to be released or completed even if the call was cancelled by the client.
+	ctx = app.DetachedCtx(ctx)
+	if err != nil {
+		// Failed call can be retried with the same key.
+		// If the key can't be released, retries get errors until it expires.
+		if rerr := s.repo.Release(ctx, record); rerr != nil {
+			app.AugmentLogFromCtx(ctx, s.log).Errorf("releasing idempotency key of failed call: %v", rerr)
+		}
+		return nil, err
+	}
+
+	completedAt := time.Now().UTC()
+	record.Response = response
+	record.CompletedAt = completedAt
+	record.ExpiresAt = completedAt.Add(s.ttl)
+	if err := s.complete(ctx, record); err != nil {
+		// Call succeeded, so its response is returned anyway.
+		// Retries get errors instead of the response, but the call is not run again.
+		app.AugmentLogFromCtx(ctx, s.log).Errorf("storing response of idempotent call: %v", err)
+	}
+	return response, nil
+}
+
+// complete stores response of the call, retrying transient errors.
+func (s *Service) complete(ctx context.Context, record bikerental.IdempotencyRecord) error {
+	var err error
+	for i := 0; i < completeAttempts; i++ {
+		if i > 0 {
+			time.Sleep(completeRetryDelay << (i - 1))
+		}
+		err = s.repo.Complete(ctx, record)
+		if err == nil || app.IsNotFoundError(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// replay returns response of the earlier call with the same key.
+func replay(existing, record bikerental.IdempotencyRecord) ([]byte, error) {
+	if existing.Method != record.Method || existing.RequestHash != record.RequestHash {
+		return nil, app.NewPreconditionError("idempotency key was already used with a different request")
+	}
+	if !existing.IsCompleted() {
+		if record.CreatedAt.Sub(existing.CreatedAt) > runningCallTimeout {
+			return nil, app.NewPreconditionError("call with the same idempotency key was interrupted, its outcome is unknown")
+		}
+		return nil, app.NewConflictError("call with the same idempotency key is still running")
+	}
+	return existing.Response, nil
+}
+
+// DeleteExpiredKeys removes expired keys of all tenants, and returns number of removed keys.
+func (s *Service) DeleteExpiredKeys(ctx context.Context) (int, error) {
+	n, err := s.repo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("deleting expired idempotency keys: %w", err)
+	}
+	return n, nil
+}
+
+// clientFromCtx returns tenant and caller of the call.
+func clientFromCtx(ctx context.Context) (string, string, error) {
+	caller, ok := app.IdentityFromCtx(ctx)
+	if !ok {
+		return "", "", app.ErrUnauthenticated
+	}
+	tenantID, ok := app.TenantIDFromCtx(ctx)
+	if !ok {
+		return "", "", app.NewValidationError("unknown tenant")
+	}
+	return tenantID, fmt.Sprintf("%s:%s", caller.Method, caller.Subject), nil
+}
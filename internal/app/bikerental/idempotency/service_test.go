@@ -0,0 +1,165 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+// fakeRepository keeps records by key, like db repository does for one client.
+type fakeRepository struct {
+	records map[string]bikerental.IdempotencyRecord
+
+	// completeErrors are returned by subsequent calls of Complete.
+	completeErrors []error
+	completeCalls  int
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{records: map[string]bikerental.IdempotencyRecord{}}
+}
+
+func (r *fakeRepository) Reserve(ctx context.Context, rec bikerental.IdempotencyRecord) (*bikerental.IdempotencyRecord, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if existing, ok := r.records[rec.Key]; ok && existing.ExpiresAt.After(rec.CreatedAt) {
+		return &existing, nil
+	}
+	r.records[rec.Key] = rec
+	return nil, nil
+}
+
+func (r *fakeRepository) Complete(ctx context.Context, rec bikerental.IdempotencyRecord) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.completeCalls++
+	if len(r.completeErrors) > 0 {
+		err := r.completeErrors[0]
+		r.completeErrors = r.completeErrors[1:]
+		if err != nil {
+			return err
+		}
+	}
+	r.records[rec.Key] = rec
+	return nil
+}
+
+func (r *fakeRepository) Release(ctx context.Context, rec bikerental.IdempotencyRecord) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	delete(r.records, rec.Key)
+	return nil
+}
+
+func (r *fakeRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	return 0, nil
+}
+
+func newTestService(t *testing.T, repo Repository) *Service {
+	t.Helper()
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	s, err := NewService(repo, time.Hour, log)
+	if err != nil {
+		t.Fatalf("creating service: %v", err)
+	}
+	return s
+}
+
+func testCtx() context.Context {
+	ctx := app.CtxWithIdentity(context.Background(), app.Identity{Subject: "key-1", TenantID: "tenant-1"})
+	return app.CtxWithTenantID(ctx, "tenant-1")
+}
+
+var testCall = bikerental.IdempotentCall{Key: "key-1", Method: "CreateBike", Request: []byte("request")}
+
+// countingRun returns run function counting its calls.
+func countingRun(calls *int, err error) func(context.Context) ([]byte, error) {
+	return func(context.Context) ([]byte, error) {
+		*calls++
+		if err != nil {
+			return nil, err
+		}
+		return []byte("response"), nil
+	}
+}
+
+func TestServiceRunCompleteRetried(t *testing.T) {
+	repo := newFakeRepository()
+	repo.completeErrors = []error{errors.New("connection reset")}
+	s := newTestService(t, repo)
+
+	var calls int
+	for i := 0; i < 2; i++ {
+		got, err := s.Run(testCtx(), testCall, countingRun(&calls, nil))
+		if err != nil || string(got) != "response" {
+			t.Fatalf("Run() = %s, error = %v, want response", got, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("call run %d times, want 1", calls)
+	}
+	if repo.completeCalls != 2 {
+		t.Errorf("Complete() called %d times, want 2", repo.completeCalls)
+	}
+}
+
+func TestServiceRunNotCompleted(t *testing.T) {
+	repo := newFakeRepository()
+	repo.completeErrors = []error{
+		errors.New("connection reset"),
+		errors.New("connection reset"),
+		errors.New("connection reset"),
+	}
+	s := newTestService(t, repo)
+
+	var calls int
+	got, err := s.Run(testCtx(), testCall, countingRun(&calls, nil))
+	if err != nil || string(got) != "response" {
+		t.Fatalf("Run() = %s, error = %v, want response of successful call", got, err)
+	}
+
+	if _, err := s.Run(testCtx(), testCall, countingRun(&calls, nil)); !app.IsConflictError(err) {
+		t.Errorf("Run() of running call error = %v, want conflict error", err)
+	}
+
+	// Call is considered interrupted after timeout, but it's still not run again.
+	rec := repo.records[testCall.Key]
+	rec.CreatedAt = rec.CreatedAt.Add(-2 * runningCallTimeout)
+	repo.records[testCall.Key] = rec
+	if _, err := s.Run(testCtx(), testCall, countingRun(&calls, nil)); !app.IsPreconditionError(err) {
+		t.Errorf("Run() of interrupted call error = %v, want precondition error", err)
+	}
+	if calls != 1 {
+		t.Errorf("call run %d times, want 1", calls)
+	}
+}
+
+func TestServiceRunReleasedAfterCancel(t *testing.T) {
+	repo := newFakeRepository()
+	s := newTestService(t, repo)
+
+	ctx, cancel := context.WithCancel(testCtx())
+	callErr := errors.New("call cancelled")
+	_, err := s.Run(ctx, testCall, func(context.Context) ([]byte, error) {
+		cancel()
+		return nil, callErr
+	})
+	if !errors.Is(err, callErr) {
+		t.Fatalf("Run() error = %v, want %v", err, callErr)
+	}
+
+	var calls int
+	if _, err := s.Run(testCtx(), testCall, countingRun(&calls, nil)); err != nil || calls != 1 {
+		t.Errorf("Run() of retry error = %v, run %d times, want call run once", err, calls)
+	}
+}
@@ -0,0 +1,104 @@
+package bikerental
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// ErrLoyaltyPointsNotRedeemable is returned when customer doesn't have enough loyalty points to redeem.
+var ErrLoyaltyPointsNotRedeemable = app.ConflictError{Err: errors.New("not enough loyalty points")}
+
+// Loyalty programme rules.
+const (
+	// LoyaltyPointsPerEuro is a number of points earned per full euro of completed reservation value.
+	LoyaltyPointsPerEuro = 1
+
+	// LoyaltyPointValue is a value of one redeemed point in eurocents.
+	LoyaltyPointValue = 10
+
+	// LoyaltySilverTierPoints is a number of earned points needed for silver tier.
+	LoyaltySilverTierPoints = 500
+
+	// LoyaltyGoldTierPoints is a number of earned points needed for gold tier.
+	LoyaltyGoldTierPoints = 2000
+)
+
+// LoyaltyTier describes customer loyalty tier. Higher tiers unlock discounts.
+type LoyaltyTier string
+
+// Loyalty tiers.
+const (
+	LoyaltyTierNone   LoyaltyTier = ""
+	LoyaltyTierSilver LoyaltyTier = "silver"
+	LoyaltyTierGold   LoyaltyTier = "gold"
+)
+
+// LoyaltyEntryKind describes a loyalty ledger entry.
+type LoyaltyEntryKind string
+
+// Loyalty ledger entry kinds.
+const (
+	// LoyaltyEntryKindAccrual adds points earned for completed reservation.
+	LoyaltyEntryKindAccrual LoyaltyEntryKind = "accrual"
+
+	// LoyaltyEntryKindRedemption subtracts points redeemed as a discount for reservation.
+	LoyaltyEntryKindRedemption LoyaltyEntryKind = "redemption"
+
+	// LoyaltyEntryKindReversal subtracts points accrued for canceled reservation.
+	LoyaltyEntryKindReversal LoyaltyEntryKind = "reversal"
+
+	// LoyaltyEntryKindRefund adds back points redeemed for canceled reservation.
+	LoyaltyEntryKindRefund LoyaltyEntryKind = "refund"
+)
+
+// LoyaltyEntry is an entry in append-only loyalty ledger.
+// Entries are never modified, corrections are made by appending new entries.
+type LoyaltyEntry struct {
+	ID            string
+	CustomerID    string
+	ReservationID string
+	Kind          LoyaltyEntryKind
+
+	// Points are positive for entries adding points and negative for entries subtracting points.
+	Points int
+
+	CreatedAt time.Time
+}
+
+// LoyaltyAccount is a summary of customer loyalty ledger.
+type LoyaltyAccount struct {
+	CustomerID string
+
+	// Balance is a number of points that can be redeemed.
+	Balance int
+
+	// EarnedPoints is a number of points earned for completed reservations, regardless of redemptions.
+	// It determines loyalty tier.
+	EarnedPoints int
+}
+
+// Tier returns loyalty tier of the account.
+func (a LoyaltyAccount) Tier() LoyaltyTier {
+	switch {
+	case a.EarnedPoints >= LoyaltyGoldTierPoints:
+		return LoyaltyTierGold
+	case a.EarnedPoints >= LoyaltySilverTierPoints:
+		return LoyaltyTierSilver
+	default:
+		return LoyaltyTierNone
+	}
+}
+
+// IsEarned returns true if entry counts as earned points, see LoyaltyAccount.EarnedPoints.
+func (k LoyaltyEntryKind) IsEarned() bool {
+	return k == LoyaltyEntryKindAccrual || k == LoyaltyEntryKindReversal
+}
+
+// LoyaltyService provides loyalty programme data.
+type LoyaltyService interface {
+	GetAccount(ctx context.Context, customerID string) (*LoyaltyAccount, error)
+	ListEntries(ctx context.Context, customerID string) ([]LoyaltyEntry, error)
+}
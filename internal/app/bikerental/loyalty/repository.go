@@ -0,0 +1,17 @@
+package loyalty
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Repository provides loyalty ledger data.
+type Repository interface {
+	// GetAccount returns loyalty account summary of a customer.
+	// Returns empty account if customer has no ledger entries.
+	GetAccount(ctx context.Context, customerID string) (*bikerental.LoyaltyAccount, error)
+
+	// ListEntries returns all ledger entries of a customer, from the oldest.
+	ListEntries(ctx context.Context, customerID string) ([]bikerental.LoyaltyEntry, error)
+}
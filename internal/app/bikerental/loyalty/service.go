@@ -0,0 +1,58 @@
+package loyalty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service provides loyalty programme data.
+// Points are accrued, redeemed and reversed by reservation service, together with reservation changes.
+type Service struct {
+	repository Repository
+}
+
+// NewService creates new service instance.
+func NewService(repository Repository) (*Service, error) {
+	if repository == nil {
+		return nil, errors.New("empty loyalty repository")
+	}
+	return &Service{
+		repository: repository,
+	}, nil
+}
+
+// GetAccount returns loyalty account summary of a customer.
+func (s *Service) GetAccount(ctx context.Context, customerID string) (*bikerental.LoyaltyAccount, error) {
+	if customerID == "" {
+		return nil, app.NewValidationError("customer id can't be empty")
+	}
+	if err := bikerental.CheckCustomerAccess(ctx, customerID); err != nil {
+		return nil, err
+	}
+
+	account, err := s.repository.GetAccount(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching loyalty account from repository: %w", err)
+	}
+	return account, nil
+}
+
+// ListEntries returns loyalty ledger entries of a customer, from the oldest.
+func (s *Service) ListEntries(ctx context.Context, customerID string) ([]bikerental.LoyaltyEntry, error) {
+	if customerID == "" {
+		return nil, app.NewValidationError("customer id can't be empty")
+	}
+	if err := bikerental.CheckCustomerAccess(ctx, customerID); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.repository.ListEntries(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching loyalty ledger from repository: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,172 @@
+package bikerental
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// Currency is an ISO 4217 currency code, for example "EUR".
+type Currency string
+
+// BaseCurrency is a currency of all values that are not tied to a bike:
+// loyalty points, company spending limits and statements, deposit amounts configuration.
+const BaseCurrency Currency = "EUR"
+
+// Validate validates currency code format.
+func (c Currency) Validate() error {
+	if len(c) != 3 {
+		return app.NewValidationError(fmt.Sprintf("invalid currency code: '%s'", c))
+	}
+	for _, r := range c {
+		if r < 'A' || r > 'Z' {
+			return app.NewValidationError(fmt.Sprintf("invalid currency code: '%s'", c))
+		}
+	}
+	return nil
+}
+
+// MinorUnits returns number of decimal places of the currency, e.g. 2 for euro (cents).
+func (c Currency) MinorUnits() int {
+	switch c {
+	case "BIF", "CLP", "DJF", "GNF", "ISK", "JPY", "KMF", "KRW", "PYG", "RWF", "UGX", "VND", "VUV", "XAF", "XOF", "XPF":
+		return 0
+	case "BHD", "IQD", "JOD", "KWD", "LYD", "OMR", "TND":
+		return 3
+	default:
+		return 2
+	}
+}
+
+// Money is an amount of money in minor units of the currency, e.g. eurocents.
+//
+// Zero value is a zero amount in no particular currency, so it can be added to money in any currency.
+// Mixing different currencies in arithmetic is a programming error and causes panic,
+// values have to be converted first.
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+// NewMoney creates new money value.
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Validate validates money value. Amount can't be negative.
+func (m Money) Validate() error {
+	if m.Amount < 0 {
+		return app.NewValidationError("amount can't be negative")
+	}
+	if m.IsZero() && m.Currency == "" {
+		return nil
+	}
+	return m.Currency.Validate()
+}
+
+// IsZero returns true if amount is zero.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Add returns sum of money values.
+func (m Money) Add(other Money) Money {
+	c := m.currencyWith(other)
+	return Money{Amount: m.Amount + other.Amount, Currency: c}
+}
+
+// Sub returns difference of money values.
+func (m Money) Sub(other Money) Money {
+	c := m.currencyWith(other)
+	return Money{Amount: m.Amount - other.Amount, Currency: c}
+}
+
+// Mul returns money multiplied by a factor, rounded to minor units with math.Round.
+func (m Money) Mul(factor float64) Money {
+	return Money{Amount: int64(math.Round(float64(m.Amount) * factor)), Currency: m.Currency}
+}
+
+// Percent returns given percent of money, rounded to minor units with math.Round.
+func (m Money) Percent(percent float64) Money {
+	return Money{Amount: int64(math.Round(float64(m.Amount) * percent / 100.0)), Currency: m.Currency}
+}
+
+// Less returns true if m is lower than other.
+func (m Money) Less(other Money) bool {
+	m.currencyWith(other)
+	return m.Amount < other.Amount
+}
+
+// Min returns the lower of money values.
+func (m Money) Min(other Money) Money {
+	if other.Less(m) {
+		return Money{Amount: other.Amount, Currency: m.currencyWith(other)}
+	}
+	return Money{Amount: m.Amount, Currency: m.currencyWith(other)}
+}
+
+// Convert returns money converted to other currency with given rate, rounded to minor units with math.Round.
+// Rate is a price of one unit of m currency in `to` currency.
+func (m Money) Convert(rate float64, to Currency) Money {
+	if m.Currency == to {
+		return m
+	}
+	scale := math.Pow10(to.MinorUnits() - m.Currency.MinorUnits())
+	return Money{Amount: int64(math.Round(float64(m.Amount) * rate * scale)), Currency: to}
+}
+
+func (m Money) String() string {
+	return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+}
+
+// Format returns amount in major units with currency code, for customers, e.g. "12.50 EUR".
+func (m Money) Format() string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	digits := m.Currency.MinorUnits()
+	if digits == 0 {
+		return fmt.Sprintf("%s%d %s", sign, amount, m.Currency)
+	}
+	unit := int64(math.Pow10(digits))
+	return fmt.Sprintf("%s%d.%0*d %s", sign, amount/unit, digits, amount%unit, m.Currency)
+}
+
+// currencyWith returns common currency of two money values. Zero values match any currency.
+func (m Money) currencyWith(other Money) Currency {
+	switch {
+	case m.Currency == other.Currency:
+		return m.Currency
+	case m.Currency == "" && m.IsZero():
+		return other.Currency
+	case other.Currency == "" && other.IsZero():
+		return m.Currency
+	default:
+		panic(fmt.Sprintf("mixing money in different currencies: %s and %s", m.Currency, other.Currency))
+	}
+}
+
+// ExchangeRateService provides currency exchange rates.
+type ExchangeRateService interface {
+	// GetRate returns a price of one unit of `from` currency in `to` currency.
+	// Returns app.ErrNotFound if any of currencies is unknown.
+	GetRate(ctx context.Context, from, to Currency) (float64, error)
+}
+
+// ConvertMoney converts money to other currency using exchange rates.
+func ConvertMoney(ctx context.Context, rates ExchangeRateService, m Money, to Currency) (Money, error) {
+	if m.Currency == to || (m.Currency == "" && m.IsZero()) {
+		return Money{Amount: m.Amount, Currency: to}, nil
+	}
+	rate, err := rates.GetRate(ctx, m.Currency, to)
+	if err != nil {
+		return Money{}, fmt.Errorf("fetching %s/%s exchange rate: %w", m.Currency, to, err)
+	}
+	return m.Convert(rate, to), nil
+}
@@ -0,0 +1,88 @@
+package bikerental
+
+import (
+	"context"
+	"time"
+)
+
+// NotificationType describes what the customer is notified about.
+type NotificationType string
+
+// Notification types.
+const (
+	NotificationTypeReservationConfirmed NotificationType = "reservation_confirmed"
+	NotificationTypeReservationReminder  NotificationType = "reservation_reminder"
+	NotificationTypeReservationCanceled  NotificationType = "reservation_canceled"
+	NotificationTypeLateReturnWarning    NotificationType = "late_return_warning"
+)
+
+// ReservationReminderLeadTime is how long before the reservation start the reminder is sent.
+const ReservationReminderLeadTime = time.Hour
+
+// NotificationStatus describes state of notification in outbox.
+type NotificationStatus string
+
+// Notification statuses.
+const (
+	NotificationStatusPending NotificationStatus = "pending"
+	NotificationStatusSent    NotificationStatus = "sent"
+
+	// NotificationStatusSkipped is a status of notifications that were not relevant anymore when they were due,
+	// for example reminder of canceled reservation.
+	NotificationStatusSkipped NotificationStatus = "skipped"
+
+	// NotificationStatusFailed is a status of notifications that couldn't be sent after all retries.
+	NotificationStatusFailed NotificationStatus = "failed"
+)
+
+// Notification is a message to the customer about reservation, waiting in outbox until it's due.
+// Notifications are stored in the same transaction as reservation changes and sent after it's committed.
+type Notification struct {
+	ID            string
+	Type          NotificationType
+	ReservationID string
+
+	// TenantID is a tenant of the reservation. It's set by the repository when notification is enqueued.
+	TenantID string
+
+	// Recipient is an email address of the customer.
+	Recipient string
+
+	// SendAt is a time when notification is due.
+	SendAt time.Time
+
+	Status NotificationStatus
+
+	// Attempts is a number of failed attempts to send the notification.
+	Attempts  int
+	LastError string
+	CreatedAt time.Time
+}
+
+// IsRelevant returns false if notification shouldn't be sent anymore, because reservation state has changed.
+// Reminders and late return warnings are sent only for reservations that are still approved.
+func (n Notification) IsRelevant(r Reservation) bool {
+	switch n.Type {
+	case NotificationTypeReservationReminder, NotificationTypeLateReturnWarning:
+		return r.Status == ReservationStatusApproved
+	default:
+		return true
+	}
+}
+
+// Message is a rendered notification.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Notifier is a port for delivering messages to customers.
+type Notifier interface {
+	Send(context.Context, Message) error
+}
+
+// NotificationRenderer renders notification messages from templates.
+type NotificationRenderer interface {
+	Render(ctx context.Context, n Notification, r Reservation) (*Message, error)
+}
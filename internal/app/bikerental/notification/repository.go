@@ -0,0 +1,28 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// OutboxRepository provides access to notifications waiting for delivery.
+type OutboxRepository interface {
+	// ClaimDue returns pending notifications of all tenants due at given time and locks them for lease duration,
+	// so concurrent workers don't send them twice.
+	// Notifications not updated before the lease expires are returned again.
+	ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]bikerental.Notification, error)
+
+	// Update stores status, schedule and attempts of a notification of the tenant from context
+	// and releases its lock.
+	// Returns app.ErrNotFound if notification doesn't exist.
+	Update(context.Context, bikerental.Notification) error
+}
+
+// ReservationRepository provides methods for reading reservation data.
+type ReservationRepository interface {
+	// Get returns a reservation by id.
+	// Returns app.ErrNotFound if reservation doesn't exists.
+	Get(ctx context.Context, id string) (*bikerental.Reservation, error)
+}
@@ -0,0 +1,124 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+const (
+	// batchSize is a max number of notifications processed in one outbox run.
+	batchSize = 50
+
+	// claimLease is how long claimed notifications are locked for other workers.
+	// It has to be longer than sending the whole batch.
+	claimLease = 5 * time.Minute
+
+	// maxAttempts is a number of attempts after which notification is marked as failed.
+	maxAttempts = 5
+
+	// retryDelay is a delay after first failed attempt. It's doubled after each next attempt.
+	retryDelay = time.Minute
+)
+
+// Service sends notifications from outbox.
+type Service struct {
+	notifier         bikerental.Notifier
+	renderer         bikerental.NotificationRenderer
+	outboxRepo       OutboxRepository
+	reservationsRepo ReservationRepository
+}
+
+// NewService creates new service instance.
+func NewService(
+	notifier bikerental.Notifier,
+	renderer bikerental.NotificationRenderer,
+	outboxRepo OutboxRepository,
+	reservationsRepo ReservationRepository,
+) (*Service, error) {
+	if notifier == nil {
+		return nil, errors.New("empty notifier")
+	}
+	if renderer == nil {
+		return nil, errors.New("empty notification renderer")
+	}
+	if outboxRepo == nil {
+		return nil, errors.New("empty outbox repository")
+	}
+	if reservationsRepo == nil {
+		return nil, errors.New("empty reservations repository")
+	}
+	return &Service{
+		notifier:         notifier,
+		renderer:         renderer,
+		outboxRepo:       outboxRepo,
+		reservationsRepo: reservationsRepo,
+	}, nil
+}
+
+// ProcessOutbox sends due notifications and returns number of processed ones.
+// Notifications that are not relevant anymore, e.g. reminders of canceled reservations, are skipped.
+// Failed notifications are retried with exponential backoff, up to maxAttempts.
+// Returned error means that outbox couldn't be updated, delivery errors are stored with notifications.
+func (s *Service) ProcessOutbox(ctx context.Context) (int, error) {
+	now := time.Now()
+	notifications, err := s.outboxRepo.ClaimDue(ctx, now, batchSize, claimLease)
+	if err != nil {
+		return 0, fmt.Errorf("claiming due notifications: %w", err)
+	}
+
+	for i, n := range notifications {
+		// Worker processes notifications of all tenants.
+		ctx := app.CtxWithTenantID(ctx, n.TenantID)
+		n = s.deliver(ctx, n, now)
+		if err := s.outboxRepo.Update(ctx, n); err != nil {
+			return i, fmt.Errorf("updating notification %s: %w", n.ID, err)
+		}
+	}
+	return len(notifications), nil
+}
+
+// deliver sends notification and returns it with updated status.
+func (s *Service) deliver(ctx context.Context, n bikerental.Notification, now time.Time) bikerental.Notification {
+	reservation, err := s.reservationsRepo.Get(ctx, n.ReservationID)
+	if err != nil {
+		if app.IsNotFoundError(err) {
+			n.Status = bikerental.NotificationStatusSkipped
+			n.LastError = "reservation not found"
+			return n
+		}
+		return retry(n, fmt.Errorf("fetching reservation: %w", err), now)
+	}
+	if !n.IsRelevant(*reservation) {
+		n.Status = bikerental.NotificationStatusSkipped
+		return n
+	}
+
+	msg, err := s.renderer.Render(ctx, n, *reservation)
+	if err != nil {
+		return retry(n, fmt.Errorf("rendering message: %w", err), now)
+	}
+	if err := s.notifier.Send(ctx, *msg); err != nil {
+		return retry(n, fmt.Errorf("sending message: %w", err), now)
+	}
+
+	n.Status = bikerental.NotificationStatusSent
+	n.LastError = ""
+	return n
+}
+
+// retry schedules next attempt of failed notification, or marks it as failed after maxAttempts.
+func retry(n bikerental.Notification, err error, now time.Time) bikerental.Notification {
+	n.Attempts++
+	n.LastError = err.Error()
+	if n.Attempts >= maxAttempts {
+		n.Status = bikerental.NotificationStatusFailed
+		return n
+	}
+	n.SendAt = now.Add(retryDelay << (n.Attempts - 1))
+	return n
+}
@@ -0,0 +1,267 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+type fakeOutbox struct {
+	due     []bikerental.Notification
+	updated []bikerental.Notification
+	// updatedTenants are tenants from context of updates.
+	updatedTenants []string
+}
+
+func (o *fakeOutbox) ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]bikerental.Notification, error) {
+	due := o.due
+	o.due = nil
+	return due, nil
+}
+
+func (o *fakeOutbox) Update(ctx context.Context, n bikerental.Notification) error {
+	tenantID, _ := app.TenantIDFromCtx(ctx)
+	o.updated = append(o.updated, n)
+	o.updatedTenants = append(o.updatedTenants, tenantID)
+	return nil
+}
+
+// fakeReservations returns reservations only for their tenant.
+type fakeReservations struct {
+	tenantID     string
+	reservations map[string]bikerental.Reservation
+	err          error
+}
+
+func (r *fakeReservations) Get(ctx context.Context, id string) (*bikerental.Reservation, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	tenantID, _ := app.TenantIDFromCtx(ctx)
+	res, ok := r.reservations[id]
+	if !ok || tenantID != r.tenantID {
+		return nil, app.ErrNotFound
+	}
+	return &res, nil
+}
+
+type fakeRenderer struct{}
+
+func (fakeRenderer) Render(ctx context.Context, n bikerental.Notification, r bikerental.Reservation) (*bikerental.Message, error) {
+	return &bikerental.Message{To: n.Recipient, Subject: string(n.Type), Body: r.ID}, nil
+}
+
+type fakeNotifier struct {
+	err  error
+	sent []bikerental.Message
+}
+
+func (n *fakeNotifier) Send(ctx context.Context, msg bikerental.Message) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.sent = append(n.sent, msg)
+	return nil
+}
+
+func newTestService(t *testing.T, notifier *fakeNotifier, outbox *fakeOutbox, reservations *fakeReservations) *Service {
+	t.Helper()
+	s, err := NewService(notifier, fakeRenderer{}, outbox, reservations)
+	if err != nil {
+		t.Fatalf("creating service: %v", err)
+	}
+	return s
+}
+
+func testNotification(typ bikerental.NotificationType, attempts int) bikerental.Notification {
+	return bikerental.Notification{
+		ID:            "notification-1",
+		Type:          typ,
+		ReservationID: "reservation-1",
+		TenantID:      "tenant-1",
+		Recipient:     "customer@example.com",
+		Status:        bikerental.NotificationStatusPending,
+		Attempts:      attempts,
+	}
+}
+
+func TestServiceProcessOutboxRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		attempts     int
+		sendErr      error
+		wantStatus   bikerental.NotificationStatus
+		wantAttempts int
+		wantDelay    time.Duration
+	}{
+		{
+			name:         "sent",
+			attempts:     2,
+			wantStatus:   bikerental.NotificationStatusSent,
+			wantAttempts: 2,
+		},
+		{
+			name:         "first failure",
+			sendErr:      errors.New("smtp unavailable"),
+			wantStatus:   bikerental.NotificationStatusPending,
+			wantAttempts: 1,
+			wantDelay:    retryDelay,
+		},
+		{
+			name:         "delay doubled after each failure",
+			attempts:     2,
+			sendErr:      errors.New("smtp unavailable"),
+			wantStatus:   bikerental.NotificationStatusPending,
+			wantAttempts: 3,
+			wantDelay:    4 * retryDelay,
+		},
+		{
+			name:         "failed after max attempts",
+			attempts:     maxAttempts - 1,
+			sendErr:      errors.New("smtp unavailable"),
+			wantStatus:   bikerental.NotificationStatusFailed,
+			wantAttempts: maxAttempts,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outbox := &fakeOutbox{due: []bikerental.Notification{testNotification(bikerental.NotificationTypeReservationConfirmed, tt.attempts)}}
+			notifier := &fakeNotifier{err: tt.sendErr}
+			s := newTestService(t, notifier, outbox, &fakeReservations{
+				tenantID: "tenant-1",
+				reservations: map[string]bikerental.Reservation{
+					"reservation-1": {ID: "reservation-1", Status: bikerental.ReservationStatusApproved},
+				},
+			})
+
+			before := time.Now()
+			processed, err := s.ProcessOutbox(context.Background())
+			after := time.Now()
+			if err != nil {
+				t.Fatalf("ProcessOutbox() error = %v", err)
+			}
+			if processed != 1 || len(outbox.updated) != 1 {
+				t.Fatalf("ProcessOutbox() processed %d, updated %d, want 1", processed, len(outbox.updated))
+			}
+
+			if outbox.updatedTenants[0] != "tenant-1" {
+				t.Errorf("updated in tenant '%s', want tenant-1", outbox.updatedTenants[0])
+			}
+			n := outbox.updated[0]
+			if n.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", n.Status, tt.wantStatus)
+			}
+			if n.Attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", n.Attempts, tt.wantAttempts)
+			}
+			if (n.LastError != "") != (tt.sendErr != nil) {
+				t.Errorf("last error = '%s', want error: %t", n.LastError, tt.sendErr != nil)
+			}
+			if tt.wantDelay > 0 && (n.SendAt.Before(before.Add(tt.wantDelay)) || n.SendAt.After(after.Add(tt.wantDelay))) {
+				t.Errorf("send at = %s, want %s after processing", n.SendAt, tt.wantDelay)
+			}
+		})
+	}
+}
+
+func TestServiceProcessOutboxRelevance(t *testing.T) {
+	tests := []struct {
+		name              string
+		notificationType  bikerental.NotificationType
+		reservationStatus bikerental.ReservationStatus
+		reservationTenant string
+		wantStatus        bikerental.NotificationStatus
+	}{
+		{
+			name:              "late return warning of not returned bike",
+			notificationType:  bikerental.NotificationTypeLateReturnWarning,
+			reservationStatus: bikerental.ReservationStatusApproved,
+			wantStatus:        bikerental.NotificationStatusSent,
+		},
+		{
+			name:              "late return warning of returned bike",
+			notificationType:  bikerental.NotificationTypeLateReturnWarning,
+			reservationStatus: bikerental.ReservationStatusCompleted,
+			wantStatus:        bikerental.NotificationStatusSkipped,
+		},
+		{
+			name:              "late return warning of canceled reservation",
+			notificationType:  bikerental.NotificationTypeLateReturnWarning,
+			reservationStatus: bikerental.ReservationStatusCanceled,
+			wantStatus:        bikerental.NotificationStatusSkipped,
+		},
+		{
+			name:              "reminder of canceled reservation",
+			notificationType:  bikerental.NotificationTypeReservationReminder,
+			reservationStatus: bikerental.ReservationStatusCanceled,
+			wantStatus:        bikerental.NotificationStatusSkipped,
+		},
+		{
+			name:              "cancellation of canceled reservation",
+			notificationType:  bikerental.NotificationTypeReservationCanceled,
+			reservationStatus: bikerental.ReservationStatusCanceled,
+			wantStatus:        bikerental.NotificationStatusSent,
+		},
+		{
+			name:              "reservation of other tenant",
+			notificationType:  bikerental.NotificationTypeLateReturnWarning,
+			reservationStatus: bikerental.ReservationStatusApproved,
+			reservationTenant: "tenant-2",
+			wantStatus:        bikerental.NotificationStatusSkipped,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantID := tt.reservationTenant
+			if tenantID == "" {
+				tenantID = "tenant-1"
+			}
+			outbox := &fakeOutbox{due: []bikerental.Notification{testNotification(tt.notificationType, 0)}}
+			notifier := &fakeNotifier{}
+			s := newTestService(t, notifier, outbox, &fakeReservations{
+				tenantID: tenantID,
+				reservations: map[string]bikerental.Reservation{
+					"reservation-1": {ID: "reservation-1", Status: tt.reservationStatus},
+				},
+			})
+
+			if _, err := s.ProcessOutbox(context.Background()); err != nil {
+				t.Fatalf("ProcessOutbox() error = %v", err)
+			}
+			if len(outbox.updated) != 1 {
+				t.Fatalf("updated %d notifications, want 1", len(outbox.updated))
+			}
+			if got := outbox.updated[0].Status; got != tt.wantStatus {
+				t.Errorf("status = %s, want %s", got, tt.wantStatus)
+			}
+			wantSent := 0
+			if tt.wantStatus == bikerental.NotificationStatusSent {
+				wantSent = 1
+			}
+			if len(notifier.sent) != wantSent {
+				t.Errorf("sent %d messages, want %d", len(notifier.sent), wantSent)
+			}
+		})
+	}
+}
+
+func TestServiceProcessOutboxReservationError(t *testing.T) {
+	outbox := &fakeOutbox{due: []bikerental.Notification{testNotification(bikerental.NotificationTypeLateReturnWarning, 0)}}
+	notifier := &fakeNotifier{}
+	s := newTestService(t, notifier, outbox, &fakeReservations{err: errors.New("db unavailable")})
+
+	if _, err := s.ProcessOutbox(context.Background()); err != nil {
+		t.Fatalf("ProcessOutbox() error = %v", err)
+	}
+	n := outbox.updated[0]
+	if n.Status != bikerental.NotificationStatusPending || n.Attempts != 1 {
+		t.Errorf("notification = %s after %d attempts, want pending retry after 1 attempt", n.Status, n.Attempts)
+	}
+	if len(notifier.sent) != 0 {
+		t.Errorf("sent %d messages, want 0", len(notifier.sent))
+	}
+}
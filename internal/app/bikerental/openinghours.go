@@ -0,0 +1,145 @@
+package bikerental
+
+import (
+	"context"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// MaxOpenIntervalsRange limits time range of opening hours queries.
+const MaxOpenIntervalsRange = 31 * 24 * time.Hour
+
+// OpeningHours is a calendar of station opening hours: weekly schedule with holiday exceptions.
+type OpeningHours struct {
+	// StationID is empty for global calendar, used by stations without their own calendar.
+	StationID string
+
+	// Location is a time zone of the schedule. If nil, UTC is used.
+	Location *time.Location
+
+	// Weekly contains opening hours for each day of a week. Days without hours are closed.
+	Weekly map[time.Weekday][]ClockRange
+
+	// Holidays override weekly schedule for specific dates.
+	Holidays []Holiday
+}
+
+// ClockRange is a range of local wall clock time within a day: [From, To].
+type ClockRange struct {
+	From Clock
+	To   Clock
+}
+
+// Clock is a local wall clock time. 24:00 means the end of a day.
+type Clock struct {
+	Hour   int
+	Minute int
+}
+
+// Holiday is a date with special opening hours. If there are no hours, the station is closed all day.
+type Holiday struct {
+	Name  string
+	Year  int
+	Month time.Month
+	Day   int
+	Hours []ClockRange
+}
+
+// OpenInterval is a time range when a station is open.
+type OpenInterval struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// IsOpen returns true if the station is open at given time.
+// Opening and closing times are treated as open, so a bike can be returned at closing time.
+func (h OpeningHours) IsOpen(t time.Time) bool {
+	for _, i := range h.OpenIntervals(t.Add(-24*time.Hour), t.Add(24*time.Hour)) {
+		if !t.Before(i.StartTime) && !t.After(i.EndTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// OpenIntervals returns time ranges when the station is open, clipped to [from, to] range.
+// Adjacent intervals, for example spanning midnight, are merged.
+func (h OpeningHours) OpenIntervals(from, to time.Time) []OpenInterval {
+	loc := h.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var result []OpenInterval
+	start := from.In(loc)
+	for day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc); day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, r := range h.hoursOn(day) {
+			i := OpenInterval{
+				StartTime: time.Date(day.Year(), day.Month(), day.Day(), r.From.Hour, r.From.Minute, 0, 0, loc),
+				EndTime:   time.Date(day.Year(), day.Month(), day.Day(), r.To.Hour, r.To.Minute, 0, 0, loc),
+			}
+			if i.StartTime.Before(from) {
+				i.StartTime = from
+			}
+			if i.EndTime.After(to) {
+				i.EndTime = to
+			}
+			if !i.EndTime.After(i.StartTime) {
+				continue
+			}
+
+			if n := len(result); n > 0 && !i.StartTime.After(result[n-1].EndTime) {
+				if i.EndTime.After(result[n-1].EndTime) {
+					result[n-1].EndTime = i.EndTime
+				}
+				continue
+			}
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+func (h OpeningHours) hoursOn(day time.Time) []ClockRange {
+	for _, hol := range h.Holidays {
+		if hol.Year == day.Year() && hol.Month == day.Month() && hol.Day == day.Day() {
+			return hol.Hours
+		}
+	}
+	return h.Weekly[day.Weekday()]
+}
+
+// OpeningHoursService provides opening hours of stations.
+type OpeningHoursService interface {
+	ListOpenIntervals(context.Context, ListOpenIntervalsRequest) ([]OpenInterval, error)
+	IsOpen(ctx context.Context, stationID string, t time.Time) (bool, error)
+
+	// StationLocation returns time zone of the station opening hours, or UTC if it's not known.
+	StationLocation(ctx context.Context, stationID string) (*time.Location, error)
+}
+
+// ListOpenIntervalsRequest is a request for listing station opening hours in a time range.
+type ListOpenIntervalsRequest struct {
+	StationID string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// Validate validates request data.
+func (r *ListOpenIntervalsRequest) Validate() error {
+	// Note: IsZero check doesn't work for empty timestamps created by empty protobuf timestamp.AsTime.
+	if r.StartTime.Unix() == 0 {
+		return app.NewValidationError("start time can't be empty")
+	}
+	if r.EndTime.Unix() == 0 {
+		return app.NewValidationError("end time can't be empty")
+	}
+	if r.EndTime.Before(r.StartTime) {
+		return app.NewValidationError("end time have to ba after start time")
+	}
+	if r.EndTime.Sub(r.StartTime) > MaxOpenIntervalsRange {
+		return app.NewValidationError("time range can't be longer than 31 days")
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package openinghours
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Repository provides opening hours calendars.
+type Repository interface {
+	// GetOpeningHours returns calendar of a station. Empty station id means global calendar.
+	// Returns app.ErrNotFound if there is no calendar for the station.
+	GetOpeningHours(ctx context.Context, stationID string) (*bikerental.OpeningHours, error)
+}
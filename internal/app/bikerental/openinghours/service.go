@@ -0,0 +1,90 @@
+package openinghours
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service provides opening hours of stations.
+type Service struct {
+	repository Repository
+}
+
+// NewService creates new service instance.
+func NewService(repository Repository) (*Service, error) {
+	if repository == nil {
+		return nil, errors.New("empty opening hours repository")
+	}
+	return &Service{
+		repository: repository,
+	}, nil
+}
+
+// ListOpenIntervals returns time ranges when the station is open.
+func (s *Service) ListOpenIntervals(ctx context.Context, req bikerental.ListOpenIntervalsRequest) ([]bikerental.OpenInterval, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	calendar, err := s.calendar(ctx, req.StationID)
+	if err != nil {
+		return nil, err
+	}
+	if calendar == nil {
+		return []bikerental.OpenInterval{{StartTime: req.StartTime, EndTime: req.EndTime}}, nil
+	}
+	return calendar.OpenIntervals(req.StartTime, req.EndTime), nil
+}
+
+// IsOpen returns true if the station is open at given time.
+func (s *Service) IsOpen(ctx context.Context, stationID string, t time.Time) (bool, error) {
+	calendar, err := s.calendar(ctx, stationID)
+	if err != nil {
+		return false, err
+	}
+	if calendar == nil {
+		return true, nil
+	}
+	return calendar.IsOpen(t), nil
+}
+
+// StationLocation returns time zone of the station calendar.
+// Stations without calendars are open all the time, so UTC is returned for them.
+func (s *Service) StationLocation(ctx context.Context, stationID string) (*time.Location, error) {
+	calendar, err := s.calendar(ctx, stationID)
+	if err != nil {
+		return nil, err
+	}
+	if calendar == nil || calendar.Location == nil {
+		return time.UTC, nil
+	}
+	return calendar.Location, nil
+}
+
+// calendar returns calendar of a station, falling back to global calendar.
+// If there is no calendar at all, returns nil and stations are open all the time.
+func (s *Service) calendar(ctx context.Context, stationID string) (*bikerental.OpeningHours, error) {
+	if stationID != "" {
+		calendar, err := s.repository.GetOpeningHours(ctx, stationID)
+		if err == nil {
+			return calendar, nil
+		}
+		if !app.IsNotFoundError(err) {
+			return nil, fmt.Errorf("fetching station calendar from repository: %w", err)
+		}
+	}
+
+	calendar, err := s.repository.GetOpeningHours(ctx, "")
+	if err != nil {
+		if app.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetching global calendar from repository: %w", err)
+	}
+	return calendar, nil
+}
@@ -0,0 +1,256 @@
+package bikerental
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("loading location %s: %v", name, err)
+	}
+	return loc
+}
+
+func hours(fromHour, toHour int) []ClockRange {
+	return []ClockRange{{From: Clock{Hour: fromHour}, To: Clock{Hour: toHour}}}
+}
+
+// everyDay returns weekly schedule with the same hours on each day.
+func everyDay(r []ClockRange) map[time.Weekday][]ClockRange {
+	weekly := map[time.Weekday][]ClockRange{}
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		weekly[d] = r
+	}
+	return weekly
+}
+
+func utc(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestOpeningHoursOpenIntervals(t *testing.T) {
+	warsaw := loadLocation(t, "Europe/Warsaw")
+	tests := []struct {
+		name  string
+		hours OpeningHours
+		from  time.Time
+		to    time.Time
+		want  []OpenInterval
+	}{
+		{
+			name:  "utc by default",
+			hours: OpeningHours{Weekly: everyDay(hours(8, 16))},
+			from:  utc("2021-06-07T00:00:00Z"),
+			to:    utc("2021-06-08T00:00:00Z"),
+			want:  []OpenInterval{{utc("2021-06-07T08:00:00Z"), utc("2021-06-07T16:00:00Z")}},
+		},
+		{
+			name:  "summer time in station location",
+			hours: OpeningHours{Location: warsaw, Weekly: everyDay(hours(8, 16))},
+			from:  utc("2021-06-07T00:00:00Z"),
+			to:    utc("2021-06-08T00:00:00Z"),
+			want:  []OpenInterval{{utc("2021-06-07T06:00:00Z"), utc("2021-06-07T14:00:00Z")}},
+		},
+		{
+			name:  "local date other than utc date",
+			hours: OpeningHours{Location: warsaw, Weekly: everyDay(hours(0, 2))},
+			from:  utc("2021-06-06T22:30:00Z"),
+			to:    utc("2021-06-07T12:00:00Z"),
+			want:  []OpenInterval{{utc("2021-06-06T22:30:00Z"), utc("2021-06-07T00:00:00Z")}},
+		},
+		{
+			name:  "clocks moved forward during opening hours",
+			hours: OpeningHours{Location: warsaw, Weekly: everyDay(hours(1, 5))},
+			from:  utc("2021-03-28T00:00:00Z"),
+			to:    utc("2021-03-28T22:00:00Z"),
+			want:  []OpenInterval{{utc("2021-03-28T00:00:00Z"), utc("2021-03-28T03:00:00Z")}},
+		},
+		{
+			name:  "clocks moved back during opening hours",
+			hours: OpeningHours{Location: warsaw, Weekly: everyDay(hours(1, 5))},
+			from:  utc("2021-10-30T22:00:00Z"),
+			to:    utc("2021-10-31T23:00:00Z"),
+			want:  []OpenInterval{{utc("2021-10-30T23:00:00Z"), utc("2021-10-31T04:00:00Z")}},
+		},
+		{
+			name:  "days before and after time change",
+			hours: OpeningHours{Location: warsaw, Weekly: everyDay(hours(8, 16))},
+			from:  utc("2021-10-30T00:00:00Z"),
+			to:    utc("2021-11-01T00:00:00Z"),
+			want: []OpenInterval{
+				{utc("2021-10-30T06:00:00Z"), utc("2021-10-30T14:00:00Z")},
+				{utc("2021-10-31T07:00:00Z"), utc("2021-10-31T15:00:00Z")},
+			},
+		},
+		{
+			name: "holiday closed all day",
+			hours: OpeningHours{
+				Location: warsaw,
+				Weekly:   everyDay(hours(8, 16)),
+				Holidays: []Holiday{{Name: "Christmas", Year: 2021, Month: time.December, Day: 25}},
+			},
+			from: utc("2021-12-24T00:00:00Z"),
+			to:   utc("2021-12-27T00:00:00Z"),
+			want: []OpenInterval{
+				{utc("2021-12-24T07:00:00Z"), utc("2021-12-24T15:00:00Z")},
+				{utc("2021-12-26T07:00:00Z"), utc("2021-12-26T15:00:00Z")},
+			},
+		},
+		{
+			name: "holiday with shorter hours",
+			hours: OpeningHours{
+				Location: warsaw,
+				Weekly:   everyDay(hours(8, 16)),
+				Holidays: []Holiday{{Name: "Christmas Eve", Year: 2021, Month: time.December, Day: 24, Hours: hours(8, 12)}},
+			},
+			from: utc("2021-12-24T00:00:00Z"),
+			to:   utc("2021-12-25T00:00:00Z"),
+			want: []OpenInterval{{utc("2021-12-24T07:00:00Z"), utc("2021-12-24T11:00:00Z")}},
+		},
+		{
+			name: "holiday of other year",
+			hours: OpeningHours{
+				Weekly:   everyDay(hours(8, 16)),
+				Holidays: []Holiday{{Name: "Christmas", Year: 2020, Month: time.December, Day: 25}},
+			},
+			from: utc("2021-12-25T00:00:00Z"),
+			to:   utc("2021-12-26T00:00:00Z"),
+			want: []OpenInterval{{utc("2021-12-25T08:00:00Z"), utc("2021-12-25T16:00:00Z")}},
+		},
+		{
+			name: "hours crossing midnight are merged",
+			hours: OpeningHours{Weekly: map[time.Weekday][]ClockRange{
+				time.Friday:   {{From: Clock{Hour: 18}, To: Clock{Hour: 24}}},
+				time.Saturday: {{From: Clock{Hour: 0}, To: Clock{Hour: 2}}, {From: Clock{Hour: 10}, To: Clock{Hour: 14}}},
+			}},
+			from: utc("2021-06-11T00:00:00Z"),
+			to:   utc("2021-06-13T00:00:00Z"),
+			want: []OpenInterval{
+				{utc("2021-06-11T18:00:00Z"), utc("2021-06-12T02:00:00Z")},
+				{utc("2021-06-12T10:00:00Z"), utc("2021-06-12T14:00:00Z")},
+			},
+		},
+		{
+			name:  "open all week is one interval",
+			hours: OpeningHours{Location: warsaw, Weekly: everyDay(hours(0, 24))},
+			from:  utc("2021-06-07T00:00:00Z"),
+			to:    utc("2021-06-14T00:00:00Z"),
+			want:  []OpenInterval{{utc("2021-06-07T00:00:00Z"), utc("2021-06-14T00:00:00Z")}},
+		},
+		{
+			name:  "intervals clipped to range",
+			hours: OpeningHours{Weekly: everyDay(hours(8, 16))},
+			from:  utc("2021-06-07T10:00:00Z"),
+			to:    utc("2021-06-08T09:00:00Z"),
+			want: []OpenInterval{
+				{utc("2021-06-07T10:00:00Z"), utc("2021-06-07T16:00:00Z")},
+				{utc("2021-06-08T08:00:00Z"), utc("2021-06-08T09:00:00Z")},
+			},
+		},
+		{
+			name:  "closed days",
+			hours: OpeningHours{Weekly: map[time.Weekday][]ClockRange{time.Monday: hours(8, 16)}},
+			from:  utc("2021-06-08T00:00:00Z"),
+			to:    utc("2021-06-14T00:00:00Z"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.hours.OpenIntervals(tt.from, tt.to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("OpenIntervals() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].StartTime.Equal(tt.want[i].StartTime) || !got[i].EndTime.Equal(tt.want[i].EndTime) {
+					t.Errorf("OpenIntervals()[%d] = %s - %s, want %s - %s", i,
+						got[i].StartTime.UTC(), got[i].EndTime.UTC(), tt.want[i].StartTime, tt.want[i].EndTime)
+				}
+			}
+		})
+	}
+}
+
+func TestOpeningHoursOpenIntervalsMaxRange(t *testing.T) {
+	h := OpeningHours{Location: loadLocation(t, "Europe/Warsaw"), Weekly: everyDay(hours(8, 16))}
+
+	// Range covers clocks moved back on October 31st.
+	from := time.Date(2021, time.October, 15, 0, 0, 0, 0, h.Location)
+	got := h.OpenIntervals(from, from.Add(MaxOpenIntervalsRange))
+	if len(got) != 31 {
+		t.Fatalf("OpenIntervals() returned %d intervals, want 31", len(got))
+	}
+	for _, i := range got {
+		start, end := i.StartTime.In(h.Location), i.EndTime.In(h.Location)
+		if start.Hour() != 8 || end.Hour() != 16 || end.Sub(start) != 8*time.Hour {
+			t.Errorf("interval %s - %s, want 08:00 - 16:00 local time", start, end)
+		}
+	}
+}
+
+func TestOpeningHoursIsOpen(t *testing.T) {
+	warsaw := loadLocation(t, "Europe/Warsaw")
+	h := OpeningHours{
+		Location: warsaw,
+		Weekly: map[time.Weekday][]ClockRange{
+			time.Friday:   {{From: Clock{Hour: 8}, To: Clock{Hour: 24}}},
+			time.Saturday: {{From: Clock{Hour: 0}, To: Clock{Hour: 2}}},
+			time.Sunday:   hours(1, 5),
+		},
+		Holidays: []Holiday{{Name: "Christmas Eve", Year: 2021, Month: time.December, Day: 24, Hours: hours(8, 12)}},
+	}
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{t: utc("2021-06-11T06:00:00Z"), want: true},  // Friday 08:00 local, opening time
+		{t: utc("2021-06-11T05:59:00Z"), want: false}, // Friday 07:59 local
+		{t: utc("2021-06-11T23:00:00Z"), want: true},  // Saturday 01:00 local, after midnight
+		{t: utc("2021-06-12T00:00:00Z"), want: true},  // Saturday 02:00 local, closing time
+		{t: utc("2021-06-12T00:01:00Z"), want: false}, // Saturday 02:01 local
+		{t: utc("2021-03-28T01:30:00Z"), want: true},  // Sunday 03:30 local, after clocks moved forward
+		{t: utc("2021-03-28T03:00:00Z"), want: true},  // Sunday 05:00 local summer time
+		{t: utc("2021-03-28T03:30:00Z"), want: false}, // Sunday 05:30 local summer time
+		{t: utc("2021-10-31T04:00:00Z"), want: true},  // Sunday 05:00 local winter time
+		{t: utc("2021-10-31T04:30:00Z"), want: false}, // Sunday 05:30 local winter time
+		{t: utc("2021-12-24T10:00:00Z"), want: true},  // Christmas Eve 11:00 local
+		{t: utc("2021-12-24T12:00:00Z"), want: false}, // Christmas Eve 13:00 local, closed early
+		{t: utc("2021-12-17T12:00:00Z"), want: true},  // Friday 13:00 local, week before
+	}
+	for _, tt := range tests {
+		if got := h.IsOpen(tt.t); got != tt.want {
+			t.Errorf("IsOpen(%s) = %t, want %t", tt.t.In(warsaw), got, tt.want)
+		}
+	}
+}
+
+func TestListOpenIntervalsRequestValidate(t *testing.T) {
+	start := utc("2021-06-07T00:00:00Z")
+	tests := []struct {
+		name    string
+		end     time.Time
+		wantErr bool
+	}{
+		{name: "one day", end: start.Add(24 * time.Hour)},
+		{name: "max range", end: start.Add(MaxOpenIntervalsRange)},
+		{name: "longer than max range", end: start.Add(MaxOpenIntervalsRange + time.Minute), wantErr: true},
+		{name: "end before start", end: start.Add(-time.Hour), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := ListOpenIntervalsRequest{StartTime: start, EndTime: tt.end}
+			err := r.Validate()
+			if tt.wantErr != app.IsValidationError(err) || (!tt.wantErr && err != nil) {
+				t.Errorf("Validate() error = %v, want validation error: %t", err, tt.wantErr)
+			}
+		})
+	}
+}
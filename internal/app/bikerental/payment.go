@@ -0,0 +1,70 @@
+package bikerental
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// ErrPaymentDeclined is returned by payment provider when authorization is declined.
+var ErrPaymentDeclined = app.ConflictError{Err: errors.New("payment declined")}
+
+// PaymentStatus describes state of reservation payment.
+type PaymentStatus string
+
+// Payment statuses.
+const (
+	// PaymentStatusNone is a status of reservations without payment, for example fully discounted.
+	PaymentStatusNone PaymentStatus = ""
+
+	// PaymentStatusAuthorized means that the amount is reserved on customer account.
+	PaymentStatusAuthorized PaymentStatus = "authorized"
+
+	// PaymentStatusCaptured means that the amount was charged, when the bike was returned.
+	PaymentStatusCaptured PaymentStatus = "captured"
+
+	// PaymentStatusRefunded means that captured amount was returned to the customer.
+	PaymentStatusRefunded PaymentStatus = "refunded"
+
+	// PaymentStatusVoided means that authorization was released without charging the customer.
+	PaymentStatusVoided PaymentStatus = "voided"
+)
+
+// PaymentPurpose describes what the payment is for.
+type PaymentPurpose string
+
+// Payment purposes.
+const (
+	PaymentPurposeRental  PaymentPurpose = "rental"
+	PaymentPurposeDeposit PaymentPurpose = "deposit"
+)
+
+// PaymentRequest is a request for payment authorization.
+type PaymentRequest struct {
+	// ReservationID together with purpose is used by providers as idempotency key.
+	ReservationID string
+	Purpose       PaymentPurpose
+
+	// CustomerID is empty for new customers, they are created with the reservation.
+	CustomerID    string
+	CustomerEmail string
+
+	Amount Money
+}
+
+// PaymentService is a port for payment provider.
+type PaymentService interface {
+	// Authorize reserves the amount on customer account and returns provider payment id.
+	// Returns ErrPaymentDeclined if provider declines the payment.
+	Authorize(context.Context, PaymentRequest) (string, error)
+
+	// Capture charges authorized amount.
+	Capture(ctx context.Context, paymentID string, amount Money) error
+
+	// Refund returns captured amount to the customer.
+	Refund(ctx context.Context, paymentID string, amount Money) error
+
+	// Void releases authorization without charging the customer.
+	Void(ctx context.Context, paymentID string) error
+}
@@ -0,0 +1,151 @@
+package bikerental
+
+import (
+	"context"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// MaxPriceQuoteRange limits time range of price quotes, because prices are calculated hour by hour.
+// It's also the longest reservation possible, so booking policies can't allow longer ones.
+const MaxPriceQuoteRange = 90 * 24 * time.Hour
+
+// RateTable describes dynamic pricing rules for a bike or all bikes of some model.
+// Zero values of multipliers and caps mean that the rule is not used.
+type RateTable struct {
+	// BikeID is set if the table applies to a single bike.
+	BikeID string
+
+	// ModelName is set if the table applies to all bikes of given model.
+	ModelName string
+
+	// Location is used to determine local time of day, weekdays and seasons.
+	// If nil, UTC is used.
+	Location *time.Location
+
+	// PricePerHour is a base rate in minor units of bike currency. If zero, bike's own price is used.
+	PricePerHour int64
+
+	// PeakHours are hour ranges of a day with peak rates. Other hours have off-peak rates.
+	PeakHours         []HourRange
+	PeakMultiplier    float64
+	OffPeakMultiplier float64
+
+	// WeekendMultiplier applies to all hours on Saturday and Sunday.
+	WeekendMultiplier float64
+
+	// Seasons are periods of a year with special rates. The first matching season is used.
+	Seasons []Season
+
+	// MaxChargedHoursPerDay limits number of charged hours in one calendar day.
+	MaxChargedHoursPerDay float64
+
+	// MaxChargedHoursPerWeek limits number of charged hours in one calendar week (starting on Monday).
+	MaxChargedHoursPerWeek float64
+}
+
+// HourRange is a range of hours within a day: [From, To).
+type HourRange struct {
+	From int
+	To   int
+}
+
+// Contains returns true if the hour is within the range.
+func (r HourRange) Contains(hour int) bool {
+	return hour >= r.From && hour < r.To
+}
+
+// Season is a period of a year with special rate. Period boundaries are inclusive.
+// Seasons may wrap around the end of a year, for example from December 15th to January 15th.
+type Season struct {
+	Name       string
+	From       MonthDay
+	To         MonthDay
+	Multiplier float64
+}
+
+// Contains returns true if the time is within the season.
+func (s Season) Contains(t time.Time) bool {
+	md := MonthDay{Month: t.Month(), Day: t.Day()}
+	if s.From.After(s.To) {
+		return !md.Before(s.From) || !md.After(s.To)
+	}
+	return !md.Before(s.From) && !md.After(s.To)
+}
+
+// MonthDay is a day of a year, without specific year.
+type MonthDay struct {
+	Month time.Month
+	Day   int
+}
+
+// Before returns true if md is earlier in a year than other.
+func (md MonthDay) Before(other MonthDay) bool {
+	if md.Month != other.Month {
+		return md.Month < other.Month
+	}
+	return md.Day < other.Day
+}
+
+// After returns true if md is later in a year than other.
+func (md MonthDay) After(other MonthDay) bool {
+	return other.Before(md)
+}
+
+// PricingService calculates reservation prices.
+type PricingService interface {
+	QuotePrice(context.Context, PriceQuoteRequest) (*PriceQuote, error)
+}
+
+// PriceQuoteRequest is a request for calculating price of a bike rental.
+type PriceQuoteRequest struct {
+	Bike      Bike
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// Validate validates the request.
+func (r *PriceQuoteRequest) Validate() error {
+	if r.Bike.ID == "" {
+		return app.NewValidationError("empty bike id")
+	}
+	// Note: IsZero check doesn't work for empty timestamps created by empty protobuf timestamp.AsTime.
+	if r.StartTime.Unix() == 0 {
+		return app.NewValidationError("start time can't be empty")
+	}
+	if r.EndTime.Unix() == 0 {
+		return app.NewValidationError("end time can't be empty")
+	}
+	if r.EndTime.Before(r.StartTime) {
+		return app.NewValidationError("end time have to ba after start time")
+	}
+	if r.EndTime.Sub(r.StartTime) > MaxPriceQuoteRange {
+		return app.NewValidationError("time range can't be longer than 90 days")
+	}
+	return nil
+}
+
+// PriceQuote is a price of a bike rental, itemized by rate periods.
+type PriceQuote struct {
+	Items []PriceQuoteItem
+
+	// TotalValue is a sum of all items values, in bike currency.
+	TotalValue Money
+}
+
+// PriceQuoteItem is a part of reservation time charged with the same rate.
+type PriceQuoteItem struct {
+	StartTime time.Time
+	EndTime   time.Time
+
+	// Description describes applied rate, for example "peak, weekend".
+	Description string
+
+	PricePerHour Money
+
+	// ChargedHours may be lower than item duration if daily or weekly caps apply.
+	ChargedHours float64
+
+	Value Money
+}
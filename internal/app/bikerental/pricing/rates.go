@@ -0,0 +1,194 @@
+package pricing
+
+// This file contains all business rules for calculating reservation prices.
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+const (
+	standardRateDescription = "standard"
+	peakRateDescription     = "peak"
+	offPeakRateDescription  = "off-peak"
+	weekendRateDescription  = "weekend"
+)
+
+// quote calculates price of a bike rental using rate table.
+// Rules:
+//   - reservation time is split into segments at full hours of local time,
+//   - each segment is charged with a rate valid at its start,
+//   - segments are charged chronologically, until daily or weekly cap of charged hours is reached,
+//   - consecutive segments with the same rate are merged into one quote item,
+//   - prices are in bike currency,
+//   - item value is rounded to minor units of the currency, total value is a sum of items values.
+func quote(table bikerental.RateTable, bike bikerental.Bike, from, to time.Time) bikerental.PriceQuote {
+	loc := table.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	currency := bike.PricePerHour.Currency
+	basePrice := table.PricePerHour
+	if basePrice == 0 {
+		basePrice = bike.PricePerHour.Amount
+	}
+
+	dailyCap := newHoursCap(table.MaxChargedHoursPerDay)
+	weeklyCap := newHoursCap(table.MaxChargedHoursPerWeek)
+
+	var items []quoteItem
+	for start := from; start.Before(to); {
+		local := start.In(loc)
+		end := time.Date(local.Year(), local.Month(), local.Day(), local.Hour()+1, 0, 0, 0, loc)
+		if !end.After(start) {
+			// Local time can go back on DST change, just move to the next full hour then.
+			end = start.Truncate(time.Hour).Add(time.Hour)
+		}
+		if end.After(to) {
+			end = to
+		}
+
+		day := local.Format("2006-01-02")
+		year, week := local.ISOWeek()
+		weekKey := fmt.Sprintf("%d-%d", year, week)
+
+		hours := end.Sub(start).Hours()
+		charged := math.Min(hours, math.Min(dailyCap.remaining(day), weeklyCap.remaining(weekKey)))
+		dailyCap.use(day, charged)
+		weeklyCap.use(weekKey, charged)
+
+		rate, description := rateAt(table, float64(basePrice), local)
+
+		if n := len(items); n > 0 && items[n-1].description == description && items[n-1].rate == rate {
+			items[n-1].end = end
+			items[n-1].chargedHours += charged
+			items[n-1].value += rate * charged
+		} else {
+			items = append(items, quoteItem{
+				start:        start,
+				end:          end,
+				description:  description,
+				rate:         rate,
+				chargedHours: charged,
+				value:        rate * charged,
+			})
+		}
+
+		start = end
+	}
+
+	result := bikerental.PriceQuote{
+		Items:      make([]bikerental.PriceQuoteItem, 0, len(items)),
+		TotalValue: bikerental.NewMoney(0, currency),
+	}
+	for _, it := range items {
+		item := it.toAppItem(currency)
+		result.Items = append(result.Items, item)
+		result.TotalValue = result.TotalValue.Add(item.Value)
+	}
+	return result
+}
+
+// rateAt returns rate in minor units of bike currency per hour, valid at given local time, and its description.
+// Rules:
+//   - peak or off-peak multiplier applies, if rate table defines peak hours,
+//   - weekend multiplier applies on Saturday and Sunday,
+//   - multiplier of the first matching season applies,
+//   - all applicable multipliers are multiplied.
+func rateAt(table bikerental.RateTable, basePrice float64, t time.Time) (float64, string) {
+	rate := basePrice
+	var labels []string
+
+	if len(table.PeakHours) > 0 {
+		if isPeakHour(table.PeakHours, t.Hour()) {
+			rate *= multiplier(table.PeakMultiplier)
+			labels = append(labels, peakRateDescription)
+		} else {
+			rate *= multiplier(table.OffPeakMultiplier)
+			labels = append(labels, offPeakRateDescription)
+		}
+	}
+
+	if wd := t.Weekday(); table.WeekendMultiplier != 0 && (wd == time.Saturday || wd == time.Sunday) {
+		rate *= table.WeekendMultiplier
+		labels = append(labels, weekendRateDescription)
+	}
+
+	for _, s := range table.Seasons {
+		if s.Contains(t) {
+			rate *= multiplier(s.Multiplier)
+			labels = append(labels, s.Name)
+			break
+		}
+	}
+
+	if len(labels) == 0 {
+		return rate, standardRateDescription
+	}
+	return rate, strings.Join(labels, ", ")
+}
+
+func isPeakHour(peakHours []bikerental.HourRange, hour int) bool {
+	for _, r := range peakHours {
+		if r.Contains(hour) {
+			return true
+		}
+	}
+	return false
+}
+
+// multiplier returns rate multiplier. Zero value means that multiplier is not set.
+func multiplier(m float64) float64 {
+	if m == 0 {
+		return 1
+	}
+	return m
+}
+
+// hoursCap tracks charged hours within some periods (days, weeks).
+type hoursCap struct {
+	limit float64
+	used  map[string]float64
+}
+
+func newHoursCap(limit float64) *hoursCap {
+	return &hoursCap{
+		limit: limit,
+		used:  make(map[string]float64),
+	}
+}
+
+func (c *hoursCap) remaining(period string) float64 {
+	if c.limit <= 0 {
+		return math.Inf(1)
+	}
+	return math.Max(0, c.limit-c.used[period])
+}
+
+func (c *hoursCap) use(period string, hours float64) {
+	c.used[period] += hours
+}
+
+type quoteItem struct {
+	start        time.Time
+	end          time.Time
+	description  string
+	rate         float64
+	chargedHours float64
+	value        float64
+}
+
+func (it quoteItem) toAppItem(currency bikerental.Currency) bikerental.PriceQuoteItem {
+	return bikerental.PriceQuoteItem{
+		StartTime:    it.start,
+		EndTime:      it.end,
+		Description:  it.description,
+		PricePerHour: bikerental.NewMoney(int64(math.Round(it.rate)), currency),
+		ChargedHours: it.chargedHours,
+		Value:        bikerental.NewMoney(int64(math.Round(it.value)), currency),
+	}
+}
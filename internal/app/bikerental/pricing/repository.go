@@ -0,0 +1,15 @@
+package pricing
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Repository provides rate tables.
+type Repository interface {
+	// GetRateTable returns rate table for a bike.
+	// Table defined for specific bike takes precedence over table defined for bike model.
+	// Returns app.ErrNotFound if there is no rate table for the bike.
+	GetRateTable(ctx context.Context, bike bikerental.Bike) (*bikerental.RateTable, error)
+}
@@ -0,0 +1,45 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service provides methods for calculating reservation prices.
+type Service struct {
+	repository Repository
+}
+
+// NewService creates new service instance.
+func NewService(repository Repository) (*Service, error) {
+	if repository == nil {
+		return nil, errors.New("empty rate tables repository")
+	}
+	return &Service{
+		repository: repository,
+	}, nil
+}
+
+// QuotePrice returns itemized price of a bike rental.
+// If there is no rate table for the bike, bike's flat price per hour is used.
+func (s *Service) QuotePrice(ctx context.Context, r bikerental.PriceQuoteRequest) (*bikerental.PriceQuote, error) {
+	if err := r.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	table, err := s.repository.GetRateTable(ctx, r.Bike)
+	if err != nil {
+		// We're ok with no rate table, bike has a flat rate then.
+		if !app.IsNotFoundError(err) {
+			return nil, fmt.Errorf("fetching rate table from repository: %w", err)
+		}
+		table = &bikerental.RateTable{}
+	}
+
+	q := quote(*table, r.Bike, r.StartTime, r.EndTime)
+	return &q, nil
+}
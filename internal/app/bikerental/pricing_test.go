@@ -0,0 +1,32 @@
+package bikerental
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+func TestPriceQuoteRequestValidate(t *testing.T) {
+	start := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		end     time.Time
+		wantErr bool
+	}{
+		{name: "one hour", end: start.Add(time.Hour)},
+		{name: "max range", end: start.Add(MaxPriceQuoteRange)},
+		{name: "longer than max range", end: start.Add(MaxPriceQuoteRange + time.Hour), wantErr: true},
+		{name: "years", end: start.AddDate(100, 0, 0), wantErr: true},
+		{name: "end before start", end: start.Add(-time.Hour), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := PriceQuoteRequest{Bike: Bike{ID: "bike-1"}, StartTime: start, EndTime: tt.end}
+			err := r.Validate()
+			if tt.wantErr != app.IsValidationError(err) || (!tt.wantErr && err != nil) {
+				t.Errorf("Validate() error = %v, want validation error: %t", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package bikerental
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// ErrPromoCodeNotRedeemable is returned when promo code can't be redeemed anymore,
+// for example because redemption limit was reached by concurrent reservations.
+var ErrPromoCodeNotRedeemable = app.ConflictError{Err: errors.New("promo code can't be redeemed")}
+
+// PromoCodeType describes how promo code discount is calculated.
+type PromoCodeType string
+
+// Promo code types.
+const (
+	PromoCodeTypeUnknown    PromoCodeType = ""
+	PromoCodeTypePercentage PromoCodeType = "percentage"
+	PromoCodeTypeFixed      PromoCodeType = "fixed"
+)
+
+// PromoCode is a code that customer can enter when making reservation to get a discount.
+// Zero values of limits and restrictions mean that they are not used.
+type PromoCode struct {
+	Code string
+	Type PromoCodeType
+
+	// Percent of reservation value, for percentage codes.
+	Percent float64
+
+	// Amount for fixed codes. It's converted to reservation currency when the code is used.
+	Amount Money
+
+	ExpiresAt time.Time
+
+	// MaxRedemptions limits how many times the code can be used in total.
+	MaxRedemptions int
+
+	// MaxRedemptionsPerCustomer limits how many times the code can be used by one customer.
+	MaxRedemptionsPerCustomer int
+
+	// CustomerType restricts the code to one type of customers.
+	CustomerType CustomerType
+
+	// BikeModelName restricts the code to bikes of one model.
+	BikeModelName string
+
+	// Stackable codes are added to automatic discounts.
+	// Other codes compete with automatic discounts and the greater discount is applied.
+	Stackable bool
+
+	// Redemptions is a number of times the code was used. It's read only.
+	Redemptions int
+
+	CreatedAt time.Time
+}
+
+// Validate validates promo code data.
+func (p PromoCode) Validate() error {
+	if p.Code == "" {
+		return app.NewValidationError("empty code")
+	}
+	if p.Code != NormalizePromoCode(p.Code) {
+		return app.NewValidationError("code has to be upper case, without surrounding whitespace")
+	}
+
+	switch p.Type {
+	case PromoCodeTypePercentage:
+		if p.Percent <= 0 || p.Percent > 100 {
+			return app.NewValidationError("percent has to be in (0, 100] range")
+		}
+	case PromoCodeTypeFixed:
+		if p.Amount.Amount <= 0 {
+			return app.NewValidationError("amount has to be positive")
+		}
+		if err := p.Amount.Currency.Validate(); err != nil {
+			return fmt.Errorf("invalid amount: %w", err)
+		}
+	default:
+		return app.NewValidationError("invalid promo code type")
+	}
+
+	if p.MaxRedemptions < 0 || p.MaxRedemptionsPerCustomer < 0 {
+		return app.NewValidationError("redemption limits can't be negative")
+	}
+
+	return nil
+}
+
+// CheckEligibility returns ValidationError with a reason if the code can't be used for a reservation.
+// Redemption limits are checked only against current redemptions count,
+// they are enforced when reservation is stored.
+func (p PromoCode) CheckEligibility(customer Customer, bike Bike, now time.Time) error {
+	if !p.ExpiresAt.IsZero() && !now.Before(p.ExpiresAt) {
+		return app.NewValidationError(fmt.Sprintf("promo code '%s' has expired", p.Code))
+	}
+	if p.MaxRedemptions > 0 && p.Redemptions >= p.MaxRedemptions {
+		return app.NewValidationError(fmt.Sprintf("promo code '%s' has been used up", p.Code))
+	}
+	if p.CustomerType != CustomerTypeUnknown && p.CustomerType != customer.Type {
+		return app.NewValidationError(fmt.Sprintf("promo code '%s' is not valid for this type of customer", p.Code))
+	}
+	if p.BikeModelName != "" && p.BikeModelName != bike.ModelName {
+		return app.NewValidationError(fmt.Sprintf("promo code '%s' is valid only for %s bikes", p.Code, p.BikeModelName))
+	}
+	return nil
+}
+
+// DiscountAmount returns discount for given reservation value.
+// Amount of fixed codes has to be converted to reservation currency first.
+// Discount is never greater than reservation value.
+func (p PromoCode) DiscountAmount(reservationValue Money) Money {
+	var amount Money
+	switch p.Type {
+	case PromoCodeTypePercentage:
+		amount = reservationValue.Percent(p.Percent)
+	case PromoCodeTypeFixed:
+		amount = p.Amount
+	}
+	return amount.Min(reservationValue)
+}
+
+// NormalizePromoCode returns promo code in canonical form, so customers can type it in any case.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// PromoCodeService manages promo codes.
+type PromoCodeService interface {
+	ListPromoCodes(context.Context) ([]PromoCode, error)
+	CreatePromoCode(context.Context, PromoCode) (*PromoCode, error)
+}
@@ -0,0 +1,17 @@
+package promocode
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Repository provides methods for reading/writing promo codes.
+type Repository interface {
+	// List returns all promo codes.
+	List(context.Context) ([]bikerental.PromoCode, error)
+
+	// Create creates new promo code.
+	// Returns app.ConflictError if code already exists.
+	Create(context.Context, bikerental.PromoCode) error
+}
@@ -0,0 +1,50 @@
+package promocode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service manages promo codes.
+type Service struct {
+	repository Repository
+}
+
+// NewService creates new service instance.
+func NewService(repository Repository) (*Service, error) {
+	if repository == nil {
+		return nil, errors.New("empty promo codes repository")
+	}
+	return &Service{
+		repository: repository,
+	}, nil
+}
+
+// ListPromoCodes returns all promo codes.
+func (s *Service) ListPromoCodes(ctx context.Context) ([]bikerental.PromoCode, error) {
+	codes, err := s.repository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching promo codes from repository: %w", err)
+	}
+	return codes, nil
+}
+
+// CreatePromoCode creates new promo code.
+// Code is normalized, so customers can type it in any case.
+func (s *Service) CreatePromoCode(ctx context.Context, p bikerental.PromoCode) (*bikerental.PromoCode, error) {
+	p.Code = bikerental.NormalizePromoCode(p.Code)
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid promo code: %w", err)
+	}
+	p.Redemptions = 0
+	p.CreatedAt = time.Now()
+
+	if err := s.repository.Create(ctx, p); err != nil {
+		return nil, fmt.Errorf("creating promo code in repository: %w", err)
+	}
+	return &p, nil
+}
@@ -0,0 +1,160 @@
+package bikerental
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// RateLimit is a token bucket limit: Rate tokens are added per second, up to Burst tokens.
+// Each call takes one token.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// Validate validates rate limit.
+func (l RateLimit) Validate() error {
+	if l.Rate <= 0 {
+		return app.NewValidationError("rate has to be positive")
+	}
+	if l.Burst < 1 {
+		return app.NewValidationError("burst has to be at least 1")
+	}
+	return nil
+}
+
+// RateLimitPolicy defines limits of API clients.
+// Clients are callers identities. Calls are limited per IP address too, before callers are authenticated.
+type RateLimitPolicy struct {
+	// IP is a limit of all calls from an IP address, so invalid credentials can't be tried without limits.
+	IP RateLimit
+
+	// Default is a limit of methods not listed in Methods.
+	Default RateLimit
+
+	// Methods are limits of rpc methods, keyed by method names, e.g. "CreateReservation".
+	// Each method of a client has its own bucket.
+	Methods map[string]RateLimit
+
+	// DailyQuota is a max number of calls of a client per day (UTC), all methods together.
+	// Zero means no quota.
+	DailyQuota int64
+}
+
+// Validate validates rate limit policy.
+func (p RateLimitPolicy) Validate() error {
+	if err := p.IP.Validate(); err != nil {
+		return fmt.Errorf("invalid ip limit: %w", err)
+	}
+	if err := p.Default.Validate(); err != nil {
+		return fmt.Errorf("invalid default limit: %w", err)
+	}
+	for m, l := range p.Methods {
+		if err := l.Validate(); err != nil {
+			return fmt.Errorf("invalid limit of method %s: %w", m, err)
+		}
+	}
+	if p.DailyQuota < 0 {
+		return app.NewValidationError("daily quota can't be negative")
+	}
+	return nil
+}
+
+// MethodLimit returns limit of the method.
+func (p RateLimitPolicy) MethodLimit(method string) RateLimit {
+	if l, ok := p.Methods[method]; ok {
+		return l
+	}
+	return p.Default
+}
+
+// RefillTime returns max time after which buckets of all limits are full.
+// Full bucket is the same as a new one, so buckets not used for that long can be removed.
+func (p RateLimitPolicy) RefillTime() time.Duration {
+	refill := func(l RateLimit) time.Duration {
+		return time.Duration(float64(l.Burst) / l.Rate * float64(time.Second))
+	}
+	max := refill(p.IP)
+	if d := refill(p.Default); d > max {
+		max = d
+	}
+	for _, l := range p.Methods {
+		if d := refill(l); d > max {
+			max = d
+		}
+	}
+	return max
+}
+
+// TokenBucket is a state of a rate limited client.
+type TokenBucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// NewTokenBucket returns full bucket.
+func NewTokenBucket(limit RateLimit, now time.Time) TokenBucket {
+	return TokenBucket{
+		Tokens:    float64(limit.Burst),
+		UpdatedAt: now,
+	}
+}
+
+// Take refills the bucket and takes one token from it.
+// Returns bucket after the call, and time after which the call can be retried if there was no token.
+func (b TokenBucket) Take(limit RateLimit, now time.Time) (TokenBucket, RateLimitDecision) {
+	if elapsed := now.Sub(b.UpdatedAt); elapsed > 0 {
+		b.Tokens = math.Min(float64(limit.Burst), b.Tokens+elapsed.Seconds()*limit.Rate)
+		b.UpdatedAt = now
+	}
+	if b.Tokens < 1 {
+		wait := time.Duration((1 - b.Tokens) / limit.Rate * float64(time.Second))
+		return b, RateLimitDecision{RetryAfter: wait}
+	}
+	b.Tokens--
+	return b, RateLimitDecision{Allowed: true}
+}
+
+// RateLimitDecision is a result of taking a token.
+type RateLimitDecision struct {
+	Allowed bool
+
+	// RetryAfter is set when the call is not allowed.
+	RetryAfter time.Duration
+}
+
+// RateLimiter is a port for token buckets storage.
+type RateLimiter interface {
+	// Take takes one token from the bucket of the key. Bucket of a new key is full.
+	Take(ctx context.Context, key string, limit RateLimit, now time.Time) (RateLimitDecision, error)
+
+	// DeleteIdle removes buckets not used since given time, and returns number of removed buckets.
+	DeleteIdle(ctx context.Context, before time.Time) (int, error)
+}
+
+// QuotaCounter is a port for daily calls counters.
+type QuotaCounter interface {
+	// Increment increments counter of the key for the day (UTC) of given time, and returns the new value.
+	Increment(ctx context.Context, key string, at time.Time) (int64, error)
+
+	// DeleteBefore removes counters of days (UTC) before the day of given time, and returns number of removed counters.
+	DeleteBefore(ctx context.Context, at time.Time) (int, error)
+}
+
+// RateLimitService limits calls of API clients.
+type RateLimitService interface {
+	// CheckRateLimit counts the call of the client to the method.
+	// Returns app.RateLimitError if the client exceeded its limits.
+	CheckRateLimit(ctx context.Context, client, method string) error
+
+	// CheckIPRateLimit counts the call from the IP address, before the caller is authenticated.
+	// Returns app.RateLimitError if the address exceeded its limit.
+	CheckIPRateLimit(ctx context.Context, ip string) error
+
+	// DeleteExpired removes buckets and counters not needed anymore, and returns number of removed items.
+	DeleteExpired(ctx context.Context) (int, error)
+}
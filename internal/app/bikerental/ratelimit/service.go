@@ -0,0 +1,98 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service limits calls of API clients with token buckets per client and method, and daily quotas per client.
+type Service struct {
+	policy  bikerental.RateLimitPolicy
+	limiter bikerental.RateLimiter
+	quotas  bikerental.QuotaCounter
+}
+
+// NewService creates new service instance.
+func NewService(
+	policy bikerental.RateLimitPolicy,
+	limiter bikerental.RateLimiter,
+	quotas bikerental.QuotaCounter,
+) (*Service, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid rate limit policy: %w", err)
+	}
+	if limiter == nil {
+		return nil, errors.New("empty rate limiter")
+	}
+	if quotas == nil {
+		return nil, errors.New("empty quota counter")
+	}
+	return &Service{
+		policy:  policy,
+		limiter: limiter,
+		quotas:  quotas,
+	}, nil
+}
+
+// CheckRateLimit takes a token from the bucket of the client and method, and counts the call in daily quota.
+// Calls rejected by the rate limit are not counted in the quota.
+// Returns app.RateLimitError if the client exceeded its limits.
+func (s *Service) CheckRateLimit(ctx context.Context, client, method string) error {
+	now := time.Now()
+
+	decision, err := s.limiter.Take(ctx, client+"|"+method, s.policy.MethodLimit(method), now)
+	if err != nil {
+		return fmt.Errorf("taking rate limit token: %w", err)
+	}
+	if !decision.Allowed {
+		return app.NewRateLimitError(fmt.Sprintf("rate limit of %s exceeded", method), decision.RetryAfter)
+	}
+
+	if s.policy.DailyQuota == 0 {
+		return nil
+	}
+	calls, err := s.quotas.Increment(ctx, client, now)
+	if err != nil {
+		return fmt.Errorf("counting call in daily quota: %w", err)
+	}
+	if calls > s.policy.DailyQuota {
+		nextDay := now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+		return app.NewRateLimitError("daily quota exceeded", nextDay.Sub(now))
+	}
+	return nil
+}
+
+// CheckIPRateLimit takes a token from the bucket of the IP address, shared by all methods.
+// Calls from the address are not counted in daily quotas, quotas are per client.
+// Returns app.RateLimitError if the address exceeded its limit.
+func (s *Service) CheckIPRateLimit(ctx context.Context, ip string) error {
+	decision, err := s.limiter.Take(ctx, "ip:"+ip, s.policy.IP, time.Now())
+	if err != nil {
+		return fmt.Errorf("taking ip rate limit token: %w", err)
+	}
+	if !decision.Allowed {
+		return app.NewRateLimitError("rate limit of ip address exceeded", decision.RetryAfter)
+	}
+	return nil
+}
+
+// DeleteExpired removes buckets which would be full by now, and quota counters of previous days.
+// Returns number of removed buckets and counters.
+func (s *Service) DeleteExpired(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	buckets, err := s.limiter.DeleteIdle(ctx, now.Add(-s.policy.RefillTime()))
+	if err != nil {
+		return 0, fmt.Errorf("deleting idle rate limit buckets: %w", err)
+	}
+	counters, err := s.quotas.DeleteBefore(ctx, now)
+	if err != nil {
+		return buckets, fmt.Errorf("deleting old quota counters: %w", err)
+	}
+	return buckets + counters, nil
+}
@@ -0,0 +1,104 @@
+package bikerental
+
+import (
+	"context"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// ReceiptFormat is a format of receipt document.
+type ReceiptFormat string
+
+// Receipt formats.
+const (
+	ReceiptFormatHTML ReceiptFormat = "html"
+	ReceiptFormatPDF  ReceiptFormat = "pdf"
+)
+
+// Receipt is a summary of a completed rental for the customer.
+type Receipt struct {
+	ReservationID string
+	Customer      Customer
+	BikeModelName string
+	StartTime     time.Time
+	EndTime       time.Time
+
+	// BaseValue is a reservation value before discounts.
+	// It's empty for old reverse charge reservations, with value before discounts unknown.
+	BaseValue Money
+
+	Discount     Money
+	DiscountRule string
+
+	// RedeemedPoints is a number of loyalty points included in the discount.
+	RedeemedPoints int
+
+	Total Money
+
+	// Tax is empty for reservations made before tax calculation was introduced.
+	Tax ReservationTax
+}
+
+// NewReceipt creates receipt of a completed reservation.
+// Returns app.ConflictError if the rental is not completed yet.
+func NewReceipt(r Reservation) (*Receipt, error) {
+	if r.Status != ReservationStatusCompleted {
+		return nil, app.NewConflictError("receipts are available only for completed reservations")
+	}
+
+	return &Receipt{
+		ReservationID:  r.ID,
+		Customer:       r.Customer,
+		BikeModelName:  r.Bike.ModelName,
+		StartTime:      r.StartTime,
+		EndTime:        r.EndTime,
+		BaseValue:      r.QuotedValue,
+		Discount:       r.AppliedDiscount,
+		DiscountRule:   r.DiscountRule,
+		RedeemedPoints: r.RedeemedPoints,
+		Total:          r.TotalValue,
+		Tax:            r.Tax,
+	}, nil
+}
+
+// Document is a rendered document.
+type Document struct {
+	// ContentType is a MIME type of the document, for example "application/pdf".
+	ContentType string
+	Data        []byte
+}
+
+// ReceiptRenderer renders receipt documents.
+type ReceiptRenderer interface {
+	// Render returns receipt document in given format, using templates of the brand.
+	// Templates missing for the brand, or unknown brand, fall back to default templates.
+	Render(ctx context.Context, receipt Receipt, format ReceiptFormat, brand string) (*Document, error)
+}
+
+// ReceiptService provides receipts of reservations.
+type ReceiptService interface {
+	GetReceipt(context.Context, ReceiptRequest) (*Document, error)
+}
+
+// ReceiptRequest is a request for reservation receipt.
+type ReceiptRequest struct {
+	ReservationID string
+	Format        ReceiptFormat
+
+	// Brand is optional, empty brand means default templates.
+	Brand string
+}
+
+// Validate validates request data.
+func (r ReceiptRequest) Validate() error {
+	if r.ReservationID == "" {
+		return app.NewValidationError("reservation id is empty")
+	}
+	switch r.Format {
+	case ReceiptFormatHTML, ReceiptFormatPDF:
+	default:
+		return app.NewValidationError("invalid receipt format")
+	}
+	return nil
+}
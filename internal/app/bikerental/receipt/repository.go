@@ -0,0 +1,14 @@
+package receipt
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// ReservationRepository provides methods for reading reservation data.
+type ReservationRepository interface {
+	// Get returns a reservation by id.
+	// Returns app.ErrNotFound if reservation doesn't exists.
+	Get(ctx context.Context, id string) (*bikerental.Reservation, error)
+}
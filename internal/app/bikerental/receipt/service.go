@@ -0,0 +1,55 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service provides receipts of completed reservations.
+type Service struct {
+	renderer         bikerental.ReceiptRenderer
+	reservationsRepo ReservationRepository
+}
+
+// NewService creates new service instance.
+func NewService(renderer bikerental.ReceiptRenderer, reservationsRepo ReservationRepository) (*Service, error) {
+	if renderer == nil {
+		return nil, errors.New("empty receipt renderer")
+	}
+	if reservationsRepo == nil {
+		return nil, errors.New("empty reservations repository")
+	}
+	return &Service{
+		renderer:         renderer,
+		reservationsRepo: reservationsRepo,
+	}, nil
+}
+
+// GetReceipt returns rendered receipt of a completed reservation.
+func (s *Service) GetReceipt(ctx context.Context, req bikerental.ReceiptRequest) (*bikerental.Document, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	reservation, err := s.reservationsRepo.Get(ctx, req.ReservationID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching reservation by id from repository: %w", err)
+	}
+	if err := bikerental.CheckCustomerAccess(ctx, reservation.Customer.ID); err != nil {
+		return nil, err
+	}
+
+	receipt, err := bikerental.NewReceipt(*reservation)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := s.renderer.Render(ctx, *receipt, req.Format, req.Brand)
+	if err != nil {
+		return nil, fmt.Errorf("rendering receipt: %w", err)
+	}
+	return doc, nil
+}
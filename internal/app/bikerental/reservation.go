@@ -17,10 +17,12 @@ const (
 	ReservationStatusRejected ReservationStatus = "rejected"
 	ReservationStatusApproved ReservationStatus = "approved"
 	ReservationStatusCanceled ReservationStatus = "canceled"
+	// ReservationStatusCompleted is a status of reservation with bike returned by the customer.
+	ReservationStatusCompleted ReservationStatus = "completed"
 )
 
 // Reservation represents reservation for a bike.
-// Values are in fixed currency, we won't deal with currencies in decimals here for simplicity.
+// Money values are in currency of the bike, in minor units. They can be converted to BaseCurrency with ToBase.
 type Reservation struct {
 	ID        string
 	Status    ReservationStatus
@@ -29,11 +31,50 @@ type Reservation struct {
 	StartTime time.Time
 	EndTime   time.Time
 
-	// TotalValue is a total amount to pay by the customer in eurocents.
-	TotalValue int
+	// TotalValue is a total amount to pay by the customer, in bike currency.
+	// It's a gross value, including tax.
+	TotalValue Money
 
-	// AppliedDiscount is amount of discount applied to total reservation value in eurocents.
-	AppliedDiscount int
+	// QuotedValue is a reservation value before discounts, as quoted by pricing service.
+	// It's tax-inclusive for all customers, even if reverse charge customers pay only net value.
+	// It's empty for reverse charge reservations made before it was stored.
+	QuotedValue Money
+
+	// Tax is a net/tax split of the total value.
+	Tax ReservationTax
+
+	// AppliedDiscount is amount of discount applied to total reservation value.
+	AppliedDiscount Money
+
+	// DiscountRule is a name of discount rules applied to the reservation.
+	DiscountRule string
+
+	// ExchangeRate is a price of one unit of reservation currency in BaseCurrency, at the time of reservation.
+	ExchangeRate float64
+
+	// PromoCode is a promo code redeemed with the reservation, if any.
+	PromoCode string
+
+	// RedeemedPoints is a number of loyalty points redeemed as a discount.
+	// Their value is included in AppliedDiscount.
+	RedeemedPoints int
+
+	// PaymentID is an id of the payment in payment provider.
+	PaymentID     string
+	PaymentStatus PaymentStatus
+
+	// DepositAmount is a security deposit held for the reservation.
+	DepositAmount    Money
+	DepositPaymentID string
+	DepositStatus    DepositStatus
+
+	// DepositForfeited is a part of the deposit captured to cover bike damage.
+	DepositForfeited Money
+}
+
+// ToBase converts money in reservation currency to BaseCurrency, with exchange rate from the time of reservation.
+func (r Reservation) ToBase(m Money) Money {
+	return m.Convert(r.ExchangeRate, BaseCurrency)
 }
 
 // Validate validates reservation data.
@@ -50,7 +91,11 @@ type ReservationService interface {
 	GetBikeAvailability(ctx context.Context, bikeID string, startTime, endTime time.Time) (bool, error)
 	ListReservations(ctx context.Context, req ListReservationsRequest) ([]Reservation, error)
 	CreateReservation(ctx context.Context, req CreateReservationRequest) (*ReservationResponse, error)
+	GetPriceQuote(ctx context.Context, bikeID string, startTime, endTime time.Time) (*PriceQuote, error)
 	CancelReservation(ctx context.Context, bikeID string, id string) error
+	CompleteReservation(ctx context.Context, bikeID string, id string) error
+	RefundReservation(ctx context.Context, bikeID string, id string) error
+	GetInvoice(ctx context.Context, bikeID string, id string) (*Invoice, error)
 }
 
 // CreateReservationRequest is a request for creating new reservation.
@@ -60,6 +105,12 @@ type CreateReservationRequest struct {
 	Location  Location
 	StartTime time.Time
 	EndTime   time.Time
+
+	// PromoCode is optional.
+	PromoCode string
+
+	// RedeemPoints is a number of loyalty points customer wants to redeem. Optional.
+	RedeemPoints int
 }
 
 // Validate validates request data.
@@ -79,10 +130,17 @@ func (r *CreateReservationRequest) Validate() error {
 	if r.StartTime.Before(time.Now()) {
 		return app.NewValidationError("start time time can't be in the past")
 	}
-	if r.EndTime.Before(r.StartTime) {
+	if !r.EndTime.After(r.StartTime) {
 		return app.NewValidationError("end time have to ba after start time")
 	}
 
+	if r.RedeemPoints < 0 {
+		return app.NewValidationError("redeemed points can't be negative")
+	}
+	if r.RedeemPoints > 0 && r.Customer.ID == "" {
+		return app.NewValidationError("only existing customers can redeem loyalty points")
+	}
+
 	return nil
 }
 
@@ -95,10 +153,40 @@ type ReservationResponse struct {
 	// If status is "approved", it should be empty.
 	Reason string
 
+	// Rejection is a kind of rejection reason, set for "rejected" status.
+	Rejection ReservationRejection
+
 	// Reservation will be empty for statuses other than "approved".
 	Reservation *Reservation
 }
 
+// ReservationRejection is a kind of reservation rejection reason.
+// Unlike the reason, it has a fixed set of values, e.g. for metrics.
+type ReservationRejection string
+
+// Reservation rejections.
+const (
+	ReservationRejectionBikeNotFound         ReservationRejection = "bike_not_found"
+	ReservationRejectionBikeOutOfService     ReservationRejection = "bike_out_of_service"
+	ReservationRejectionBikeNotAvailable     ReservationRejection = "bike_not_available"
+	ReservationRejectionCustomerRisk         ReservationRejection = "customer_risk"
+	ReservationRejectionPaymentDeclined      ReservationRejection = "payment_declined"
+	ReservationRejectionDepositDeclined      ReservationRejection = "deposit_declined"
+	ReservationRejectionPromoCode            ReservationRejection = "promo_code_not_redeemable"
+	ReservationRejectionLoyaltyPoints        ReservationRejection = "loyalty_points_not_redeemable"
+	ReservationRejectionCompanySpendingLimit ReservationRejection = "company_spending_limit_reached"
+)
+
+// ReservationMetrics is a port for business metrics of reservations.
+// Methods must not block.
+type ReservationMetrics interface {
+	// ReservationApproved counts created reservation and its discount.
+	ReservationApproved(Reservation)
+
+	// ReservationRejected counts rejected reservation request.
+	ReservationRejected(ReservationRejection)
+}
+
 // ListReservationsRequest is a request for listing reservations.
 type ListReservationsRequest struct {
 	BikeID    string
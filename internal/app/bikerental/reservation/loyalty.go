@@ -0,0 +1,86 @@
+package reservation
+
+// This file contains all business rules for loyalty points.
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// newAccrualEntry returns ledger entry with points earned for completed reservation.
+// Rules:
+// - individual customers only,
+// - points are earned for each full euro of reservation total value, converted to BaseCurrency,
+// - no entry if there are no points to earn.
+func newAccrualEntry(r bikerental.Reservation, now time.Time) []bikerental.LoyaltyEntry {
+	if r.Customer.Type != bikerental.CustomerTypeIndividual {
+		return nil
+	}
+	points := int(r.ToBase(r.TotalValue).Amount/100) * bikerental.LoyaltyPointsPerEuro
+	if points <= 0 {
+		return nil
+	}
+	return []bikerental.LoyaltyEntry{{
+		ID:            uuid.NewString(),
+		CustomerID:    r.Customer.ID,
+		ReservationID: r.ID,
+		Kind:          bikerental.LoyaltyEntryKindAccrual,
+		Points:        points,
+		CreatedAt:     now,
+	}}
+}
+
+// newReversalEntries returns ledger entries reversing all points movements of canceled reservation.
+// Rules:
+// - accrued points are subtracted, even if balance becomes negative,
+// - redeemed points are given back.
+func newReversalEntries(r bikerental.Reservation, ledger []bikerental.LoyaltyEntry, now time.Time) []bikerental.LoyaltyEntry {
+	var earned, redeemed int
+	for _, e := range ledger {
+		if e.ReservationID != r.ID {
+			continue
+		}
+		if e.Kind.IsEarned() {
+			earned += e.Points
+		} else {
+			redeemed += e.Points
+		}
+	}
+
+	var result []bikerental.LoyaltyEntry
+	if earned != 0 {
+		result = append(result, bikerental.LoyaltyEntry{
+			ID:            uuid.NewString(),
+			CustomerID:    r.Customer.ID,
+			ReservationID: r.ID,
+			Kind:          bikerental.LoyaltyEntryKindReversal,
+			Points:        -earned,
+			CreatedAt:     now,
+		})
+	}
+	if redeemed != 0 {
+		result = append(result, bikerental.LoyaltyEntry{
+			ID:            uuid.NewString(),
+			CustomerID:    r.Customer.ID,
+			ReservationID: r.ID,
+			Kind:          bikerental.LoyaltyEntryKindRefund,
+			Points:        -redeemed,
+			CreatedAt:     now,
+		})
+	}
+	return result
+}
+
+// redeemablePoints returns how many of requested points can be redeemed for a reservation.
+// Rules:
+// - value of redeemed points can't exceed value left to pay, so customer never loses points.
+// Value to pay has to be in BaseCurrency.
+func redeemablePoints(requested int, valueToPay bikerental.Money) int {
+	limit := int(valueToPay.Amount / bikerental.LoyaltyPointValue)
+	if requested > limit {
+		return limit
+	}
+	return requested
+}
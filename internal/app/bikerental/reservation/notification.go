@@ -0,0 +1,49 @@
+package reservation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// newReservationNotifications returns notifications for a new reservation:
+// confirmation, reminder before the start and warning if the bike is not returned on time.
+// Reminder is skipped if reservation starts too soon.
+// Customers without email are not notified.
+func newReservationNotifications(r bikerental.Reservation, now time.Time) []bikerental.Notification {
+	if r.Customer.Email == "" {
+		return nil
+	}
+
+	result := []bikerental.Notification{
+		newNotification(bikerental.NotificationTypeReservationConfirmed, r, now, now),
+	}
+	if reminderAt := r.StartTime.Add(-bikerental.ReservationReminderLeadTime); reminderAt.After(now) {
+		result = append(result, newNotification(bikerental.NotificationTypeReservationReminder, r, reminderAt, now))
+	}
+	result = append(result, newNotification(bikerental.NotificationTypeLateReturnWarning, r, r.EndTime, now))
+	return result
+}
+
+// newCancellationNotifications returns notifications for a canceled reservation.
+func newCancellationNotifications(r bikerental.Reservation, now time.Time) []bikerental.Notification {
+	if r.Customer.Email == "" {
+		return nil
+	}
+	return []bikerental.Notification{
+		newNotification(bikerental.NotificationTypeReservationCanceled, r, now, now),
+	}
+}
+
+func newNotification(t bikerental.NotificationType, r bikerental.Reservation, sendAt, now time.Time) bikerental.Notification {
+	return bikerental.Notification{
+		ID:            uuid.NewString(),
+		Type:          t,
+		ReservationID: r.ID,
+		Recipient:     r.Customer.Email,
+		SendAt:        sendAt,
+		Status:        bikerental.NotificationStatusPending,
+		CreatedAt:     now,
+	}
+}
@@ -0,0 +1,169 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// authorizePayment authorizes total value of the reservation and sets its payment data.
+// Reservations without value don't need payment.
+func (s *Service) authorizePayment(ctx context.Context, reservation *bikerental.Reservation, customer bikerental.Customer) error {
+	if reservation.TotalValue.Amount <= 0 {
+		return nil
+	}
+
+	paymentID, err := s.paymentService.Authorize(ctx, bikerental.PaymentRequest{
+		ReservationID: reservation.ID,
+		Purpose:       bikerental.PaymentPurposeRental,
+		CustomerID:    customer.ID,
+		CustomerEmail: customer.Email,
+		Amount:        reservation.TotalValue,
+	})
+	if err != nil {
+		return fmt.Errorf("authorizing payment: %w", err)
+	}
+
+	reservation.PaymentID = paymentID
+	reservation.PaymentStatus = bikerental.PaymentStatusAuthorized
+	return nil
+}
+
+// holdDeposit authorizes security deposit for the reserved bike and sets reservation deposit data.
+// Bikes without deposit in the policy don't need it.
+// Deposit is held in reservation currency.
+func (s *Service) holdDeposit(ctx context.Context, reservation *bikerental.Reservation, customer bikerental.Customer) error {
+	amount := s.depositPolicy.For(reservation.Bike)
+	if amount.Amount <= 0 {
+		return nil
+	}
+	amount, err := bikerental.ConvertMoney(ctx, s.exchangeRates, amount, reservation.TotalValue.Currency)
+	if err != nil {
+		return fmt.Errorf("converting deposit amount: %w", err)
+	}
+
+	paymentID, err := s.paymentService.Authorize(ctx, bikerental.PaymentRequest{
+		ReservationID: reservation.ID,
+		Purpose:       bikerental.PaymentPurposeDeposit,
+		CustomerID:    customer.ID,
+		CustomerEmail: customer.Email,
+		Amount:        amount,
+	})
+	if err != nil {
+		return fmt.Errorf("authorizing deposit: %w", err)
+	}
+
+	reservation.DepositAmount = amount
+	reservation.DepositPaymentID = paymentID
+	reservation.DepositStatus = bikerental.DepositStatusHeld
+	return nil
+}
+
+// voidPayments releases payment and deposit authorizations of a reservation that wasn't stored.
+func (s *Service) voidPayments(ctx context.Context, reservation bikerental.Reservation) error {
+	if reservation.PaymentStatus == bikerental.PaymentStatusAuthorized {
+		if err := s.paymentService.Void(ctx, reservation.PaymentID); err != nil {
+			return fmt.Errorf("voiding payment: %w", err)
+		}
+	}
+	if reservation.DepositStatus == bikerental.DepositStatusHeld {
+		if err := s.paymentService.Void(ctx, reservation.DepositPaymentID); err != nil {
+			return fmt.Errorf("voiding deposit: %w", err)
+		}
+	}
+	return nil
+}
+
+// capturePayment charges the customer for completed reservation.
+// If capture fails, reservation stays with authorized payment, so it can be captured again.
+func (s *Service) capturePayment(ctx context.Context, reservation bikerental.Reservation) error {
+	if reservation.PaymentStatus != bikerental.PaymentStatusAuthorized {
+		return nil
+	}
+
+	if err := s.paymentService.Capture(ctx, reservation.PaymentID, reservation.TotalValue); err != nil {
+		return fmt.Errorf("capturing payment: %w", err)
+	}
+	if err := s.reservationsRepo.UpdatePaymentStatus(ctx, reservation.ID, bikerental.PaymentStatusCaptured); err != nil {
+		return fmt.Errorf("updating payment status in repository: %w", err)
+	}
+	return nil
+}
+
+// settleDeposit captures part of the deposit covering damage reported for completed reservation,
+// or releases the deposit if there is no damage.
+// If provider call fails, reservation stays with held deposit, so it can be settled again.
+func (s *Service) settleDeposit(ctx context.Context, reservation bikerental.Reservation) error {
+	if reservation.DepositStatus != bikerental.DepositStatusHeld {
+		return nil
+	}
+
+	reports, err := s.damageService.ListReports(ctx, bikerental.ListDamageReportsRequest{
+		BikeID:        reservation.Bike.ID,
+		ReservationID: reservation.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("fetching damage reports: %w", err)
+	}
+
+	forfeited := bikerental.ForfeitedDeposit(reservation.DepositAmount, reports)
+	status := bikerental.DepositStatusReleased
+	if forfeited.Amount > 0 {
+		if err := s.paymentService.Capture(ctx, reservation.DepositPaymentID, forfeited); err != nil {
+			return fmt.Errorf("capturing deposit: %w", err)
+		}
+		status = bikerental.DepositStatusForfeited
+	} else {
+		if err := s.paymentService.Void(ctx, reservation.DepositPaymentID); err != nil {
+			return fmt.Errorf("voiding deposit: %w", err)
+		}
+	}
+
+	if err := s.reservationsRepo.UpdateDepositStatus(ctx, reservation.ID, status, forfeited); err != nil {
+		return fmt.Errorf("updating deposit status in repository: %w", err)
+	}
+	return nil
+}
+
+// releasePayment voids authorized payment and held deposit of canceled reservation.
+// Captured payments are never refunded on cancellation, see refundPayment.
+// If provider call fails, reservation keeps its payment status, so it can be released again.
+func (s *Service) releasePayment(ctx context.Context, reservation bikerental.Reservation) error {
+	if reservation.PaymentStatus == bikerental.PaymentStatusAuthorized {
+		if err := s.paymentService.Void(ctx, reservation.PaymentID); err != nil {
+			return fmt.Errorf("voiding payment: %w", err)
+		}
+		if err := s.reservationsRepo.UpdatePaymentStatus(ctx, reservation.ID, bikerental.PaymentStatusVoided); err != nil {
+			return fmt.Errorf("updating payment status in repository: %w", err)
+		}
+	}
+
+	if reservation.DepositStatus == bikerental.DepositStatusHeld {
+		if err := s.paymentService.Void(ctx, reservation.DepositPaymentID); err != nil {
+			return fmt.Errorf("voiding deposit: %w", err)
+		}
+		if err := s.reservationsRepo.UpdateDepositStatus(ctx, reservation.ID, bikerental.DepositStatusReleased, bikerental.Money{}); err != nil {
+			return fmt.Errorf("updating deposit status in repository: %w", err)
+		}
+	}
+	return nil
+}
+
+// refundPayment returns full captured payment of the reservation to the customer.
+// Refund is idempotent in payment provider, so it can be retried if status update fails.
+func (s *Service) refundPayment(ctx context.Context, reservation bikerental.Reservation) error {
+	if err := s.paymentService.Refund(ctx, reservation.PaymentID, reservation.TotalValue); err != nil {
+		return fmt.Errorf("refunding payment: %w", err)
+	}
+	if err := s.reservationsRepo.UpdatePaymentStatus(ctx, reservation.ID, bikerental.PaymentStatusRefunded); err != nil {
+		return fmt.Errorf("updating payment status in repository: %w", err)
+	}
+	return nil
+}
+
+// isPaymentPending returns true if customer money is still held by payment provider.
+func isPaymentPending(reservation bikerental.Reservation) bool {
+	return reservation.PaymentStatus == bikerental.PaymentStatusAuthorized ||
+		reservation.DepositStatus == bikerental.DepositStatusHeld
+}
@@ -18,21 +18,55 @@ type Repository interface {
 
 	// Create creates new reservation for a bike.
 	// If any reservation for this bike exists within given time range, will return bikerental.ConflictError.
+	// If reservation has a promo code, it's redeemed in the same transaction.
+	// Returns bikerental.ErrPromoCodeNotRedeemable if code redemption limits are reached.
+	// If reservation has redeemed loyalty points, they are subtracted from customer balance in the same transaction.
+	// Returns bikerental.ErrLoyaltyPointsNotRedeemable if customer doesn't have enough points.
+	// If customer is a company member, company monthly spending limit is checked in the same transaction.
+	// Returns bikerental.ErrCompanySpendingLimitReached if the limit would be exceeded.
+	// Notifications and events are stored in outbox in the same transaction, so they are sent only if reservation is created.
 	// Returns created reservation data with filled all ids.
-	Create(context.Context, bikerental.Reservation) (*bikerental.Reservation, error)
+	Create(
+		ctx context.Context,
+		reservation bikerental.Reservation,
+		notifications []bikerental.Notification,
+		events []bikerental.Event,
+	) (*bikerental.Reservation, error)
 
-	// SetStatus updates the status of the reservation by its id.
+	// UpdateStatus changes status of the reservation, appends loyalty ledger entries
+	// and stores notifications and events in outbox in one transaction.
+	// Returns app.ConflictError if current status of the reservation is not `From` anymore.
 	// Returns app.ErrNotFound if reservation doesn't exists.
-	SetStatus(ctx context.Context, id string, status bikerental.ReservationStatus) error
+	UpdateStatus(context.Context, StatusChange) error
+
+	// UpdatePaymentStatus changes payment status of the reservation.
+	// Returns app.ErrNotFound if reservation doesn't exists.
+	UpdatePaymentStatus(ctx context.Context, id string, status bikerental.PaymentStatus) error
+
+	// UpdateDepositStatus changes security deposit status of the reservation and sets forfeited amount.
+	// Returns app.ErrNotFound if reservation doesn't exists.
+	UpdateDepositStatus(ctx context.Context, id string, status bikerental.DepositStatus, forfeited bikerental.Money) error
+}
+
+// StatusChange is a change of reservation status with its side effects, stored in one transaction.
+type StatusChange struct {
+	ReservationID string
+	From          bikerental.ReservationStatus
+	To            bikerental.ReservationStatus
+
+	Ledger        []bikerental.LoyaltyEntry
+	Notifications []bikerental.Notification
+	Events        []bikerental.Event
 }
 
 // ListReservationsQuery is a set of filters for reservations result.
 type ListReservationsQuery struct {
-	BikeID    string
-	StartTime time.Time
-	EndTime   time.Time
-	Status    bikerental.ReservationStatus
-	Limit     int
+	BikeID     string
+	CustomerID string
+	StartTime  time.Time
+	EndTime    time.Time
+	Status     bikerental.ReservationStatus
+	Limit      int
 }
 
 // CustomerRepository provides methods for reading customer data.
@@ -41,3 +75,10 @@ type CustomerRepository interface {
 	// Returns app.ErrNotFound if customer doesn't exist.
 	Get(ctx context.Context, id string) (*bikerental.Customer, error)
 }
+
+// CompanyRepository provides methods for reading company data.
+type CompanyRepository interface {
+	// Get returns company by id.
+	// Returns app.ErrNotFound if company doesn't exist.
+	Get(ctx context.Context, id string) (*bikerental.Company, error)
+}
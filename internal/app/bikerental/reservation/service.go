@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,36 +14,134 @@ import (
 // Service provides methods for making reservations.
 type Service struct {
 	discountService  bikerental.DiscountService
+	pricingService   bikerental.PricingService
+	openingHours     bikerental.OpeningHoursService
+	loyaltyService   bikerental.LoyaltyService
+	paymentService   bikerental.PaymentService
+	exchangeRates    bikerental.ExchangeRateService
+	taxService       bikerental.TaxService
+	riskService      bikerental.RiskService
+	damageService    bikerental.DamageReportService
 	bikeService      bikerental.BikeService
+	changes          bikerental.ReservationChangePublisher
+	metrics          bikerental.ReservationMetrics
 	reservationsRepo Repository
 	customersRepo    CustomerRepository
+	companiesRepo    CompanyRepository
+	bookingPolicies  bikerental.BookingPolicies
+	depositPolicy    bikerental.DepositPolicy
 }
 
-// NewService creates new service instance.
-func NewService(
-	discountService bikerental.DiscountService,
-	bikeService bikerental.BikeService,
-	reservationsRepo Repository,
-	customersRepo CustomerRepository,
-) (*Service, error) {
-	if discountService == nil {
-		return nil, errors.New("empty discount service")
+// Dependencies are services and repositories used by reservation service. All of them are required.
+type Dependencies struct {
+	DiscountService  bikerental.DiscountService
+	PricingService   bikerental.PricingService
+	OpeningHours     bikerental.OpeningHoursService
+	LoyaltyService   bikerental.LoyaltyService
+	PaymentService   bikerental.PaymentService
+	ExchangeRates    bikerental.ExchangeRateService
+	TaxService       bikerental.TaxService
+	RiskService      bikerental.RiskService
+	DamageService    bikerental.DamageReportService
+	BikeService      bikerental.BikeService
+	Changes          bikerental.ReservationChangePublisher
+	Metrics          bikerental.ReservationMetrics
+	ReservationsRepo Repository
+	CustomersRepo    CustomerRepository
+	CompaniesRepo    CompanyRepository
+}
+
+func (d Dependencies) validate() error {
+	if d.DiscountService == nil {
+		return errors.New("empty discount service")
+	}
+	if d.PricingService == nil {
+		return errors.New("empty pricing service")
+	}
+	if d.OpeningHours == nil {
+		return errors.New("empty opening hours service")
+	}
+	if d.LoyaltyService == nil {
+		return errors.New("empty loyalty service")
+	}
+	if d.PaymentService == nil {
+		return errors.New("empty payment service")
+	}
+	if d.ExchangeRates == nil {
+		return errors.New("empty exchange rates service")
+	}
+	if d.TaxService == nil {
+		return errors.New("empty tax service")
+	}
+	if d.RiskService == nil {
+		return errors.New("empty risk service")
+	}
+	if d.DamageService == nil {
+		return errors.New("empty damage report service")
+	}
+	if d.BikeService == nil {
+		return errors.New("empty bike service")
+	}
+	if d.Changes == nil {
+		return errors.New("empty reservation change publisher")
+	}
+	if d.Metrics == nil {
+		return errors.New("empty reservation metrics")
 	}
-	if bikeService == nil {
-		return nil, errors.New("empty bike service")
+	if d.ReservationsRepo == nil {
+		return errors.New("empty reservations repository")
 	}
-	if reservationsRepo == nil {
-		return nil, errors.New("empty reservations repository")
+	if d.CustomersRepo == nil {
+		return errors.New("empty customers repository")
+	}
+	if d.CompaniesRepo == nil {
+		return errors.New("empty companies repository")
+	}
+	return nil
+}
+
+// Config contains business rules of reservations.
+type Config struct {
+	BookingPolicies bikerental.BookingPolicies
+	DepositPolicy   bikerental.DepositPolicy
+}
+
+func (c Config) validate() error {
+	for _, p := range []bikerental.BookingPolicy{c.BookingPolicies.Individual, c.BookingPolicies.Business} {
+		if p.MaxDuration > bikerental.MaxPriceQuoteRange {
+			return fmt.Errorf("booking policy max duration can't be longer than %s", bikerental.MaxPriceQuoteRange)
+		}
+	}
+	return nil
+}
+
+// NewService creates new service instance.
+func NewService(deps Dependencies, conf Config) (*Service, error) {
+	if err := deps.validate(); err != nil {
+		return nil, err
 	}
-	if customersRepo == nil {
-		return nil, errors.New("empty customers repository")
+	if err := conf.validate(); err != nil {
+		return nil, err
 	}
 
 	return &Service{
-		discountService:  discountService,
-		bikeService:      bikeService,
-		reservationsRepo: reservationsRepo,
-		customersRepo:    customersRepo,
+		discountService:  deps.DiscountService,
+		pricingService:   deps.PricingService,
+		openingHours:     deps.OpeningHours,
+		loyaltyService:   deps.LoyaltyService,
+		paymentService:   deps.PaymentService,
+		exchangeRates:    deps.ExchangeRates,
+		taxService:       deps.TaxService,
+		riskService:      deps.RiskService,
+		damageService:    deps.DamageService,
+		bikeService:      deps.BikeService,
+		changes:          deps.Changes,
+		metrics:          deps.Metrics,
+		reservationsRepo: deps.ReservationsRepo,
+		customersRepo:    deps.CustomersRepo,
+		companiesRepo:    deps.CompaniesRepo,
+		bookingPolicies:  conf.BookingPolicies,
+		depositPolicy:    conf.DepositPolicy,
 	}, nil
 }
 
@@ -58,9 +155,13 @@ func (s *Service) GetBikeAvailability(ctx context.Context, bikeID string, startT
 	}
 
 	// Check if bike exists.
-	if _, err := s.bikeService.Get(ctx, bikeID); err != nil {
+	bike, err := s.bikeService.Get(ctx, bikeID)
+	if err != nil {
 		return false, fmt.Errorf("fetching bike data: %w", err)
 	}
+	if bike.OutOfService {
+		return false, nil
+	}
 
 	reservations, err := s.reservationsRepo.List(ctx, ListReservationsQuery{
 		BikeID:    bikeID,
@@ -79,15 +180,18 @@ func (s *Service) GetBikeAvailability(ctx context.Context, bikeID string, startT
 }
 
 // ListReservations returns list of reservations matching request criteria.
+// Customers get only their own reservations.
 func (s *Service) ListReservations(ctx context.Context, req bikerental.ListReservationsRequest) ([]bikerental.Reservation, error) {
 	if err := req.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid request: %w", err)
 	}
 
+	customerID, _ := bikerental.CallerCustomerID(ctx)
 	reservations, err := s.reservationsRepo.List(ctx, ListReservationsQuery{
-		BikeID:    req.BikeID,
-		StartTime: req.StartTime,
-		EndTime:   req.EndTime,
+		BikeID:     req.BikeID,
+		CustomerID: customerID,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("fetching reservations from repository: %w", err)
@@ -98,84 +202,532 @@ func (s *Service) ListReservations(ctx context.Context, req bikerental.ListReser
 // CreateReservation creates new reservation if possible.
 // If creating reservation is not possible due to business logic or availability issues, this method returns valid response.
 // If there are errors while processing request, returns nil and an error.
+//
+// Steps returning rejection response stop processing of the request, like errors.
 func (s *Service) CreateReservation(ctx context.Context, req bikerental.CreateReservationRequest) (*bikerental.ReservationResponse, error) {
+	req, err := s.checkCreateRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	bike, rejected, err := s.checkBike(ctx, req)
+	if rejected != nil || err != nil {
+		return rejected, err
+	}
+
+	customer, rejected, err := s.checkCustomer(ctx, req, *bike)
+	if rejected != nil || err != nil {
+		return rejected, err
+	}
+
+	reservation, err := s.priceReservation(ctx, req, *bike, customer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Event is created before payments are authorized, so there is nothing to void if it fails.
+	now := time.Now()
+	createdEvent, err := bikerental.NewReservationEvent(bikerental.EventTypeReservationCreated, *reservation, reservation.Status, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if rejected, err := s.authorizePayments(ctx, reservation, customer); rejected != nil || err != nil {
+		return rejected, err
+	}
+
+	return s.storeReservation(ctx, *reservation, createdEvent, now)
+}
+
+// checkCreateRequest validates the request and checks if the caller can make reservation for the customer.
+// Customers can make reservations only for themselves, so their id is set in returned request.
+func (s *Service) checkCreateRequest(ctx context.Context, req bikerental.CreateReservationRequest) (bikerental.CreateReservationRequest, error) {
+	if customerID, ok := bikerental.CallerCustomerID(ctx); ok && req.Customer.ID == "" {
+		req.Customer.ID = customerID
+	}
 	if err := req.Validate(); err != nil {
-		return nil, fmt.Errorf("invalid request: %w", err)
+		return req, fmt.Errorf("invalid request: %w", err)
+	}
+	if err := bikerental.CheckCustomerAccess(ctx, req.Customer.ID); err != nil {
+		return req, err
 	}
+	return req, nil
+}
 
+// checkBike returns the bike to reserve, or rejection if it can't be rented.
+func (s *Service) checkBike(ctx context.Context, req bikerental.CreateReservationRequest) (*bikerental.Bike, *bikerental.ReservationResponse, error) {
 	bike, err := s.fetchRealBike(ctx, req.BikeID)
 	if err != nil {
 		if app.IsNotFoundError(err) {
-			return &bikerental.ReservationResponse{
-				Status: bikerental.ReservationStatusRejected,
-				Reason: fmt.Sprintf("bike with id '%s' does not exists", req.BikeID),
-			}, nil
+			return nil, s.reject(bikerental.ReservationRejectionBikeNotFound, fmt.Sprintf("bike with id '%s' does not exists", req.BikeID)), nil
 		}
-		return nil, err
+		return nil, nil, err
+	}
+	if bike.OutOfService {
+		return nil, s.reject(bikerental.ReservationRejectionBikeOutOfService, fmt.Sprintf("bike with id '%s' is out of service", req.BikeID)), nil
+	}
+
+	if err := s.checkOpeningHours(ctx, bike.StationID, req.StartTime, req.EndTime); err != nil {
+		return nil, nil, err
 	}
+	return bike, nil, nil
+}
 
+// checkCustomer returns the customer making reservation, or rejection if the customer is too risky.
+func (s *Service) checkCustomer(
+	ctx context.Context,
+	req bikerental.CreateReservationRequest,
+	bike bikerental.Bike,
+) (bikerental.Customer, *bikerental.ReservationResponse, error) {
 	// If the customer exists, we want to have its real data.
 	customer, err := s.updateCustomerData(ctx, req.Customer)
+	if err != nil {
+		return customer, nil, err
+	}
+
+	risk, err := s.riskService.AssessCustomer(ctx, customer)
+	if err != nil {
+		return customer, nil, fmt.Errorf("assessing customer risk: %w", err)
+	}
+	if !risk.Approved {
+		return customer, s.reject(bikerental.ReservationRejectionCustomerRisk, risk.Reason), nil
+	}
+
+	// Booking limits depend on customer type, so we can check them only after we know the customer.
+	// Slots are aligned in station time zone.
+	loc, err := s.openingHours.StationLocation(ctx, bike.StationID)
+	if err != nil {
+		return customer, nil, fmt.Errorf("fetching station time zone: %w", err)
+	}
+	if err := s.bookingPolicies.For(customer.Type).Check(req.StartTime, req.EndTime, time.Now(), loc); err != nil {
+		return customer, nil, fmt.Errorf("booking policy violated: %w", err)
+	}
+	return customer, nil, nil
+}
+
+// priceReservation returns new approved reservation with value after discounts, redeemed loyalty points and tax.
+func (s *Service) priceReservation(
+	ctx context.Context,
+	req bikerental.CreateReservationRequest,
+	bike bikerental.Bike,
+	customer bikerental.Customer,
+) (*bikerental.Reservation, error) {
+	company, err := s.fetchCompany(ctx, customer)
 	if err != nil {
 		return nil, err
 	}
 
-	value := s.calculateReservationValue(*bike, req.StartTime, req.EndTime)
+	loyaltyAccount, err := s.fetchLoyaltyAccount(ctx, customer)
+	if err != nil {
+		return nil, err
+	}
+	if req.RedeemPoints > loyaltyAccount.Balance {
+		return nil, app.NewValidationError(fmt.Sprintf("not enough loyalty points, balance is %d", loyaltyAccount.Balance))
+	}
+
+	quote, err := s.pricingService.QuotePrice(ctx, bikerental.PriceQuoteRequest{
+		Bike:      bike,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("calculating reservation value: %w", err)
+	}
+	value := quote.TotalValue
+
+	// Exchange rate is stored with reservation, so its base value doesn't change with later rates.
+	exchangeRate, err := s.exchangeRates.GetRate(ctx, value.Currency, bikerental.BaseCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s exchange rate: %w", value.Currency, err)
+	}
 
 	discountResp, err := s.discountService.CalculateDiscount(ctx, bikerental.DiscountRequest{
 		Customer:         customer,
 		Location:         req.Location,
-		Bike:             *bike,
+		Bike:             bike,
 		ReservationValue: value,
+		PromoCode:        req.PromoCode,
+		LoyaltyTier:      loyaltyAccount.Tier(),
+		Company:          company,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("checking available discounts: %w", err)
 	}
 
-	// We expect repository to return bikerental.ConflictError if reservation for that bike in that time range already exists.
-	reservation, err := s.reservationsRepo.Create(ctx, bikerental.Reservation{
+	// Loyalty points have value in BaseCurrency.
+	discount := discountResp.Discount.Amount
+	valueToPay := value.Sub(discount)
+	redeemedPoints := redeemablePoints(req.RedeemPoints, valueToPay.Convert(exchangeRate, bikerental.BaseCurrency))
+	pointsValue := bikerental.NewMoney(int64(redeemedPoints*bikerental.LoyaltyPointValue), bikerental.BaseCurrency)
+	discount = discount.Add(pointsValue.Convert(1/exchangeRate, value.Currency).Min(valueToPay))
+
+	// Prices are tax-inclusive, tax is calculated after all discounts.
+	// Reverse charge customers pay only net value.
+	tax, err := s.taxService.CalculateTax(ctx, bikerental.TaxRequest{
+		Customer: customer,
+		Bike:     bike,
+		Value:    value.Sub(discount),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("calculating tax: %w", err)
+	}
+
+	return &bikerental.Reservation{
 		ID:              uuid.New().String(),
 		Status:          bikerental.ReservationStatusApproved,
-		Customer:        req.Customer,
-		Bike:            *bike,
+		Customer:        customer,
+		Bike:            bike,
 		StartTime:       req.StartTime,
 		EndTime:         req.EndTime,
-		TotalValue:      value - discountResp.Discount.Amount,
-		AppliedDiscount: discountResp.Discount.Amount,
-	})
+		TotalValue:      tax.Gross(),
+		QuotedValue:     value,
+		Tax:             *tax,
+		AppliedDiscount: discount,
+		DiscountRule:    discountRule(discountResp.Discount, redeemedPoints),
+		ExchangeRate:    exchangeRate,
+		PromoCode:       discountResp.Discount.PromoCode,
+		RedeemedPoints:  redeemedPoints,
+	}, nil
+}
+
+// authorizePayments authorizes reservation payment and security deposit.
+// Returns rejection if the provider declines any of them. Nothing stays authorized if it fails.
+func (s *Service) authorizePayments(ctx context.Context, reservation *bikerental.Reservation, customer bikerental.Customer) (*bikerental.ReservationResponse, error) {
+	if err := s.authorizePayment(ctx, reservation, customer); err != nil {
+		if errors.Is(err, bikerental.ErrPaymentDeclined) {
+			return s.reject(bikerental.ReservationRejectionPaymentDeclined, "payment authorization failed"), nil
+		}
+		return nil, err
+	}
+	if err := s.holdDeposit(ctx, reservation, customer); err != nil {
+		if voidErr := s.voidPayments(ctx, *reservation); voidErr != nil {
+			return nil, fmt.Errorf("%v; voiding payment of rejected reservation: %w", err, voidErr)
+		}
+		if errors.Is(err, bikerental.ErrPaymentDeclined) {
+			return s.reject(bikerental.ReservationRejectionDepositDeclined, "security deposit authorization failed"), nil
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// storeReservation saves authorized reservation in repository, and publishes the change.
+// Payments are voided if the reservation can't be stored.
+func (s *Service) storeReservation(
+	ctx context.Context,
+	reservation bikerental.Reservation,
+	createdEvent bikerental.Event,
+	now time.Time,
+) (*bikerental.ReservationResponse, error) {
+	// We expect repository to return bikerental.ConflictError if reservation for that bike in that time range already exists,
+	// or bikerental.ErrPromoCodeNotRedeemable if promo code redemption limits were reached in the meantime,
+	// or bikerental.ErrLoyaltyPointsNotRedeemable if points were spent in the meantime,
+	// or bikerental.ErrCompanySpendingLimitReached if company members spent too much this month.
+	created, err := s.reservationsRepo.Create(
+		ctx,
+		reservation,
+		newReservationNotifications(reservation, now),
+		[]bikerental.Event{createdEvent},
+	)
 	if err != nil {
-		if app.IsConflictError(err) {
-			return &bikerental.ReservationResponse{
-				Status: bikerental.ReservationStatusRejected,
-				Reason: "bike not available in requested time range",
-			}, nil
+		// Reservation wasn't stored, so customer can't be charged.
+		if voidErr := s.voidPayments(ctx, reservation); voidErr != nil {
+			return nil, fmt.Errorf("%v; voiding payment of rejected reservation: %w", err, voidErr)
 		}
 
+		if rejection, reason, ok := rejectionReason(err, reservation); ok {
+			return s.reject(rejection, reason), nil
+		}
 		return nil, fmt.Errorf("creating reservation in repository: %w", err)
 	}
+	s.publishChange(ctx, bikerental.EventTypeReservationCreated, *created, now)
+	s.metrics.ReservationApproved(*created)
 
 	return &bikerental.ReservationResponse{
-		Status:      reservation.Status,
-		Reservation: reservation,
+		Status:      created.Status,
+		Reservation: created,
 	}, nil
 }
 
-// CancelReservation removes reservation by id and bike id.
+// discountRule returns name of all discounts applied to the reservation.
+func discountRule(d bikerental.Discount, redeemedPoints int) string {
+	if redeemedPoints > 0 {
+		return bikerental.CombineDiscountRules(d.Rule, "loyalty points")
+	}
+	return d.Rule
+}
+
+// reject returns response rejecting reservation request, and counts the rejection.
+func (s *Service) reject(rejection bikerental.ReservationRejection, reason string) *bikerental.ReservationResponse {
+	s.metrics.ReservationRejected(rejection)
+	return &bikerental.ReservationResponse{
+		Status:    bikerental.ReservationStatusRejected,
+		Reason:    reason,
+		Rejection: rejection,
+	}
+}
+
+// rejectionReason returns rejection and its reason if repository error is caused by business rules.
+func rejectionReason(err error, reservation bikerental.Reservation) (bikerental.ReservationRejection, string, bool) {
+	switch {
+	case errors.Is(err, bikerental.ErrPromoCodeNotRedeemable):
+		return bikerental.ReservationRejectionPromoCode, fmt.Sprintf("promo code '%s' can't be redeemed anymore", reservation.PromoCode), true
+	case errors.Is(err, bikerental.ErrCompanySpendingLimitReached):
+		return bikerental.ReservationRejectionCompanySpendingLimit, "company monthly spending limit reached", true
+	case errors.Is(err, bikerental.ErrLoyaltyPointsNotRedeemable):
+		return bikerental.ReservationRejectionLoyaltyPoints, "not enough loyalty points", true
+	case app.IsConflictError(err):
+		return bikerental.ReservationRejectionBikeNotAvailable, "bike not available in requested time range", true
+	default:
+		return "", "", false
+	}
+}
+
+// GetPriceQuote returns itemized price of renting a bike in given time range.
+// Discounts are not included in the quote.
+func (s *Service) GetPriceQuote(ctx context.Context, bikeID string, startTime, endTime time.Time) (*bikerental.PriceQuote, error) {
+	if bikeID == "" {
+		return nil, app.NewValidationError("bike id can't be empty")
+	}
+
+	bike, err := s.fetchRealBike(ctx, bikeID)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := s.pricingService.QuotePrice(ctx, bikerental.PriceQuoteRequest{
+		Bike:      *bike,
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("calculating price quote: %w", err)
+	}
+	return quote, nil
+}
+
+// CancelReservation cancels approved reservation by id and bike id.
+// Loyalty points redeemed for the reservation are given back and the customer is notified.
+// Payment authorization is voided and security deposit is released.
+// Completed reservations can't be canceled, their payments are returned only with RefundReservation.
+// Customers can cancel only their own approved reservations, staff can cancel any.
 // Returns app.ErrNotFound if reservation doesn't exists.
 func (s *Service) CancelReservation(ctx context.Context, bikeID string, id string) error {
+	reservation, err := s.fetchReservation(ctx, bikeID, id)
+	if err != nil {
+		return err
+	}
+	if err := bikerental.CheckCustomerCancelAccess(ctx, *reservation); err != nil {
+		return err
+	}
+	if reservation.Status == bikerental.ReservationStatusCanceled {
+		// Payment release could have failed when reservation was canceled, we can retry it.
+		if isPaymentPending(*reservation) {
+			return s.releasePayment(ctx, *reservation)
+		}
+		return app.NewValidationError("reservation is already canceled")
+	}
+	if reservation.Status != bikerental.ReservationStatusApproved {
+		return app.NewValidationError("only approved reservations can be canceled")
+	}
+
+	var reversal []bikerental.LoyaltyEntry
+	if reservation.Customer.ID != "" {
+		ledger, err := s.loyaltyService.ListEntries(ctx, reservation.Customer.ID)
+		if err != nil {
+			return fmt.Errorf("fetching loyalty ledger: %w", err)
+		}
+		reversal = newReversalEntries(*reservation, ledger, time.Now())
+	}
+
+	// Repository returns conflict error if reservation status was changed in the meantime,
+	// so points can't be reversed twice or miss accrual from concurrent completion.
+	// For the same reason payment is released only after status is changed.
+	now := time.Now()
+	event, err := bikerental.NewReservationEvent(bikerental.EventTypeReservationCanceled, *reservation, bikerental.ReservationStatusCanceled, now)
+	if err != nil {
+		return err
+	}
+	if err := s.reservationsRepo.UpdateStatus(ctx, StatusChange{
+		ReservationID: id,
+		From:          reservation.Status,
+		To:            bikerental.ReservationStatusCanceled,
+		Ledger:        reversal,
+		Notifications: newCancellationNotifications(*reservation, now),
+		Events:        []bikerental.Event{event},
+	}); err != nil {
+		return fmt.Errorf("updating reservation status in repository: %w", err)
+	}
+	s.publishStatusChange(ctx, bikerental.EventTypeReservationCanceled, *reservation, bikerental.ReservationStatusCanceled, now)
+
+	if err := s.releasePayment(ctx, *reservation); err != nil {
+		return fmt.Errorf("releasing payment of canceled reservation: %w", err)
+	}
+	return nil
+}
+
+// RefundReservation returns captured payment of completed reservation to the customer.
+// It's an explicit staff decision, e.g. after a complaint. Loyalty points earned for the reservation are kept,
+// and security deposit is not affected, it's settled when reservation is completed.
+// Returns app.ErrNotFound if reservation doesn't exists.
+func (s *Service) RefundReservation(ctx context.Context, bikeID string, id string) error {
+	reservation, err := s.fetchReservation(ctx, bikeID, id)
+	if err != nil {
+		return err
+	}
+	if reservation.Status != bikerental.ReservationStatusCompleted {
+		return app.NewValidationError("only completed reservations can be refunded")
+	}
+	if reservation.PaymentStatus != bikerental.PaymentStatusCaptured {
+		return app.NewValidationError("only captured payments can be refunded")
+	}
+
+	if err := s.refundPayment(ctx, *reservation); err != nil {
+		return fmt.Errorf("refunding payment of completed reservation: %w", err)
+	}
+	return nil
+}
+
+// CompleteReservation marks reservation as completed, when the bike is returned.
+// Customer earns loyalty points for completed reservation and authorized payment is captured.
+// Security deposit is released, or partially captured to cover damage reported for the reservation,
+// so damage has to be reported before the bike return is confirmed.
+// Returns app.ErrNotFound if reservation doesn't exists.
+func (s *Service) CompleteReservation(ctx context.Context, bikeID string, id string) error {
+	reservation, err := s.fetchReservation(ctx, bikeID, id)
+	if err != nil {
+		return err
+	}
+	if reservation.Status == bikerental.ReservationStatusCompleted &&
+		(reservation.PaymentStatus == bikerental.PaymentStatusAuthorized || reservation.DepositStatus == bikerental.DepositStatusHeld) {
+		// Payment capture or deposit settlement could have failed when reservation was completed, we can retry it.
+		return s.settlePayments(ctx, *reservation)
+	}
+	if reservation.Status != bikerental.ReservationStatusApproved {
+		return app.NewValidationError("only approved reservations can be completed")
+	}
+
+	now := time.Now()
+	event, err := bikerental.NewReservationEvent(bikerental.EventTypeReservationCompleted, *reservation, bikerental.ReservationStatusCompleted, now)
+	if err != nil {
+		return err
+	}
+	if err := s.reservationsRepo.UpdateStatus(ctx, StatusChange{
+		ReservationID: id,
+		From:          reservation.Status,
+		To:            bikerental.ReservationStatusCompleted,
+		Ledger:        newAccrualEntry(*reservation, now),
+		Events:        []bikerental.Event{event},
+	}); err != nil {
+		return fmt.Errorf("updating reservation status in repository: %w", err)
+	}
+	s.publishStatusChange(ctx, bikerental.EventTypeReservationCompleted, *reservation, bikerental.ReservationStatusCompleted, now)
+
+	return s.settlePayments(ctx, *reservation)
+}
+
+// publishStatusChange pushes reservation with changed status to watchers.
+func (s *Service) publishStatusChange(
+	ctx context.Context,
+	t bikerental.EventType,
+	reservation bikerental.Reservation,
+	status bikerental.ReservationStatus,
+	now time.Time,
+) {
+	reservation.Status = status
+	s.publishChange(ctx, t, reservation, now)
+}
+
+func (s *Service) publishChange(ctx context.Context, t bikerental.EventType, reservation bikerental.Reservation, now time.Time) {
+	tenantID, _ := app.TenantIDFromCtx(ctx)
+	s.changes.PublishReservationChange(bikerental.ReservationChange{
+		TenantID:    tenantID,
+		Type:        t,
+		Reservation: reservation,
+		OccurredAt:  now,
+	})
+}
+
+// GetInvoice returns VAT invoice of a completed reservation.
+func (s *Service) GetInvoice(ctx context.Context, bikeID string, id string) (*bikerental.Invoice, error) {
+	reservation, err := s.fetchReservation(ctx, bikeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := bikerental.CheckCustomerAccess(ctx, reservation.Customer.ID); err != nil {
+		return nil, err
+	}
+	return bikerental.NewInvoice(*reservation)
+}
+
+func (s *Service) settlePayments(ctx context.Context, reservation bikerental.Reservation) error {
+	if err := s.capturePayment(ctx, reservation); err != nil {
+		return fmt.Errorf("capturing payment of completed reservation: %w", err)
+	}
+	if err := s.settleDeposit(ctx, reservation); err != nil {
+		return fmt.Errorf("settling deposit of completed reservation: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) fetchReservation(ctx context.Context, bikeID string, id string) (*bikerental.Reservation, error) {
 	reservation, err := s.reservationsRepo.Get(ctx, id)
 	if err != nil {
-		return fmt.Errorf("fetching reservation by id from repository: %w", err)
+		return nil, fmt.Errorf("fetching reservation by id from repository: %w", err)
 	}
 
 	// If the bike id doesn't match it's basically the same as invalid reservation id.
 	if reservation.Bike.ID != bikeID {
-		return app.ErrNotFound
+		return nil, app.ErrNotFound
 	}
+	return reservation, nil
+}
 
-	if err := s.reservationsRepo.SetStatus(ctx, id, bikerental.ReservationStatusCanceled); err != nil {
-		return fmt.Errorf("updating reservation status in repository: %w", err)
+// fetchCompany returns company of the customer, or nil if customer is not a company member.
+func (s *Service) fetchCompany(ctx context.Context, customer bikerental.Customer) (*bikerental.Company, error) {
+	if customer.CompanyID == "" {
+		return nil, nil
+	}
+
+	company, err := s.companiesRepo.Get(ctx, customer.CompanyID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching company: %w", err)
+	}
+	return company, nil
+}
+
+// fetchLoyaltyAccount returns loyalty account of the customer. New customers have empty account.
+func (s *Service) fetchLoyaltyAccount(ctx context.Context, customer bikerental.Customer) (*bikerental.LoyaltyAccount, error) {
+	if customer.ID == "" {
+		return &bikerental.LoyaltyAccount{}, nil
+	}
+
+	account, err := s.loyaltyService.GetAccount(ctx, customer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching loyalty account: %w", err)
 	}
+	return account, nil
+}
+
+// checkOpeningHours returns validation error if the bike can't be picked up or returned at its station,
+// because the station is closed at that time.
+func (s *Service) checkOpeningHours(ctx context.Context, stationID string, startTime, endTime time.Time) error {
+	open, err := s.openingHours.IsOpen(ctx, stationID, startTime)
+	if err != nil {
+		return fmt.Errorf("checking station opening hours: %w", err)
+	}
+	if !open {
+		return app.NewValidationError("start time is outside station opening hours")
+	}
+
+	open, err = s.openingHours.IsOpen(ctx, stationID, endTime)
+	if err != nil {
+		return fmt.Errorf("checking station opening hours: %w", err)
+	}
+	if !open {
+		return app.NewValidationError("end time is outside station opening hours")
+	}
+
 	return nil
 }
 
@@ -202,9 +754,3 @@ func (s *Service) updateCustomerData(ctx context.Context, customer bikerental.Cu
 	}
 	return *existingCustomer, nil
 }
-
-func (s *Service) calculateReservationValue(bike bikerental.Bike, from, to time.Time) int {
-	return int(math.Round(
-		float64(bike.PricePerHour) * to.Sub(from).Hours(),
-	))
-}
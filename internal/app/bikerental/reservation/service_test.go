@@ -0,0 +1,268 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// fakeRepository keeps reservations by id and records their status changes.
+type fakeRepository struct {
+	Repository
+	reservations map[string]bikerental.Reservation
+	changes      []StatusChange
+}
+
+func (r *fakeRepository) Get(ctx context.Context, id string) (*bikerental.Reservation, error) {
+	res, ok := r.reservations[id]
+	if !ok {
+		return nil, app.ErrNotFound
+	}
+	return &res, nil
+}
+
+func (r *fakeRepository) UpdateStatus(ctx context.Context, change StatusChange) error {
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+func (r *fakeRepository) UpdatePaymentStatus(ctx context.Context, id string, status bikerental.PaymentStatus) error {
+	res := r.reservations[id]
+	res.PaymentStatus = status
+	r.reservations[id] = res
+	return nil
+}
+
+func (r *fakeRepository) UpdateDepositStatus(ctx context.Context, id string, status bikerental.DepositStatus, forfeited bikerental.Money) error {
+	res := r.reservations[id]
+	res.DepositStatus = status
+	r.reservations[id] = res
+	return nil
+}
+
+// fakePayments records calls made to payment provider.
+type fakePayments struct {
+	bikerental.PaymentService
+	voided   []string
+	refunded []string
+}
+
+func (p *fakePayments) Void(ctx context.Context, paymentID string) error {
+	p.voided = append(p.voided, paymentID)
+	return nil
+}
+
+func (p *fakePayments) Refund(ctx context.Context, paymentID string, amount bikerental.Money) error {
+	p.refunded = append(p.refunded, paymentID)
+	return nil
+}
+
+type fakeLoyalty struct {
+	bikerental.LoyaltyService
+}
+
+func (fakeLoyalty) ListEntries(ctx context.Context, customerID string) ([]bikerental.LoyaltyEntry, error) {
+	return nil, nil
+}
+
+type noChanges struct{}
+
+func (noChanges) PublishReservationChange(bikerental.ReservationChange) {}
+
+func newTestService(t *testing.T, repo *fakeRepository, payments *fakePayments) *Service {
+	t.Helper()
+	s, err := NewService(Dependencies{
+		DiscountService:  struct{ bikerental.DiscountService }{},
+		PricingService:   struct{ bikerental.PricingService }{},
+		OpeningHours:     struct{ bikerental.OpeningHoursService }{},
+		LoyaltyService:   fakeLoyalty{},
+		PaymentService:   payments,
+		ExchangeRates:    struct{ bikerental.ExchangeRateService }{},
+		TaxService:       struct{ bikerental.TaxService }{},
+		RiskService:      struct{ bikerental.RiskService }{},
+		DamageService:    struct{ bikerental.DamageReportService }{},
+		BikeService:      struct{ bikerental.BikeService }{},
+		Changes:          noChanges{},
+		Metrics:          struct{ bikerental.ReservationMetrics }{},
+		ReservationsRepo: repo,
+		CustomersRepo:    struct{ CustomerRepository }{},
+		CompaniesRepo:    struct{ CompanyRepository }{},
+	}, Config{BookingPolicies: bikerental.BookingPolicies{
+		Individual: bikerental.BookingPolicy{MaxDuration: 7 * 24 * time.Hour},
+		Business:   bikerental.BookingPolicy{MaxDuration: 30 * 24 * time.Hour},
+	}})
+	if err != nil {
+		t.Fatalf("creating service: %v", err)
+	}
+	return s
+}
+
+func staffCtx() context.Context {
+	return app.CtxWithIdentity(context.Background(), app.Identity{Subject: "key-1", TenantID: "tenant-1", Role: app.RoleStaff})
+}
+
+func customerCtx(id string) context.Context {
+	return app.CtxWithIdentity(context.Background(), app.Identity{Subject: id, TenantID: "tenant-1", Role: app.RoleCustomer})
+}
+
+func testReservation(status bikerental.ReservationStatus, payment bikerental.PaymentStatus) bikerental.Reservation {
+	return bikerental.Reservation{
+		ID:               "res-1",
+		Status:           status,
+		Customer:         bikerental.Customer{ID: "customer-1"},
+		Bike:             bikerental.Bike{ID: "bike-1"},
+		TotalValue:       bikerental.NewMoney(10000, bikerental.BaseCurrency),
+		PaymentID:        "payment-1",
+		PaymentStatus:    payment,
+		DepositPaymentID: "deposit-1",
+	}
+}
+
+func TestServiceCancelReservation(t *testing.T) {
+	tests := []struct {
+		name        string
+		reservation bikerental.Reservation
+		wantErr     bool
+		wantVoided  []string
+		wantPayment bikerental.PaymentStatus
+	}{
+		{
+			name:        "approved reservation",
+			reservation: testReservation(bikerental.ReservationStatusApproved, bikerental.PaymentStatusAuthorized),
+			wantVoided:  []string{"payment-1"},
+			wantPayment: bikerental.PaymentStatusVoided,
+		},
+		{
+			name:        "completed reservation",
+			reservation: testReservation(bikerental.ReservationStatusCompleted, bikerental.PaymentStatusCaptured),
+			wantErr:     true,
+			wantPayment: bikerental.PaymentStatusCaptured,
+		},
+		{
+			name:        "canceled reservation with authorization left",
+			reservation: testReservation(bikerental.ReservationStatusCanceled, bikerental.PaymentStatusAuthorized),
+			wantVoided:  []string{"payment-1"},
+			wantPayment: bikerental.PaymentStatusVoided,
+		},
+		{
+			name:        "canceled reservation",
+			reservation: testReservation(bikerental.ReservationStatusCanceled, bikerental.PaymentStatusVoided),
+			wantErr:     true,
+			wantPayment: bikerental.PaymentStatusVoided,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepository{reservations: map[string]bikerental.Reservation{"res-1": tt.reservation}}
+			payments := &fakePayments{}
+			s := newTestService(t, repo, payments)
+
+			err := s.CancelReservation(staffCtx(), "bike-1", "res-1")
+			if tt.wantErr != app.IsValidationError(err) || (!tt.wantErr && err != nil) {
+				t.Fatalf("CancelReservation() error = %v, want validation error: %v", err, tt.wantErr)
+			}
+			if len(payments.refunded) != 0 {
+				t.Errorf("refunded payments = %v, want none", payments.refunded)
+			}
+			if len(payments.voided) != len(tt.wantVoided) || (len(tt.wantVoided) > 0 && payments.voided[0] != tt.wantVoided[0]) {
+				t.Errorf("voided payments = %v, want %v", payments.voided, tt.wantVoided)
+			}
+			if got := repo.reservations["res-1"].PaymentStatus; got != tt.wantPayment {
+				t.Errorf("payment status = %s, want %s", got, tt.wantPayment)
+			}
+		})
+	}
+}
+
+func TestServiceCancelReservationByCustomer(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		reservation bikerental.Reservation
+		wantErr     bool
+	}{
+		{
+			name:        "own approved reservation",
+			ctx:         customerCtx("customer-1"),
+			reservation: testReservation(bikerental.ReservationStatusApproved, bikerental.PaymentStatusAuthorized),
+		},
+		{
+			name:        "own completed reservation",
+			ctx:         customerCtx("customer-1"),
+			reservation: testReservation(bikerental.ReservationStatusCompleted, bikerental.PaymentStatusCaptured),
+			wantErr:     true,
+		},
+		{
+			name:        "approved reservation of other customer",
+			ctx:         customerCtx("customer-2"),
+			reservation: testReservation(bikerental.ReservationStatusApproved, bikerental.PaymentStatusAuthorized),
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepository{reservations: map[string]bikerental.Reservation{"res-1": tt.reservation}}
+			payments := &fakePayments{}
+			s := newTestService(t, repo, payments)
+
+			err := s.CancelReservation(tt.ctx, "bike-1", "res-1")
+			if tt.wantErr != app.IsForbiddenError(err) || (!tt.wantErr && err != nil) {
+				t.Fatalf("CancelReservation() error = %v, want forbidden error: %v", err, tt.wantErr)
+			}
+			if tt.wantErr && (len(repo.changes) != 0 || len(payments.voided) != 0 || len(payments.refunded) != 0) {
+				t.Errorf("reservation changed by forbidden call: status changes %d, voided %v, refunded %v",
+					len(repo.changes), payments.voided, payments.refunded)
+			}
+		})
+	}
+}
+
+func TestServiceRefundReservation(t *testing.T) {
+	tests := []struct {
+		name         string
+		reservation  bikerental.Reservation
+		wantErr      bool
+		wantRefunded int
+		wantPayment  bikerental.PaymentStatus
+	}{
+		{
+			name:         "completed reservation",
+			reservation:  testReservation(bikerental.ReservationStatusCompleted, bikerental.PaymentStatusCaptured),
+			wantRefunded: 1,
+			wantPayment:  bikerental.PaymentStatusRefunded,
+		},
+		{
+			name:        "already refunded reservation",
+			reservation: testReservation(bikerental.ReservationStatusCompleted, bikerental.PaymentStatusRefunded),
+			wantErr:     true,
+			wantPayment: bikerental.PaymentStatusRefunded,
+		},
+		{
+			name:        "approved reservation",
+			reservation: testReservation(bikerental.ReservationStatusApproved, bikerental.PaymentStatusAuthorized),
+			wantErr:     true,
+			wantPayment: bikerental.PaymentStatusAuthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepository{reservations: map[string]bikerental.Reservation{"res-1": tt.reservation}}
+			payments := &fakePayments{}
+			s := newTestService(t, repo, payments)
+
+			err := s.RefundReservation(staffCtx(), "bike-1", "res-1")
+			if tt.wantErr != app.IsValidationError(err) || (!tt.wantErr && err != nil) {
+				t.Fatalf("RefundReservation() error = %v, want validation error: %v", err, tt.wantErr)
+			}
+			if len(payments.refunded) != tt.wantRefunded {
+				t.Errorf("refunded payments = %v, want %d", payments.refunded, tt.wantRefunded)
+			}
+			if got := repo.reservations["res-1"].PaymentStatus; got != tt.wantPayment {
+				t.Errorf("payment status = %s, want %s", got, tt.wantPayment)
+			}
+		})
+	}
+}
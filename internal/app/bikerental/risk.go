@@ -0,0 +1,95 @@
+package bikerental
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// BlocklistEntryKind describes what a blocklist entry matches.
+type BlocklistEntryKind string
+
+// Blocklist entry kinds.
+const (
+	BlocklistEntryKindUnknown     BlocklistEntryKind = ""
+	BlocklistEntryKindCustomerID  BlocklistEntryKind = "customer_id"
+	BlocklistEntryKindEmailDomain BlocklistEntryKind = "email_domain"
+)
+
+// BlocklistEntry blocks customers from making reservations.
+type BlocklistEntry struct {
+	ID   string
+	Kind BlocklistEntryKind
+
+	// Value is a customer id or email domain, depending on entry kind.
+	Value string
+
+	// Reason is an internal note for the staff, it's not shown to the customer.
+	Reason string
+
+	CreatedAt time.Time
+}
+
+// Validate validates blocklist entry data.
+func (e BlocklistEntry) Validate() error {
+	switch e.Kind {
+	case BlocklistEntryKindCustomerID, BlocklistEntryKindEmailDomain:
+	default:
+		return app.NewValidationError("invalid blocklist entry kind")
+	}
+	if e.Value == "" {
+		return app.NewValidationError("empty value")
+	}
+	if e.Kind == BlocklistEntryKindEmailDomain && e.Value != NormalizeEmailDomain(e.Value) {
+		return app.NewValidationError("email domain has to be lower case, without '@' and surrounding whitespace")
+	}
+	return nil
+}
+
+// EmailDomain returns lower case domain part of an email address, or empty string if email has no domain.
+func EmailDomain(email string) string {
+	i := strings.LastIndex(email, "@")
+	if i < 0 {
+		return ""
+	}
+	return NormalizeEmailDomain(email[i+1:])
+}
+
+// NormalizeEmailDomain returns email domain in canonical form.
+func NormalizeEmailDomain(domain string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(domain), "@"))
+}
+
+// RiskPolicy contains limits checked before reservation is approved.
+// Zero value of a limit disables it.
+type RiskPolicy struct {
+	// MaxActiveReservations limits number of approved reservations that haven't ended yet.
+	MaxActiveReservations int
+
+	// MaxRecentNoShows limits number of no-shows within NoShowWindow.
+	// No-show is an approved reservation that has ended, but was never completed.
+	MaxRecentNoShows int
+	NoShowWindow     time.Duration
+
+	// MaxUnpaidInvoices limits number of invoiced reservations the customer hasn't paid for.
+	// Invoice is unpaid if payment of completed reservation couldn't be captured.
+	MaxUnpaidInvoices int
+}
+
+// RiskAssessment is a result of customer risk checks.
+type RiskAssessment struct {
+	Approved bool
+
+	// Reason contains reason of rejection, it's shown to the customer.
+	Reason string
+}
+
+// RiskService checks customers before reservations are approved and manages the blocklist.
+type RiskService interface {
+	AssessCustomer(ctx context.Context, customer Customer) (*RiskAssessment, error)
+	ListBlocklist(context.Context) ([]BlocklistEntry, error)
+	AddToBlocklist(context.Context, BlocklistEntry) (*BlocklistEntry, error)
+	RemoveFromBlocklist(ctx context.Context, id string) error
+}
@@ -0,0 +1,38 @@
+package risk
+
+import (
+	"context"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// BlocklistRepository provides methods for reading/writing blocklist entries.
+type BlocklistRepository interface {
+	// List returns all blocklist entries.
+	List(context.Context) ([]bikerental.BlocklistEntry, error)
+
+	// Find returns the first entry matching customer id or email domain, or nil if there is none.
+	// Empty arguments are not matched.
+	Find(ctx context.Context, customerID, emailDomain string) (*bikerental.BlocklistEntry, error)
+
+	// Create creates new blocklist entry.
+	// Returns app.ConflictError if the same entry already exists.
+	Create(context.Context, bikerental.BlocklistEntry) error
+
+	// Delete deletes blocklist entry.
+	// Returns app.ErrNotFound if entry doesn't exist.
+	Delete(ctx context.Context, id string) error
+}
+
+// ReservationRepository provides customer reservations statistics.
+type ReservationRepository interface {
+	// CountActive returns number of approved customer reservations that end after given time.
+	CountActive(ctx context.Context, customerID string, at time.Time) (int, error)
+
+	// CountNoShows returns number of approved customer reservations that ended in [from, to) time range.
+	CountNoShows(ctx context.Context, customerID string, from, to time.Time) (int, error)
+
+	// CountUnpaid returns number of completed customer reservations with payment authorized, but not captured.
+	CountUnpaid(ctx context.Context, customerID string) (int, error)
+}
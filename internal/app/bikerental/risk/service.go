@@ -0,0 +1,130 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service checks customer risk and manages the blocklist.
+type Service struct {
+	blocklist    BlocklistRepository
+	reservations ReservationRepository
+	policy       bikerental.RiskPolicy
+}
+
+// NewService creates new service instance.
+func NewService(
+	blocklist BlocklistRepository,
+	reservations ReservationRepository,
+	policy bikerental.RiskPolicy,
+) (*Service, error) {
+	if blocklist == nil {
+		return nil, errors.New("empty blocklist repository")
+	}
+	if reservations == nil {
+		return nil, errors.New("empty reservations repository")
+	}
+	return &Service{
+		blocklist:    blocklist,
+		reservations: reservations,
+		policy:       policy,
+	}, nil
+}
+
+// AssessCustomer checks if the customer can make a new reservation.
+// Existing customers are checked against the blocklist, number of active reservations, recent no-shows
+// and unpaid invoices. New customers (without id) are checked only against the email domain blocklist.
+func (s *Service) AssessCustomer(ctx context.Context, customer bikerental.Customer) (*bikerental.RiskAssessment, error) {
+	entry, err := s.blocklist.Find(ctx, customer.ID, bikerental.EmailDomain(customer.Email))
+	if err != nil {
+		return nil, fmt.Errorf("checking blocklist: %w", err)
+	}
+	if entry != nil {
+		return &bikerental.RiskAssessment{Reason: "customer is not allowed to make reservations"}, nil
+	}
+
+	if customer.ID == "" {
+		return &bikerental.RiskAssessment{Approved: true}, nil
+	}
+
+	now := time.Now()
+
+	if s.policy.MaxActiveReservations > 0 {
+		count, err := s.reservations.CountActive(ctx, customer.ID, now)
+		if err != nil {
+			return nil, fmt.Errorf("counting active reservations: %w", err)
+		}
+		if count >= s.policy.MaxActiveReservations {
+			return &bikerental.RiskAssessment{
+				Reason: fmt.Sprintf("customer can't have more than %d active reservations", s.policy.MaxActiveReservations),
+			}, nil
+		}
+	}
+
+	if s.policy.MaxRecentNoShows > 0 {
+		count, err := s.reservations.CountNoShows(ctx, customer.ID, now.Add(-s.policy.NoShowWindow), now)
+		if err != nil {
+			return nil, fmt.Errorf("counting no-shows: %w", err)
+		}
+		if count >= s.policy.MaxRecentNoShows {
+			return &bikerental.RiskAssessment{Reason: "too many recent reservations without bike pickup"}, nil
+		}
+	}
+
+	if s.policy.MaxUnpaidInvoices > 0 {
+		count, err := s.reservations.CountUnpaid(ctx, customer.ID)
+		if err != nil {
+			return nil, fmt.Errorf("counting unpaid invoices: %w", err)
+		}
+		if count >= s.policy.MaxUnpaidInvoices {
+			return &bikerental.RiskAssessment{Reason: "customer has unpaid invoices"}, nil
+		}
+	}
+
+	return &bikerental.RiskAssessment{Approved: true}, nil
+}
+
+// ListBlocklist returns all blocklist entries.
+func (s *Service) ListBlocklist(ctx context.Context) ([]bikerental.BlocklistEntry, error) {
+	entries, err := s.blocklist.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching blocklist from repository: %w", err)
+	}
+	return entries, nil
+}
+
+// AddToBlocklist creates new blocklist entry.
+// Email domains are normalized, so they can be given in any case.
+func (s *Service) AddToBlocklist(ctx context.Context, e bikerental.BlocklistEntry) (*bikerental.BlocklistEntry, error) {
+	if e.Kind == bikerental.BlocklistEntryKindEmailDomain {
+		e.Value = bikerental.NormalizeEmailDomain(e.Value)
+	}
+	if err := e.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid blocklist entry: %w", err)
+	}
+	e.ID = uuid.NewString()
+	e.CreatedAt = time.Now()
+
+	if err := s.blocklist.Create(ctx, e); err != nil {
+		return nil, fmt.Errorf("creating blocklist entry in repository: %w", err)
+	}
+	return &e, nil
+}
+
+// RemoveFromBlocklist deletes blocklist entry.
+// Returns app.ErrNotFound if entry doesn't exist.
+func (s *Service) RemoveFromBlocklist(ctx context.Context, id string) error {
+	if id == "" {
+		return app.NewValidationError("empty id")
+	}
+	if err := s.blocklist.Delete(ctx, id); err != nil {
+		return fmt.Errorf("deleting blocklist entry from repository: %w", err)
+	}
+	return nil
+}
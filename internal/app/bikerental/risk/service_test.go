@@ -0,0 +1,91 @@
+package risk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+type emptyBlocklist struct {
+	BlocklistRepository
+}
+
+func (emptyBlocklist) Find(ctx context.Context, customerID, emailDomain string) (*bikerental.BlocklistEntry, error) {
+	return nil, nil
+}
+
+// fakeReservations returns the same counts for all customers.
+type fakeReservations struct {
+	active, noShows, unpaid int
+}
+
+func (r fakeReservations) CountActive(ctx context.Context, customerID string, at time.Time) (int, error) {
+	return r.active, nil
+}
+
+func (r fakeReservations) CountNoShows(ctx context.Context, customerID string, from, to time.Time) (int, error) {
+	return r.noShows, nil
+}
+
+func (r fakeReservations) CountUnpaid(ctx context.Context, customerID string) (int, error) {
+	return r.unpaid, nil
+}
+
+func TestServiceAssessCustomer(t *testing.T) {
+	policy := bikerental.RiskPolicy{
+		MaxActiveReservations: 3,
+		MaxRecentNoShows:      2,
+		NoShowWindow:          24 * time.Hour,
+		MaxUnpaidInvoices:     1,
+	}
+	tests := []struct {
+		name         string
+		customer     bikerental.Customer
+		reservations fakeReservations
+		wantApproved bool
+	}{
+		{
+			name:         "customer without issues",
+			customer:     bikerental.Customer{ID: "customer-1"},
+			reservations: fakeReservations{active: 2, noShows: 1},
+			wantApproved: true,
+		},
+		{
+			name:         "too many active reservations",
+			customer:     bikerental.Customer{ID: "customer-1"},
+			reservations: fakeReservations{active: 3},
+		},
+		{
+			name:         "too many no-shows",
+			customer:     bikerental.Customer{ID: "customer-1"},
+			reservations: fakeReservations{noShows: 2},
+		},
+		{
+			name:         "unpaid invoice",
+			customer:     bikerental.Customer{ID: "customer-1"},
+			reservations: fakeReservations{unpaid: 1},
+		},
+		{
+			name:         "new customer",
+			reservations: fakeReservations{unpaid: 1},
+			wantApproved: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewService(emptyBlocklist{}, tt.reservations, policy)
+			if err != nil {
+				t.Fatalf("creating service: %v", err)
+			}
+			got, err := s.AssessCustomer(context.Background(), tt.customer)
+			if err != nil {
+				t.Fatalf("AssessCustomer() error = %v", err)
+			}
+			if got.Approved != tt.wantApproved {
+				t.Errorf("AssessCustomer() = %+v, want approved: %t", got, tt.wantApproved)
+			}
+		})
+	}
+}
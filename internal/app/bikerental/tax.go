@@ -0,0 +1,212 @@
+package bikerental
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// TaxTreatment describes how VAT is applied to a reservation.
+type TaxTreatment string
+
+// Tax treatments.
+const (
+	// TaxTreatmentNone is a treatment of reservations made before tax calculation was introduced.
+	TaxTreatmentNone TaxTreatment = ""
+
+	// TaxTreatmentStandard means that VAT is charged with the rate of station country.
+	TaxTreatmentStandard TaxTreatment = "standard"
+
+	// TaxTreatmentReverseCharge means that VAT is not charged, business customer from other EU country accounts for it.
+	TaxTreatmentReverseCharge TaxTreatment = "reverse_charge"
+)
+
+// ReverseChargeNote is a legal note printed on invoices with reverse charge.
+const ReverseChargeNote = "Reverse charge: VAT to be accounted for by the recipient (Art. 196 Council Directive 2006/112/EC)."
+
+// euCountries are VAT country codes of EU member states. Greece uses "EL" in VAT numbers.
+var euCountries = map[string]bool{
+	"AT": true, "BE": true, "BG": true, "CY": true, "CZ": true, "DE": true, "DK": true, "EE": true, "EL": true,
+	"ES": true, "FI": true, "FR": true, "GR": true, "HR": true, "HU": true, "IE": true, "IT": true, "LT": true,
+	"LU": true, "LV": true, "MT": true, "NL": true, "PL": true, "PT": true, "RO": true, "SE": true, "SI": true,
+	"SK": true,
+}
+
+// IsEUCountry returns true if the country code is a code of EU member state.
+func IsEUCountry(country string) bool {
+	return euCountries[country]
+}
+
+var vatIDFormat = regexp.MustCompile(`^[A-Z]{2}[0-9A-Z]{2,13}$`)
+
+// ValidateVATID validates format of VAT identification number, e.g. "PL1234567890".
+// Number is not checked in VIES, only its format.
+func ValidateVATID(id string) error {
+	if !vatIDFormat.MatchString(id) {
+		return app.NewValidationError(fmt.Sprintf("invalid VAT id: '%s'", id))
+	}
+	return nil
+}
+
+// VATIDCountry returns country code prefix of VAT identification number.
+func VATIDCountry(id string) string {
+	if len(id) < 2 {
+		return ""
+	}
+	return id[:2]
+}
+
+// TaxRegion is a set of VAT rates of a country where bikes are rented.
+type TaxRegion struct {
+	// Country is ISO 3166-1 alpha-2 country code.
+	Country string
+
+	// Rate is a standard VAT rate in percent.
+	Rate float64
+
+	// CustomerTypeRates override standard rate for some types of customers.
+	CustomerTypeRates map[CustomerType]float64
+}
+
+// RateFor returns VAT rate in percent for a customer type.
+func (r TaxRegion) RateFor(t CustomerType) float64 {
+	if rate, ok := r.CustomerTypeRates[t]; ok {
+		return rate
+	}
+	return r.Rate
+}
+
+// ReservationTax is a split of reservation value to net and tax amounts.
+// Values are in reservation currency and they're computed after discounts.
+type ReservationTax struct {
+	Country   string
+	Treatment TaxTreatment
+
+	// Rate is a VAT rate in percent. It's zero for reverse charge.
+	Rate float64
+
+	Net Money
+	Tax Money
+}
+
+// Gross returns net value with tax.
+func (t ReservationTax) Gross() Money {
+	return t.Net.Add(t.Tax)
+}
+
+// TaxService calculates taxes.
+type TaxService interface {
+	// CalculateTax splits tax-inclusive reservation value to net and tax amounts.
+	CalculateTax(context.Context, TaxRequest) (*ReservationTax, error)
+}
+
+// TaxRequest is a request for calculating tax of a reservation.
+type TaxRequest struct {
+	Customer Customer
+	Bike     Bike
+
+	// Value is a tax-inclusive value after discounts.
+	Value Money
+}
+
+// Validate validates request data.
+func (r TaxRequest) Validate() error {
+	if err := r.Value.Validate(); err != nil {
+		return fmt.Errorf("invalid value: %w", err)
+	}
+	if r.Value.Currency == "" {
+		return app.NewValidationError("empty value currency")
+	}
+	return nil
+}
+
+// Invoice is a VAT invoice of a reservation.
+type Invoice struct {
+	// Number is a number of the invoice, equal to reservation id.
+	Number        string
+	ReservationID string
+	IssuedAt      time.Time
+
+	Customer Customer
+
+	// Country is a country where the tax is due.
+	Country   string
+	Treatment TaxTreatment
+	Lines     []InvoiceLine
+	TaxLines  []InvoiceTaxLine
+
+	Net   Money
+	Tax   Money
+	Gross Money
+
+	// Note contains legal notes, e.g. about reverse charge.
+	Note string
+}
+
+// InvoiceLine is an invoiced service with its tax.
+type InvoiceLine struct {
+	Description string
+
+	// Discount is a tax-inclusive discount already subtracted from the line values.
+	Discount Money
+
+	TaxRate float64
+	Net     Money
+	Tax     Money
+	Gross   Money
+}
+
+// InvoiceTaxLine is a sum of invoice lines with the same tax rate.
+type InvoiceTaxLine struct {
+	Rate float64
+	Net  Money
+	Tax  Money
+}
+
+// NewInvoice creates invoice of a completed reservation.
+// Returns app.ConflictError if reservation can't be invoiced.
+func NewInvoice(r Reservation) (*Invoice, error) {
+	if r.Status != ReservationStatusCompleted {
+		return nil, app.NewConflictError("only completed reservations can be invoiced")
+	}
+	if r.Tax.Treatment == TaxTreatmentNone {
+		return nil, app.NewConflictError("reservation has no tax data")
+	}
+
+	line := InvoiceLine{
+		Description: fmt.Sprintf(
+			"Bike rental: %s, %s - %s",
+			r.Bike.ModelName, r.StartTime.UTC().Format(time.RFC3339), r.EndTime.UTC().Format(time.RFC3339),
+		),
+		Discount: r.AppliedDiscount,
+		TaxRate:  r.Tax.Rate,
+		Net:      r.Tax.Net,
+		Tax:      r.Tax.Tax,
+		Gross:    r.Tax.Gross(),
+	}
+
+	invoice := &Invoice{
+		Number:        r.ID,
+		ReservationID: r.ID,
+		IssuedAt:      r.EndTime,
+		Customer:      r.Customer,
+		Country:       r.Tax.Country,
+		Treatment:     r.Tax.Treatment,
+		Lines:         []InvoiceLine{line},
+		TaxLines: []InvoiceTaxLine{{
+			Rate: line.TaxRate,
+			Net:  line.Net,
+			Tax:  line.Tax,
+		}},
+		Net:   line.Net,
+		Tax:   line.Tax,
+		Gross: line.Gross,
+	}
+	if r.Tax.Treatment == TaxTreatmentReverseCharge {
+		invoice.Note = ReverseChargeNote
+	}
+	return invoice, nil
+}
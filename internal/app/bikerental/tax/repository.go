@@ -0,0 +1,14 @@
+package tax
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// RegionRepository provides tax regions of stations.
+type RegionRepository interface {
+	// GetTaxRegion returns tax region of a station. Empty station id means default region.
+	// Returns app.ErrNotFound if there is no region for the station.
+	GetTaxRegion(ctx context.Context, stationID string) (*bikerental.TaxRegion, error)
+}
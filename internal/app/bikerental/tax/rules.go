@@ -0,0 +1,49 @@
+package tax
+
+// This file contains all business rules for calculating taxes.
+
+import (
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// calculateTax splits tax-inclusive value to net and tax amounts.
+// Rules:
+// - prices are tax-inclusive, net value is rounded to minor units with math.Round and tax is the rest,
+// - rate depends on region and customer type,
+// - business customers with VAT id from other EU country than the region are charged net value only (reverse charge).
+func calculateTax(value bikerental.Money, region bikerental.TaxRegion, customer bikerental.Customer) bikerental.ReservationTax {
+	rate := region.RateFor(customer.Type)
+	net := value.Mul(1 / (1 + rate/100.0))
+
+	if isReverseCharge(region, customer) {
+		return bikerental.ReservationTax{
+			Country:   region.Country,
+			Treatment: bikerental.TaxTreatmentReverseCharge,
+			Net:       net,
+			Tax:       bikerental.NewMoney(0, value.Currency),
+		}
+	}
+
+	return bikerental.ReservationTax{
+		Country:   region.Country,
+		Treatment: bikerental.TaxTreatmentStandard,
+		Rate:      rate,
+		Net:       net,
+		Tax:       value.Sub(net),
+	}
+}
+
+func isReverseCharge(region bikerental.TaxRegion, customer bikerental.Customer) bool {
+	if customer.Type != bikerental.CustomerTypeBusiness || customer.VATID == "" {
+		return false
+	}
+	customerCountry := bikerental.VATIDCountry(customer.VATID)
+	regionCountry := region.Country
+	// Greece uses different code in VAT ids.
+	if regionCountry == "GR" {
+		regionCountry = "EL"
+	}
+	return bikerental.IsEUCountry(regionCountry) &&
+		bikerental.IsEUCountry(customerCountry) &&
+		customerCountry != regionCountry
+}
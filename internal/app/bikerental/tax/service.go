@@ -0,0 +1,60 @@
+package tax
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service calculates VAT of reservations.
+type Service struct {
+	regions RegionRepository
+}
+
+// NewService creates new service instance.
+func NewService(regions RegionRepository) (*Service, error) {
+	if regions == nil {
+		return nil, errors.New("empty tax regions repository")
+	}
+	return &Service{
+		regions: regions,
+	}, nil
+}
+
+// CalculateTax splits tax-inclusive reservation value to net and tax amounts.
+// Tax region is determined by the station of the bike, falling back to default region.
+func (s *Service) CalculateTax(ctx context.Context, req bikerental.TaxRequest) (*bikerental.ReservationTax, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	region, err := s.region(ctx, req.Bike.StationID)
+	if err != nil {
+		return nil, err
+	}
+
+	tax := calculateTax(req.Value, *region, req.Customer)
+	return &tax, nil
+}
+
+// region returns tax region of a station, falling back to default region.
+func (s *Service) region(ctx context.Context, stationID string) (*bikerental.TaxRegion, error) {
+	if stationID != "" {
+		region, err := s.regions.GetTaxRegion(ctx, stationID)
+		if err == nil {
+			return region, nil
+		}
+		if !app.IsNotFoundError(err) {
+			return nil, fmt.Errorf("fetching station tax region from repository: %w", err)
+		}
+	}
+
+	region, err := s.regions.GetTaxRegion(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("fetching default tax region from repository: %w", err)
+	}
+	return region, nil
+}
@@ -0,0 +1,80 @@
+package bikerental
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrWatchInterrupted is returned when watch ends before the client stopped it, so changes could have been missed.
+// Clients should reload data and start watching again.
+var ErrWatchInterrupted = errors.New("watch interrupted, changes could have been missed")
+
+// ReservationChange is a reservation change pushed to watchers.
+// Type is one of reservation event types.
+type ReservationChange struct {
+	// TenantID is a tenant of the reservation, changes are sent only to watchers of the same tenant.
+	TenantID string
+
+	Type EventType
+
+	// Reservation is a state after the change.
+	Reservation Reservation
+	OccurredAt  time.Time
+}
+
+// BikeAvailabilityChange is a change of bike availability in a time range.
+type BikeAvailabilityChange struct {
+	BikeID    string
+	StationID string
+	StartTime time.Time
+	EndTime   time.Time
+
+	// Available is false if the time range was reserved, true if it was released.
+	Available  bool
+	OccurredAt time.Time
+}
+
+// NewBikeAvailabilityChange returns availability change caused by reservation change.
+// Only approved reservations make the bike unavailable.
+func NewBikeAvailabilityChange(c ReservationChange) BikeAvailabilityChange {
+	return BikeAvailabilityChange{
+		BikeID:     c.Reservation.Bike.ID,
+		StationID:  c.Reservation.Bike.StationID,
+		StartTime:  c.Reservation.StartTime,
+		EndTime:    c.Reservation.EndTime,
+		Available:  c.Reservation.Status != ReservationStatusApproved,
+		OccurredAt: c.OccurredAt,
+	}
+}
+
+// WatchFilter selects changes of a bike or a station. Empty filter matches all changes.
+type WatchFilter struct {
+	BikeID    string
+	StationID string
+}
+
+// Matches returns true if the bike matches the filter.
+func (f WatchFilter) Matches(b Bike) bool {
+	if f.BikeID != "" && f.BikeID != b.ID {
+		return false
+	}
+	if f.StationID != "" && f.StationID != b.StationID {
+		return false
+	}
+	return true
+}
+
+// ReservationChangePublisher is a port for pushing reservation changes to watchers.
+// Publishing must not block, changes are sent after they are stored.
+type ReservationChangePublisher interface {
+	PublishReservationChange(ReservationChange)
+}
+
+// WatchService streams live changes of the tenant from context.
+// Methods call send for each change until context is canceled, and return nil then.
+// They return send error, or ErrWatchInterrupted if changes could have been missed.
+type WatchService interface {
+	WatchReservations(ctx context.Context, filter WatchFilter, send func(ReservationChange) error) error
+	WatchBikeAvailability(ctx context.Context, filter WatchFilter, send func(BikeAvailabilityChange) error) error
+}
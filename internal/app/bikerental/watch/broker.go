@@ -0,0 +1,10 @@
+package watch
+
+import "github.com/nglogic/go-application-guide/internal/app/bikerental"
+
+// Broker delivers published reservation changes to subscribers.
+type Broker interface {
+	// Subscribe returns channel of reservation changes published from now on, and function canceling the subscription.
+	// Channel is closed when subscription is canceled, subscriber can't keep up with changes or broker is closed.
+	Subscribe() (<-chan bikerental.ReservationChange, func())
+}
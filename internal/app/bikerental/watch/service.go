@@ -0,0 +1,84 @@
+package watch
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service streams live reservation and availability changes.
+type Service struct {
+	broker Broker
+}
+
+// NewService creates new service instance.
+func NewService(broker Broker) (*Service, error) {
+	if broker == nil {
+		return nil, errors.New("empty broker")
+	}
+	return &Service{
+		broker: broker,
+	}, nil
+}
+
+// WatchReservations calls send for every reservation change matching the filter, until context is canceled.
+func (s *Service) WatchReservations(
+	ctx context.Context,
+	filter bikerental.WatchFilter,
+	send func(bikerental.ReservationChange) error,
+) error {
+	return s.watch(ctx, func(c bikerental.ReservationChange) error {
+		if !filter.Matches(c.Reservation.Bike) {
+			return nil
+		}
+		return send(c)
+	})
+}
+
+// WatchBikeAvailability calls send for every availability change matching the filter, until context is canceled.
+func (s *Service) WatchBikeAvailability(
+	ctx context.Context,
+	filter bikerental.WatchFilter,
+	send func(bikerental.BikeAvailabilityChange) error,
+) error {
+	return s.watch(ctx, func(c bikerental.ReservationChange) error {
+		if !filter.Matches(c.Reservation.Bike) {
+			return nil
+		}
+		return send(bikerental.NewBikeAvailabilityChange(c))
+	})
+}
+
+// watch calls handle for changes of the tenant from context.
+func (s *Service) watch(ctx context.Context, handle func(bikerental.ReservationChange) error) error {
+	tenantID, ok := app.TenantIDFromCtx(ctx)
+	if !ok {
+		return app.NewValidationError("unknown tenant")
+	}
+
+	changes, cancel := s.broker.Subscribe()
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case c, ok := <-changes:
+			if !ok {
+				return bikerental.ErrWatchInterrupted
+			}
+			if c.TenantID != tenantID {
+				continue
+			}
+			if err := handle(c); err != nil {
+				if ctx.Err() != nil {
+					// Sending fails when client goes away, it's not an error.
+					return nil
+				}
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package bikerental
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// MinWebhookSecretLength is a minimal length of secret used for signing webhook payloads.
+const MinWebhookSecretLength = 16
+
+// knownEventTypes are event types that can be used in webhook filters.
+var knownEventTypes = map[EventType]bool{
+	EventTypeBikeCreated:          true,
+	EventTypeBikeUpdated:          true,
+	EventTypeBikeDeleted:          true,
+	EventTypeReservationCreated:   true,
+	EventTypeReservationCanceled:  true,
+	EventTypeReservationCompleted: true,
+}
+
+// WebhookSubscription is a subscription of external system to domain events.
+type WebhookSubscription struct {
+	ID  string
+	URL string
+
+	// EventTypes filter events sent to the subscriber. Empty filter means all events.
+	EventTypes []EventType
+
+	// Secret is used for signing payloads with HMAC-SHA256, so subscriber can verify them.
+	Secret string
+
+	CreatedAt time.Time
+}
+
+// Validate validates subscription data.
+// Webhook url has to be https url of a public host. Insecure urls, with http scheme or private network host,
+// can be allowed for development.
+// Host names are resolved only when events are sent, so senders have to check resolved addresses too.
+func (s WebhookSubscription) Validate(allowInsecureURL bool) error {
+	u, err := url.Parse(s.URL)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return app.NewValidationError("webhook url has to be an absolute http or https url")
+	}
+	if !allowInsecureURL {
+		if u.Scheme != "https" {
+			return app.NewValidationError("webhook url has to be an absolute https url")
+		}
+		if isPrivateHost(u.Hostname()) {
+			return app.NewValidationError("webhook url can't point to a private network host")
+		}
+	}
+	for _, t := range s.EventTypes {
+		if !knownEventTypes[t] {
+			return app.NewValidationError(fmt.Sprintf("unknown event type: '%s'", t))
+		}
+	}
+	if len(s.Secret) < MinWebhookSecretLength {
+		return app.NewValidationError(fmt.Sprintf("secret has to have at least %d characters", MinWebhookSecretLength))
+	}
+	return nil
+}
+
+// isPrivateHost returns true for localhost and ip addresses that are not public.
+func isPrivateHost(host string) bool {
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() || ip.IsMulticast()
+}
+
+// Matches returns true if the event passes subscription filters.
+func (s WebhookSubscription) Matches(t EventType) bool {
+	if len(s.EventTypes) == 0 {
+		return true
+	}
+	for _, st := range s.EventTypes {
+		if st == t {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus describes state of event delivery to a subscriber.
+type WebhookDeliveryStatus string
+
+// Webhook delivery statuses.
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+
+	// WebhookDeliveryStatusDead is a status of deliveries that failed after all retries.
+	// They can be redelivered manually.
+	WebhookDeliveryStatusDead WebhookDeliveryStatus = "dead"
+)
+
+// WebhookDelivery is a delivery of one event to one subscription.
+type WebhookDelivery struct {
+	ID             string
+	SubscriptionID string
+	Event          Event
+	Status         WebhookDeliveryStatus
+
+	// Attempts is a number of failed attempts since the delivery was created or redelivered.
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	DeliveredAt   time.Time
+
+	// Log contains all delivery attempts, from the oldest. It's read only.
+	Log []WebhookAttempt
+}
+
+// WebhookAttempt is an entry of delivery log.
+type WebhookAttempt struct {
+	AttemptedAt time.Time
+
+	// StatusCode is a http status of subscriber response, 0 if there was no response.
+	StatusCode int
+	Error      string
+	Duration   time.Duration
+}
+
+// WebhookRequest is a request for sending an event to a subscriber.
+type WebhookRequest struct {
+	URL    string
+	Secret string
+	Event  Event
+}
+
+// WebhookSender is a port for sending signed events to subscribers.
+type WebhookSender interface {
+	// Send sends the event and returns http status of the response.
+	// Non 2xx statuses are returned as errors together with the status.
+	Send(context.Context, WebhookRequest) (int, error)
+}
+
+// ListWebhookDeliveriesRequest is a request for delivery log of a subscription.
+type ListWebhookDeliveriesRequest struct {
+	SubscriptionID string
+
+	// Status is optional, empty status means all deliveries.
+	Status WebhookDeliveryStatus
+	Limit  int
+}
+
+// WebhookService manages webhook subscriptions and deliveries.
+type WebhookService interface {
+	ListWebhookSubscriptions(context.Context) ([]WebhookSubscription, error)
+
+	// CreateWebhookSubscription creates subscription. Secret is generated if it's empty.
+	CreateWebhookSubscription(context.Context, WebhookSubscription) (*WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, id string) error
+	ListWebhookDeliveries(context.Context, ListWebhookDeliveriesRequest) ([]WebhookDelivery, error)
+
+	// RedeliverWebhook schedules delivery to be sent again immediately, regardless of its status.
+	RedeliverWebhook(ctx context.Context, subscriptionID, deliveryID string) (*WebhookDelivery, error)
+}
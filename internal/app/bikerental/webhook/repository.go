@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Repository manages webhook subscriptions and deliveries of the tenant from context.
+// Only ClaimDue returns deliveries of all tenants.
+type Repository interface {
+	ListSubscriptions(context.Context) ([]bikerental.WebhookSubscription, error)
+
+	// GetSubscription returns subscription by id.
+	// Returns app.ErrNotFound if subscription doesn't exist.
+	GetSubscription(ctx context.Context, id string) (*bikerental.WebhookSubscription, error)
+
+	CreateSubscription(context.Context, bikerental.WebhookSubscription) error
+
+	// DeleteSubscription deletes subscription with all its deliveries.
+	// Returns app.ErrNotFound if subscription doesn't exist.
+	DeleteSubscription(ctx context.Context, id string) error
+
+	// CreateDeliveries stores new deliveries.
+	// Deliveries of an event already stored for a subscription are ignored, so events published again are not duplicated.
+	CreateDeliveries(context.Context, []bikerental.WebhookDelivery) error
+
+	// GetDelivery returns delivery by id, with its log.
+	// Returns app.ErrNotFound if delivery doesn't exist.
+	GetDelivery(ctx context.Context, id string) (*bikerental.WebhookDelivery, error)
+
+	// ListDeliveries returns deliveries with their logs, from the newest.
+	ListDeliveries(context.Context, bikerental.ListWebhookDeliveriesRequest) ([]bikerental.WebhookDelivery, error)
+
+	// ClaimDue returns pending deliveries of all tenants due at given time, from the oldest,
+	// and locks them for lease duration, so concurrent workers don't send them twice.
+	// Logs of claimed deliveries are not loaded. Deliveries not updated before the lease expires are returned again.
+	// Tenant of a delivery is set in its event.
+	ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]bikerental.WebhookDelivery, error)
+
+	// RecordAttempt stores delivery status and appends the attempt to its log in one transaction.
+	// Delivery lock is released.
+	RecordAttempt(ctx context.Context, d bikerental.WebhookDelivery, attempt bikerental.WebhookAttempt) error
+
+	// Reschedule stores delivery status and schedule, without log entry. Delivery lock is released.
+	// Returns app.ErrNotFound if delivery doesn't exist.
+	Reschedule(context.Context, bikerental.WebhookDelivery) error
+}
@@ -0,0 +1,260 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+const (
+	// batchSize is a max number of deliveries sent in one run.
+	batchSize = 50
+
+	// claimLease is how long claimed deliveries are locked for other workers.
+	// It has to be longer than sending the whole batch.
+	claimLease = 10 * time.Minute
+
+	// maxAttempts is a number of failed attempts after which delivery is dead.
+	// With retryDelay doubled after each attempt, subscriber has about 4 hours to recover.
+	maxAttempts = 8
+
+	// retryDelay is a delay after first failed attempt. It's doubled after each next attempt.
+	retryDelay = 2 * time.Minute
+
+	// defaultDeliveriesLimit is a number of deliveries listed if limit is not given.
+	defaultDeliveriesLimit = 50
+)
+
+// Service manages webhook subscriptions and delivers events to subscribers.
+// It's an event publisher: published events are stored as deliveries for matching subscriptions
+// and sent by ProcessDeliveries.
+type Service struct {
+	sender     bikerental.WebhookSender
+	repository Repository
+
+	// allowInsecureURLs allows http urls and private network hosts of subscribers, for development.
+	allowInsecureURLs bool
+}
+
+// NewService creates new service instance.
+// Insecure urls should be allowed only in development, see bikerental.WebhookSubscription.Validate.
+func NewService(sender bikerental.WebhookSender, repository Repository, allowInsecureURLs bool) (*Service, error) {
+	if sender == nil {
+		return nil, errors.New("empty webhook sender")
+	}
+	if repository == nil {
+		return nil, errors.New("empty webhook repository")
+	}
+	return &Service{
+		sender:            sender,
+		repository:        repository,
+		allowInsecureURLs: allowInsecureURLs,
+	}, nil
+}
+
+// ListWebhookSubscriptions returns all subscriptions. Secrets are not returned.
+func (s *Service) ListWebhookSubscriptions(ctx context.Context) ([]bikerental.WebhookSubscription, error) {
+	subs, err := s.repository.ListSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching subscriptions from repository: %w", err)
+	}
+	for i := range subs {
+		subs[i].Secret = ""
+	}
+	return subs, nil
+}
+
+// CreateWebhookSubscription creates subscription. Secret is generated if it's empty.
+// Returned subscription contains the secret, it's not returned later.
+func (s *Service) CreateWebhookSubscription(ctx context.Context, sub bikerental.WebhookSubscription) (*bikerental.WebhookSubscription, error) {
+	if sub.ID != "" {
+		return nil, app.NewValidationError("can't create subscription with not empty id")
+	}
+	if sub.Secret == "" {
+		secret, err := newSecret()
+		if err != nil {
+			return nil, err
+		}
+		sub.Secret = secret
+	}
+	if err := sub.Validate(s.allowInsecureURLs); err != nil {
+		return nil, fmt.Errorf("invalid subscription: %w", err)
+	}
+
+	sub.ID = uuid.NewString()
+	sub.CreatedAt = time.Now()
+	if err := s.repository.CreateSubscription(ctx, sub); err != nil {
+		return nil, fmt.Errorf("adding subscription to repository: %w", err)
+	}
+	return &sub, nil
+}
+
+// DeleteWebhookSubscription deletes subscription with its deliveries.
+func (s *Service) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	if id == "" {
+		return app.NewValidationError("empty id")
+	}
+	if err := s.repository.DeleteSubscription(ctx, id); err != nil {
+		return fmt.Errorf("deleting subscription in repository: %w", err)
+	}
+	return nil
+}
+
+// ListWebhookDeliveries returns delivery log of a subscription, from the newest deliveries.
+func (s *Service) ListWebhookDeliveries(ctx context.Context, req bikerental.ListWebhookDeliveriesRequest) ([]bikerental.WebhookDelivery, error) {
+	if req.SubscriptionID == "" {
+		return nil, app.NewValidationError("empty subscription id")
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultDeliveriesLimit
+	}
+	ds, err := s.repository.ListDeliveries(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching deliveries from repository: %w", err)
+	}
+	return ds, nil
+}
+
+// RedeliverWebhook schedules delivery to be sent again immediately, regardless of its status.
+// Attempts are reset, so redelivered delivery is retried like a new one.
+func (s *Service) RedeliverWebhook(ctx context.Context, subscriptionID, deliveryID string) (*bikerental.WebhookDelivery, error) {
+	d, err := s.repository.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching delivery from repository: %w", err)
+	}
+	if d.SubscriptionID != subscriptionID {
+		return nil, app.ErrNotFound
+	}
+
+	d.Status = bikerental.WebhookDeliveryStatusPending
+	d.Attempts = 0
+	d.NextAttemptAt = time.Now()
+	if err := s.repository.Reschedule(ctx, *d); err != nil {
+		return nil, fmt.Errorf("rescheduling delivery in repository: %w", err)
+	}
+	return d, nil
+}
+
+// Publish creates deliveries of the event for all matching subscriptions of the event tenant.
+func (s *Service) Publish(ctx context.Context, e bikerental.Event) error {
+	if e.TenantID == "" {
+		return errors.New("event without tenant")
+	}
+	// Events are delivered only to subscribers of the same tenant.
+	ctx = app.CtxWithTenantID(ctx, e.TenantID)
+
+	subs, err := s.repository.ListSubscriptions(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching subscriptions from repository: %w", err)
+	}
+
+	now := time.Now()
+	var deliveries []bikerental.WebhookDelivery
+	for _, sub := range subs {
+		if !sub.Matches(e.Type) {
+			continue
+		}
+		deliveries = append(deliveries, bikerental.WebhookDelivery{
+			ID:             uuid.NewString(),
+			SubscriptionID: sub.ID,
+			Event:          e,
+			Status:         bikerental.WebhookDeliveryStatusPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+		})
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err := s.repository.CreateDeliveries(ctx, deliveries); err != nil {
+		return fmt.Errorf("adding deliveries to repository: %w", err)
+	}
+	return nil
+}
+
+// ProcessDeliveries sends due deliveries and returns number of processed ones.
+// Failed deliveries are retried with exponential backoff, after maxAttempts they are dead.
+// Returned error means that deliveries couldn't be updated, delivery errors are stored in delivery log.
+func (s *Service) ProcessDeliveries(ctx context.Context) (int, error) {
+	deliveries, err := s.repository.ClaimDue(ctx, time.Now(), batchSize, claimLease)
+	if err != nil {
+		return 0, fmt.Errorf("claiming due deliveries: %w", err)
+	}
+
+	subs := make(map[string]*bikerental.WebhookSubscription)
+	for i, d := range deliveries {
+		// Worker processes deliveries of all tenants.
+		ctx := app.CtxWithTenantID(ctx, d.Event.TenantID)
+		sub, ok := subs[d.SubscriptionID]
+		if !ok {
+			sub, err = s.repository.GetSubscription(ctx, d.SubscriptionID)
+			if err != nil && !app.IsNotFoundError(err) {
+				return i, fmt.Errorf("fetching subscription %s: %w", d.SubscriptionID, err)
+			}
+			subs[d.SubscriptionID] = sub
+		}
+		if sub == nil {
+			// Subscription was deleted with its deliveries in the meantime.
+			continue
+		}
+
+		d, attempt := s.deliver(ctx, *sub, d)
+		if err := s.repository.RecordAttempt(ctx, d, attempt); err != nil {
+			return i, fmt.Errorf("recording attempt of delivery %s: %w", d.ID, err)
+		}
+	}
+	return len(deliveries), nil
+}
+
+// deliver sends the event and returns delivery with updated status and the attempt for delivery log.
+func (s *Service) deliver(
+	ctx context.Context,
+	sub bikerental.WebhookSubscription,
+	d bikerental.WebhookDelivery,
+) (bikerental.WebhookDelivery, bikerental.WebhookAttempt) {
+	start := time.Now()
+	code, err := s.sender.Send(ctx, bikerental.WebhookRequest{
+		URL:    sub.URL,
+		Secret: sub.Secret,
+		Event:  d.Event,
+	})
+	attempt := bikerental.WebhookAttempt{
+		AttemptedAt: start,
+		StatusCode:  code,
+		Duration:    time.Since(start),
+	}
+
+	if err == nil {
+		d.Status = bikerental.WebhookDeliveryStatusDelivered
+		d.DeliveredAt = time.Now()
+		d.LastError = ""
+		return d, attempt
+	}
+
+	attempt.Error = err.Error()
+	d.Attempts++
+	d.LastError = err.Error()
+	if d.Attempts >= maxAttempts {
+		d.Status = bikerental.WebhookDeliveryStatusDead
+	} else {
+		d.NextAttemptAt = start.Add(retryDelay << (d.Attempts - 1))
+	}
+	return d, attempt
+}
+
+// newSecret returns random secret for signing payloads.
+func newSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
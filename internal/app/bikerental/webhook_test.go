@@ -0,0 +1,30 @@
+package bikerental
+
+import "testing"
+
+func TestWebhookSubscriptionValidateURL(t *testing.T) {
+	tests := []struct {
+		url           string
+		allowInsecure bool
+		wantErr       bool
+	}{
+		{url: "https://hooks.example.com/bikes"},
+		{url: "http://hooks.example.com/bikes", wantErr: true},
+		{url: "http://hooks.example.com/bikes", allowInsecure: true},
+		{url: "https://localhost:8080/hook", wantErr: true},
+		{url: "https://127.0.0.1/hook", wantErr: true},
+		{url: "https://[::1]/hook", wantErr: true},
+		{url: "https://10.0.0.5/hook", wantErr: true},
+		{url: "https://169.254.169.254/latest/meta-data", wantErr: true},
+		{url: "http://localhost:8080/hook", allowInsecure: true},
+		{url: "ftp://hooks.example.com", allowInsecure: true, wantErr: true},
+		{url: "/relative", allowInsecure: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		sub := WebhookSubscription{URL: tt.url, Secret: "0123456789abcdef"}
+		err := sub.Validate(tt.allowInsecure)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%t) of %s error = %v, want error: %t", tt.allowInsecure, tt.url, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+// DetachedCtx returns a context with values of the parent (tenant, identity, log fields, trace span),
+// but without its deadline and cancellation.
+// It's used for cleanup that has to happen even when the call was cancelled by the client.
+func DetachedCtx(parent context.Context) context.Context {
+	return detachedCtx{parent: parent}
+}
+
+type detachedCtx struct {
+	parent context.Context
+}
+
+func (detachedCtx) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+func (detachedCtx) Done() <-chan struct{} { return nil }
+
+func (detachedCtx) Err() error { return nil }
+
+func (c detachedCtx) Value(key interface{}) interface{} { return c.parent.Value(key) }
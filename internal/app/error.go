@@ -1,11 +1,17 @@
 package app
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // Sentinel errors.
 var (
 	// ErrNotFound represents all kind of problems resulting from not finding something.
 	ErrNotFound = errors.New("not found")
+
+	// ErrUnauthenticated represents missing or invalid caller credentials.
+	ErrUnauthenticated = errors.New("unauthenticated")
 )
 
 // IsNotFoundError returns true if err has NotFoundError in its chain.
@@ -13,6 +19,11 @@ func IsNotFoundError(err error) bool {
 	return errors.Is(err, ErrNotFound)
 }
 
+// IsUnauthenticatedError returns true if err has ErrUnauthenticated in its chain.
+func IsUnauthenticatedError(err error) bool {
+	return errors.Is(err, ErrUnauthenticated)
+}
+
 // ValidationError represents all kind of invalid data errors.
 type ValidationError struct {
 	Err error
@@ -53,3 +64,68 @@ func (e ConflictError) Error() string {
 func IsConflictError(err error) bool {
 	return errors.As(err, &ConflictError{})
 }
+
+// ForbiddenError represents calls not allowed for the caller,
+// like using a method not available for caller's role or accessing data of other customers.
+type ForbiddenError struct {
+	Err error
+}
+
+// NewForbiddenError creates new ForbiddenError instance.
+func NewForbiddenError(message string) error {
+	return ForbiddenError{Err: errors.New(message)}
+}
+
+// Error fullfills error interface.
+func (e ForbiddenError) Error() string {
+	return e.Err.Error()
+}
+
+// IsForbiddenError returns true if err has ForbiddenError in its chain.
+func IsForbiddenError(err error) bool {
+	return errors.As(err, &ForbiddenError{})
+}
+
+// RateLimitError represents calls rejected because the caller exceeded a rate limit or a quota.
+type RateLimitError struct {
+	Err error
+
+	// RetryAfter is a time after which the call can be retried.
+	RetryAfter time.Duration
+}
+
+// NewRateLimitError creates new RateLimitError instance.
+func NewRateLimitError(message string, retryAfter time.Duration) error {
+	return RateLimitError{Err: errors.New(message), RetryAfter: retryAfter}
+}
+
+// Error fullfills error interface.
+func (e RateLimitError) Error() string {
+	return e.Err.Error()
+}
+
+// IsRateLimitError returns true if err has RateLimitError in its chain.
+func IsRateLimitError(err error) bool {
+	return errors.As(err, &RateLimitError{})
+}
+
+// PreconditionError represents calls rejected because of the state of the system,
+// which won't change by retrying the call. For example - reusing an idempotency key with a different request.
+type PreconditionError struct {
+	Err error
+}
+
+// NewPreconditionError creates new PreconditionError instance.
+func NewPreconditionError(message string) error {
+	return PreconditionError{Err: errors.New(message)}
+}
+
+// Error fullfills error interface.
+func (e PreconditionError) Error() string {
+	return e.Err.Error()
+}
+
+// IsPreconditionError returns true if err has PreconditionError in its chain.
+func IsPreconditionError(err error) bool {
+	return errors.As(err, &PreconditionError{})
+}
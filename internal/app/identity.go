@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+	"fmt"
+)
+
+// Authentication methods.
+const (
+	AuthMethodAPIKey = "api_key"
+	AuthMethodJWT    = "jwt"
+)
+
+// Role decides what the caller is allowed to do.
+type Role string
+
+// Roles.
+const (
+	RoleEmpty Role = ""
+
+	// RoleAdmin can do everything, also manage bikes and system configuration.
+	RoleAdmin Role = "admin"
+
+	// RoleStaff works at the rental desk and can manage reservations of all customers.
+	RoleStaff Role = "staff"
+
+	// RoleCustomer can manage only own reservations.
+	RoleCustomer Role = "customer"
+)
+
+// Validate validates role.
+func (r Role) Validate() error {
+	switch r {
+	case RoleAdmin, RoleStaff, RoleCustomer:
+		return nil
+	default:
+		return NewValidationError(fmt.Sprintf("unknown role: '%s'", r))
+	}
+}
+
+// Identity is an authenticated caller.
+type Identity struct {
+	// Subject is an id of the caller: API key id or JWT subject.
+	// For customers it's a customer id.
+	Subject string
+
+	// TenantID is an id of the tenant (rental company) the caller belongs to.
+	// It's empty for platform admins, who choose the tenant of each call.
+	TenantID string
+
+	// Method is one of authentication methods.
+	Method string
+
+	Role Role
+}
+
+// CustomerID returns id of the customer, or false if the caller is not a customer.
+func (i Identity) CustomerID() (string, bool) {
+	if i.Role != RoleCustomer {
+		return "", false
+	}
+	return i.Subject, true
+}
+
+type ctxIdentityKeyType uint32
+
+const (
+	ctxIdentityKey ctxIdentityKeyType = iota
+)
+
+// IdentityFromCtx returns caller identity from context.
+// Returns false if the caller wasn't authenticated.
+func IdentityFromCtx(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(ctxIdentityKey).(Identity)
+	return id, ok
+}
+
+// CtxWithIdentity returns new context with caller identity.
+func CtxWithIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, ctxIdentityKey, id)
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+)
+
+// DefaultTenantID is a tenant of data created before multi-tenancy was introduced.
+const DefaultTenantID = "default"
+
+type ctxTenantIDKeyType uint32
+
+const (
+	ctxTenantIDKey ctxTenantIDKeyType = iota
+)
+
+// TenantIDFromCtx returns id of the tenant (rental company) the call is made for.
+// Returns false if tenant is not known.
+func TenantIDFromCtx(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(ctxTenantIDKey).(string)
+	return id, ok && id != ""
+}
+
+// CtxWithTenantID returns new context with tenant id.
+func CtxWithTenantID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxTenantIDKey, id)
+}
@@ -2,24 +2,15 @@ package app
 
 import (
 	"context"
-)
-
-type ctxTraceIDKeyType uint32
 
-const (
-	ctxTraceIDKeyKey ctxTraceIDKeyType = iota
+	"go.opentelemetry.io/otel/trace"
 )
 
-// TraceIDFromCtx returns trace id from context.
-// If id is not present in context, returns emoty string.
+// TraceIDFromCtx returns trace id of the span active in context.
+// If there is no span in context, returns empty string.
 func TraceIDFromCtx(ctx context.Context) string {
-	if id, ok := ctx.Value(ctxTraceIDKeyKey).(string); ok {
-		return id
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
 	}
 	return ""
 }
-
-// CtxWithTraceID returns new context with trace id..
-func CtxWithTraceID(ctx context.Context, id string) context.Context {
-	return context.WithValue(ctx, ctxTraceIDKeyKey, id)
-}
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	context "context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+// HTTP server default timeouts.
+const (
+	readTimeout       = 5 * time.Second
+	readHeaderTimeout = 3 * time.Second
+	writeTimeout      = 10 * time.Second
+	idleTimeout       = 30 * time.Second
+)
+
+// RunServer starts http server with endpoints for operators: /metrics with prometheus metrics.
+// It listens on a separate address, which shouldn't be exposed to API clients.
+// Server is gracefully shut down on context cancellation.
+func RunServer(ctx context.Context, log logrus.FieldLogger, gatherer prometheus.Gatherer, addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{
+		ErrorLog: log,
+	}))
+
+	s := http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadTimeout:       readTimeout,
+		ReadHeaderTimeout: readHeaderTimeout,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       idleTimeout,
+	}
+
+	go func() {
+		<-ctx.Done()
+		log.Infof("admin server: shutting down")
+		if err := s.Shutdown(context.Background()); err != nil {
+			log.Errorf("admin server: failed to shutdown admin server: %v", err)
+		}
+	}()
+
+	log.Infof("admin server: listening on %s", addr)
+	if err := s.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
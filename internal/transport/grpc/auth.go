@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Headers with caller credentials.
+// API key can be sent in X-API-Key header or as a bearer token, JWT only as a bearer token.
+// X-Tenant-ID header selects a tenant for platform admins, other callers can send only their own tenant.
+const (
+	APIKeyHeader        = "x-api-key"
+	AuthorizationHeader = "authorization"
+	TenantIDHeader      = "x-tenant-id"
+)
+
+// AuthUnaryServerInterceptor returns a new unary server interceptor authenticating the caller.
+// Health service is called by probes without credentials.
+func AuthUnaryServerInterceptor(authenticator bikerental.Authenticator, log logrus.FieldLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isHealthMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticateFromMetadata(ctx, authenticator, log)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamServerInterceptor returns a new stream server interceptor authenticating the caller.
+func AuthStreamServerInterceptor(authenticator bikerental.Authenticator, log logrus.FieldLogger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isHealthMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, err := authenticateFromMetadata(ss.Context(), authenticator, log)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStreamWithCtx{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticateFromMetadata(ctx context.Context, authenticator bikerental.Authenticator, log logrus.FieldLogger) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return Authenticate(
+		ctx,
+		authenticator,
+		log,
+		firstValue(md.Get(APIKeyHeader)),
+		firstValue(md.Get(AuthorizationHeader)),
+		firstValue(md.Get(TenantIDHeader)),
+	)
+}
+
+// Authenticate authenticates the caller with credentials from request headers,
+// and returns context with caller identity and tenant id.
+// Returns server error with codes.Unauthenticated if credentials are missing or invalid,
+// and codes.PermissionDenied if the caller doesn't belong to requested tenant.
+func Authenticate(
+	ctx context.Context,
+	authenticator bikerental.Authenticator,
+	log logrus.FieldLogger,
+	apiKey, authorization, tenantID string,
+) (context.Context, error) {
+	credentials := apiKey
+	if credentials == "" {
+		credentials = bearerToken(authorization)
+	}
+
+	identity, err := authenticator.Authenticate(ctx, credentials)
+	if err != nil {
+		if !app.IsUnauthenticatedError(err) {
+			app.AugmentLogFromCtx(ctx, log).Errorf("authenticating caller: %v", err)
+		}
+		return ctx, NewServerError(err)
+	}
+
+	tenantID, err = resolveTenantID(identity, tenantID)
+	if err != nil {
+		return ctx, NewServerError(err)
+	}
+
+	ctx = app.CtxWithIdentity(ctx, identity)
+	ctx = app.CtxWithTenantID(ctx, tenantID)
+	ctx = app.CtxWithLogField(ctx, "auth.subject", identity.Subject)
+	ctx = app.CtxWithLogField(ctx, "auth.tenant", tenantID)
+	return ctx, nil
+}
+
+// resolveTenantID returns tenant of the call: the caller's tenant, or requested one for platform admins.
+func resolveTenantID(identity app.Identity, requested string) (string, error) {
+	switch {
+	case identity.TenantID == "" && requested == "":
+		return "", app.NewValidationError("tenant id is required, send it in X-Tenant-ID header")
+	case identity.TenantID == "":
+		return requested, nil
+	case requested != "" && requested != identity.TenantID:
+		return "", app.NewForbiddenError(fmt.Sprintf("caller doesn't belong to tenant '%s'", requested))
+	default:
+		return identity.TenantID, nil
+	}
+}
+
+// bearerToken returns token from authorization header value, or empty string if it's not a bearer token.
+func bearerToken(authorization string) string {
+	const scheme = "bearer "
+	if len(authorization) < len(scheme) || !strings.EqualFold(authorization[:len(scheme)], scheme) {
+		return ""
+	}
+	return strings.TrimSpace(authorization[len(scheme):])
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -1,9 +1,16 @@
 package grpc
 
 import (
+	"errors"
+	"math"
+	"strconv"
+
 	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // NewServerError creates error for server response.
@@ -20,9 +27,52 @@ func NewServerError(err error) error {
 		code = codes.AlreadyExists
 	case app.IsValidationError(err):
 		code = codes.InvalidArgument
+	case app.IsUnauthenticatedError(err):
+		code = codes.Unauthenticated
+	case app.IsForbiddenError(err):
+		code = codes.PermissionDenied
+	case app.IsPreconditionError(err):
+		code = codes.FailedPrecondition
+	case app.IsRateLimitError(err):
+		return newRateLimitError(err)
 	default:
 		code = codes.Internal
 	}
 
 	return status.Error(code, err.Error())
 }
+
+// newRateLimitError creates resource exhausted error with retry delay in details.
+func newRateLimitError(err error) error {
+	var rlErr app.RateLimitError
+	errors.As(err, &rlErr)
+
+	st := status.New(codes.ResourceExhausted, err.Error())
+	if withDetails, detailsErr := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(rlErr.RetryAfter),
+	}); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// RetryAfter returns value of Retry-After header for rate limit server error: retry delay in seconds, rounded up.
+// Returns false if err has no retry delay.
+func RetryAfter(err error) (string, bool) {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.RetryInfo); ok {
+			return strconv.Itoa(int(math.Ceil(info.GetRetryDelay().AsDuration().Seconds()))), true
+		}
+	}
+	return "", false
+}
+
+// newWatchError creates error ending watch stream.
+// Interrupted watch is reported as unavailable, so clients know they should retry.
+// Other errors come from sending messages, they already have status of the stream.
+func newWatchError(err error) error {
+	if errors.Is(err, bikerental.ErrWatchInterrupted) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return err
+}
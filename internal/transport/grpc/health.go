@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nglogic/go-application-guide/pkg/api/bikerentalv1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// healthMethodPrefix is a prefix of health service methods, they are called by probes without credentials.
+const healthMethodPrefix = "/grpc.health.v1.Health/"
+
+var bikerentalServiceName = bikerentalv1.File_nglogic_bikerental_v1_service_proto.Services().ByName("BikeRentalService").FullName()
+
+// HealthCheck checks if a dependency required for serving calls is available.
+type HealthCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// Health reports if the app is ready to serve calls, with grpc health service and http gateway probes.
+// Serving status is updated by periodic dependency checks, so probes don't put load on dependencies.
+// Status is the same for the whole server and for BikeRentalService.
+type Health struct {
+	log     logrus.FieldLogger
+	checks  []HealthCheck
+	timeout time.Duration
+	server  *health.Server
+}
+
+// NewHealth creates new health instance. It's not serving until dependencies are checked by Run.
+func NewHealth(log logrus.FieldLogger, checks []HealthCheck, timeout time.Duration) (*Health, error) {
+	if log == nil {
+		return nil, errors.New("empty logger")
+	}
+	if timeout <= 0 {
+		return nil, errors.New("timeout is required")
+	}
+	for _, c := range checks {
+		if c.Name == "" || c.Check == nil {
+			return nil, errors.New("health check requires name and check func")
+		}
+	}
+
+	h := &Health{
+		log:     log,
+		checks:  checks,
+		timeout: timeout,
+		server:  health.NewServer(),
+	}
+	h.setServing(false)
+	return h, nil
+}
+
+// Run checks dependencies with given interval and updates serving status, until context is cancelled.
+func (h *Health) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	checked, ready := false, false
+	for {
+		err := h.checkDependencies(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		// Only changes of status are logged, so failing dependency doesn't flood logs.
+		if err != nil && (ready || !checked) {
+			h.log.Errorf("health: not ready: %v", err)
+		}
+		if err == nil && !ready {
+			h.log.Infof("health: ready")
+		}
+		checked, ready = true, err == nil
+		h.setServing(ready)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Ready returns true if the app is ready to serve calls.
+func (h *Health) Ready(ctx context.Context) bool {
+	resp, err := h.server.Check(ctx, &healthpb.HealthCheckRequest{})
+	return err == nil && resp.Status == healthpb.HealthCheckResponse_SERVING
+}
+
+// Shutdown sets status to not serving permanently, status updates of Run are ignored after it.
+// It has to be called a drain delay before servers are stopped, so probes stop sending traffic before connections are drained.
+func (h *Health) Shutdown() {
+	h.server.Shutdown()
+}
+
+func (h *Health) checkDependencies(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	var failed []string
+	for _, c := range h.checks {
+		if err := c.Check(ctx); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", c.Name, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("dependency checks failed: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+func (h *Health) setServing(serving bool) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	h.server.SetServingStatus("", status)
+	h.server.SetServingStatus(string(bikerentalServiceName), status)
+}
+
+// isHealthMethod returns true for methods of grpc health service.
+func isHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, healthMethodPrefix)
+}
@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/nglogic/go-application-guide/internal/transport/grpc"
 	"github.com/nglogic/go-application-guide/pkg/api/bikerentalv1"
 	"github.com/sirupsen/logrus"
 )
@@ -20,31 +23,62 @@ const (
 	idleTimeout       = 30 * time.Second
 )
 
-// RunServer starts http server with grpc gateway for ServiceServer.
-// Server is gracefully shut down on context cancellation.
+// RunServer starts http server with grpc gateway for ServiceServer and receipt endpoints,
+// and with /healthz and /readyz endpoints for liveness and readiness probes.
+// All requests have to be authenticated with API key or JWT, and are rate limited per IP address and per client, except for probes.
+// Duration and status codes of requests are recorded in metrics, and requests are traced.
+// Server is gracefully shut down on context cancellation. It should be cancelled a drain delay after Health.Shutdown,
+// so /readyz responds with 503 before connections are drained.
 func RunServer(
 	ctx context.Context,
 	log logrus.FieldLogger,
 	srv bikerentalv1.BikeRentalServiceServer,
+	receipts bikerental.ReceiptService,
+	authenticator bikerental.Authenticator,
+	limits bikerental.RateLimitService,
+	metrics *grpc.RPCMetrics,
+	health *grpc.Health,
 	addr string,
 ) error {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher))
 	if err := bikerentalv1.RegisterBikeRentalServiceHandlerServer(ctx, mux, srv); err != nil {
 		return fmt.Errorf("registering http handlers for server: %w", err)
 	}
+	if err := registerReceiptHandlers(mux, log, receipts); err != nil {
+		return fmt.Errorf("registering receipt handlers: %w", err)
+	}
+	if err := registerStreamHandlers(mux, log, srv); err != nil {
+		return fmt.Errorf("registering stream handlers: %w", err)
+	}
 
 	var handler http.Handler = mux
+	handler = HandlerWithTimeout(handler, writeTimeout)
+	handler, err := HandlerWithRateLimit(handler, limits, log)
+	if err != nil {
+		return err
+	}
+	handler = HandlerWithIdentity(handler, authenticator, log)
+	handler = HandlerWithIPRateLimit(handler, limits, log)
 	handler = HandlerWithLogCtx(handler)
-	handler = HandlerWithTraceID(handler)
+	handler, err = HandlerWithTracing(handler)
+	if err != nil {
+		return err
+	}
+	handler, err = HandlerWithMetrics(handler, metrics)
+	if err != nil {
+		return err
+	}
+	handler = HandlerWithHealth(handler, health)
 
 	// See this great explanation on http timeouts:
 	// https://blog.cloudflare.com/the-complete-guide-to-golang-net-http-timeouts/
+	// Server has no write timeout, because it would end streaming responses.
+	// Other responses are limited by HandlerWithTimeout instead.
 	s := http.Server{
 		Addr:              addr,
 		Handler:           handler,
 		ReadTimeout:       readTimeout,
 		ReadHeaderTimeout: readHeaderTimeout,
-		WriteTimeout:      writeTimeout,
 		IdleTimeout:       idleTimeout,
 	}
 
@@ -62,3 +96,12 @@ func RunServer(
 	}
 	return nil
 }
+
+// incomingHeaderMatcher passes Idempotency-Key header to grpc metadata of server methods,
+// in addition to headers passed by default.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, grpc.IdempotencyKeyHeader) {
+		return grpc.IdempotencyKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,112 @@
+package httpgateway
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/nglogic/go-application-guide/pkg/api/bikerentalv1"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+)
+
+// rpcService is a service of rpc methods called by http requests.
+var rpcService = bikerentalv1.File_nglogic_bikerental_v1_service_proto.Services().ByName("BikeRentalService")
+
+// receiptMethod is a name of receipt endpoints in rate limits, they are not grpc methods.
+const receiptMethod = "GetReceipt"
+
+// methodRoute is a http route of rpc method.
+type methodRoute struct {
+	httpMethod string
+	segments   []string
+	verb       string
+	rpcMethod  string
+}
+
+// methodRoutes resolves rpc methods called by http requests, using http rules from the proto file.
+// Middlewares run before requests are routed by the gateway, so they don't know called method otherwise.
+type methodRoutes []methodRoute
+
+func newMethodRoutes() (methodRoutes, error) {
+	var routes methodRoutes
+	methods := rpcService.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		m := methods.Get(i)
+		rule, ok := proto.GetExtension(m.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			return nil, fmt.Errorf("method %s has no http rule", m.Name())
+		}
+		for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			httpMethod, pattern := httpRulePattern(r)
+			routes = append(routes, newMethodRoute(httpMethod, pattern, string(m.Name())))
+		}
+	}
+	for pattern := range receiptPatterns {
+		routes = append(routes, newMethodRoute(http.MethodGet, pattern, receiptMethod))
+	}
+	return routes, nil
+}
+
+func httpRulePattern(r *annotations.HttpRule) (string, string) {
+	switch p := r.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, p.Get
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, p.Post
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, p.Put
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, p.Patch
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, p.Delete
+	case *annotations.HttpRule_Custom:
+		return p.Custom.GetKind(), p.Custom.GetPath()
+	default:
+		return "", ""
+	}
+}
+
+// newMethodRoute creates route from path template, like "/v1/bikes/{bike_id=*}/reservations/{id=*}:cancel".
+func newMethodRoute(httpMethod, pattern, rpcMethod string) methodRoute {
+	segments, verb := splitPath(pattern)
+	return methodRoute{
+		httpMethod: httpMethod,
+		segments:   segments,
+		verb:       verb,
+		rpcMethod:  rpcMethod,
+	}
+}
+
+// resolve returns rpc method called by the request, or false if request doesn't match any route.
+func (routes methodRoutes) resolve(r *http.Request) (string, bool) {
+	segments, verb := splitPath(r.URL.Path)
+	for _, route := range routes {
+		if route.matches(r.Method, segments, verb) {
+			return route.rpcMethod, true
+		}
+	}
+	return "", false
+}
+
+func (route methodRoute) matches(httpMethod string, segments []string, verb string) bool {
+	if httpMethod != route.httpMethod || verb != route.verb || len(segments) != len(route.segments) {
+		return false
+	}
+	for i, s := range route.segments {
+		isVariable := strings.HasPrefix(s, "{")
+		if (isVariable && segments[i] == "") || (!isVariable && segments[i] != s) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitPath returns path segments and custom verb, which follows a colon after the last segment.
+func splitPath(path string) ([]string, string) {
+	var verb string
+	if i := strings.LastIndex(path, ":"); i > strings.LastIndex(path, "/") && i > strings.LastIndex(path, "}") {
+		path, verb = path[:i], path[i+1:]
+	}
+	return strings.Split(strings.Trim(path, "/"), "/"), verb
+}
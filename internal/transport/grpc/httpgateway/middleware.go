@@ -3,25 +3,55 @@ package httpgateway
 import (
 	"fmt"
 	"net/http"
+	"time"
 
-	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/nglogic/go-application-guide/internal/transport/grpc"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
-// HandlerWithTraceID wraps handler with middleware generating trace id for each request.
-// If trace id is present in headers, it will be preserved. We try to discover incoming trace ids based on w3 standard:
+const tracerName = "github.com/nglogic/go-application-guide/internal/transport/grpc/httpgateway"
+
+// HandlerWithTracing wraps handler with middleware starting a span for each request.
+// Trace context of the caller is extracted from traceparent header, based on w3 standard:
 // https://www.w3.org/TR/trace-context/#trace-context-http-headers-format
-func HandlerWithTraceID(h http.Handler) http.Handler {
+// Trace context of the request is sent back in traceparent header.
+func HandlerWithTracing(h http.Handler) (http.Handler, error) {
+	routes, err := newMethodRoutes()
+	if err != nil {
+		return nil, fmt.Errorf("resolving http routes of rpc methods: %w", err)
+	}
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		traceID := r.Header.Get("trace-id")
-		if traceID == "" {
-			traceID = fmt.Sprintf("%x", uuid.New())
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+		// Spans are named like spans of grpc calls, e.g. "nglogic.bikerental.v1.BikeRentalService/ListBikes".
+		name := "HTTP " + r.Method
+		attrs := semconv.HTTPServerAttributesFromHTTPRequest("", "", r)
+		if method, ok := routes.resolve(r); ok {
+			name = string(rpcService.FullName()) + "/" + method
+			attrs = append(attrs, semconv.RPCMethodKey.String(method))
 		}
+		ctx, span := otel.Tracer(tracerName).Start(ctx, name, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attrs...))
+		defer span.End()
 
-		ctx := app.CtxWithTraceID(r.Context(), traceID)
-		r = r.WithContext(ctx)
-		h.ServeHTTP(w, r)
-	})
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(w.Header()))
+
+		sw := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		h.ServeHTTP(sw, r.WithContext(ctx))
+
+		span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(sw.statusCode)...)
+		span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(sw.statusCode, trace.SpanKindServer))
+	}), nil
 }
 
 // HandlerWithLogCtx wraps handler with middleware adding request information to context for logging.
@@ -35,3 +65,162 @@ func HandlerWithLogCtx(h http.Handler) http.Handler {
 		h.ServeHTTP(w, r)
 	})
 }
+
+// HandlerWithTimeout wraps handler with middleware limiting time of writing the response.
+// Streaming endpoints are not limited, they are open until client disconnects.
+func HandlerWithTimeout(h http.Handler, timeout time.Duration) http.Handler {
+	withTimeout := http.TimeoutHandler(h, timeout, "request timeout")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isStreamRequest(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		withTimeout.ServeHTTP(w, r)
+	})
+}
+
+// HandlerWithIdentity wraps handler with middleware authenticating the caller with X-API-Key or Authorization header.
+// Caller identity and tenant id are added to request context. Requests without valid credentials get 401 response.
+func HandlerWithIdentity(h http.Handler, authenticator bikerental.Authenticator, log logrus.FieldLogger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := grpc.Authenticate(
+			r.Context(),
+			authenticator,
+			log,
+			r.Header.Get(grpc.APIKeyHeader),
+			r.Header.Get(grpc.AuthorizationHeader),
+			r.Header.Get(grpc.TenantIDHeader),
+		)
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		r = r.WithContext(ctx)
+		h.ServeHTTP(w, r)
+	})
+}
+
+// HandlerWithIPRateLimit wraps handler with middleware limiting requests per remote IP address.
+// It has to run before HandlerWithIdentity, so requests with invalid credentials are limited too.
+// Limited requests get 429 response with Retry-After header.
+func HandlerWithIPRateLimit(h http.Handler, limits bikerental.RateLimitService, log logrus.FieldLogger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := grpc.CheckIPRateLimit(r.Context(), limits, log, r.RemoteAddr); err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+// HandlerWithRateLimit wraps handler with middleware limiting calls of API clients.
+// It has to run after HandlerWithIdentity, so clients are identified by caller identity.
+// Limited requests get 429 response with Retry-After header.
+func HandlerWithRateLimit(h http.Handler, limits bikerental.RateLimitService, log logrus.FieldLogger) (http.Handler, error) {
+	routes, err := newMethodRoutes()
+	if err != nil {
+		return nil, fmt.Errorf("resolving http routes of rpc methods: %w", err)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Unknown routes are limited together, gateway responds to them with 404.
+		method, _ := routes.resolve(r)
+		if err := grpc.CheckRateLimit(r.Context(), limits, log, method); err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	}), nil
+}
+
+// HandlerWithMetrics wraps handler with middleware recording duration and status codes of requests.
+// It has to be the outermost middleware, so requests rejected by other middlewares are recorded too.
+// Streaming requests are recorded when they end, they are told apart by rpc method.
+func HandlerWithMetrics(h http.Handler, metrics *grpc.RPCMetrics) (http.Handler, error) {
+	routes, err := newMethodRoutes()
+	if err != nil {
+		return nil, fmt.Errorf("resolving http routes of rpc methods: %w", err)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		h.ServeHTTP(sw, r)
+
+		method, _ := routes.resolve(r)
+		metrics.ObserveHTTPRequest(method, sw.statusCode, time.Since(start))
+	}), nil
+}
+
+// statusResponseWriter remembers status code of the response.
+type statusResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *statusResponseWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Flush is needed by streaming endpoints.
+func (w *statusResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Paths of probes.
+const (
+	livenessPath  = "/healthz"
+	readinessPath = "/readyz"
+)
+
+// HandlerWithHealth wraps handler with middleware responding to liveness and readiness probes.
+// Liveness means only that the server responds, readiness is reported by health of the app.
+// It has to be the outermost middleware, so probes are not authenticated, rate limited, recorded or traced.
+func HandlerWithHealth(h http.Handler, health *grpc.Health) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case livenessPath:
+			writeProbeResponse(w, true)
+		case readinessPath:
+			writeProbeResponse(w, health.Ready(r.Context()))
+		default:
+			h.ServeHTTP(w, r)
+		}
+	})
+}
+
+func writeProbeResponse(w http.ResponseWriter, ok bool) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("not ready\n"))
+		return
+	}
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// writeStatusError writes grpc status error like the gateway does.
+func writeStatusError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	body, marshalErr := protojson.Marshal(st.Proto())
+	if marshalErr != nil {
+		http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	if retryAfter, ok := grpc.RetryAfter(err); ok {
+		w.Header().Set(grpc.RetryAfterHeader, retryAfter)
+	}
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	_, _ = w.Write(body)
+}
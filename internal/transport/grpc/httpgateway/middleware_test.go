@@ -0,0 +1,173 @@
+package httpgateway
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/transport/grpc"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newSpanRecorder sets global tracer provider recording ended spans in memory, for the time of the test.
+func newSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+	return recorder
+}
+
+func spanAttribute(s sdktrace.ReadOnlySpan, key attribute.Key) string {
+	for _, kv := range s.Attributes() {
+		if kv.Key == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestHandlerWithTracing(t *testing.T) {
+	const (
+		callerTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+		callerSpanID  = "00f067aa0ba902b7"
+	)
+	tests := []struct {
+		name        string
+		traceparent string
+		statusCode  int
+		wantName    string
+		wantMethod  string
+		wantStatus  codes.Code
+	}{
+		{
+			name:        "rpc method called by caller with trace",
+			traceparent: "00-" + callerTraceID + "-" + callerSpanID + "-01",
+			statusCode:  http.StatusNotFound,
+			wantName:    "nglogic.bikerental.v1.BikeRentalService/GetBike",
+			wantMethod:  "GetBike",
+			wantStatus:  codes.Unset,
+		},
+		{
+			name:       "server error",
+			statusCode: http.StatusInternalServerError,
+			wantName:   "nglogic.bikerental.v1.BikeRentalService/GetBike",
+			wantMethod: "GetBike",
+			wantStatus: codes.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := newSpanRecorder(t)
+
+			var handlerSpan trace.SpanContext
+			h, err := HandlerWithTracing(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handlerSpan = trace.SpanContextFromContext(r.Context())
+				w.WriteHeader(tt.statusCode)
+			}))
+			if err != nil {
+				t.Fatalf("HandlerWithTracing() error = %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/bikes/bike-1", nil)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("recorded %d spans, want 1", len(spans))
+			}
+			s := spans[0]
+			if s.Name() != tt.wantName || s.SpanKind() != trace.SpanKindServer {
+				t.Errorf("span = %s of kind %s, want %s of kind %s", s.Name(), s.SpanKind(), tt.wantName, trace.SpanKindServer)
+			}
+			if got := spanAttribute(s, "rpc.method"); got != tt.wantMethod {
+				t.Errorf("span attribute rpc.method = '%s', want '%s'", got, tt.wantMethod)
+			}
+			if got := s.Status().Code; got != tt.wantStatus {
+				t.Errorf("span status = %s, want %s", got, tt.wantStatus)
+			}
+
+			if tt.traceparent != "" {
+				if s.SpanContext().TraceID().String() != callerTraceID || s.Parent().SpanID().String() != callerSpanID || !s.Parent().IsRemote() {
+					t.Errorf("span = %s/%s with parent %s, want child of remote span %s/%s",
+						s.SpanContext().TraceID(), s.SpanContext().SpanID(), s.Parent().SpanID(), callerTraceID, callerSpanID)
+				}
+			} else if s.Parent().IsValid() {
+				t.Errorf("span parent = %s, want new trace", s.Parent().SpanID())
+			}
+
+			// Gateway calls server in process, so rpc handlers get context with the span.
+			if handlerSpan.SpanID() != s.SpanContext().SpanID() {
+				t.Errorf("span in handler context = %s, want %s", handlerSpan.SpanID(), s.SpanContext().SpanID())
+			}
+
+			traceparent := "00-" + s.SpanContext().TraceID().String() + "-" + s.SpanContext().SpanID().String() + "-01"
+			if got := rec.Header().Get("traceparent"); got != traceparent {
+				t.Errorf("traceparent header = %s, want %s", got, traceparent)
+			}
+		})
+	}
+}
+
+// TestHandlerWithHealthShutdown checks that the app is not ready during drain delay, but still serves requests.
+func TestHandlerWithHealthShutdown(t *testing.T) {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	health, err := grpc.NewHealth(log, []grpc.HealthCheck{{Name: "db", Check: func(context.Context) error { return nil }}}, time.Second)
+	if err != nil {
+		t.Fatalf("creating health: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go health.Run(ctx, 10*time.Millisecond)
+
+	h := HandlerWithHealth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), health)
+	get := func(path string) int {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Code
+	}
+
+	for deadline := time.Now().Add(time.Second); get(readinessPath) != http.StatusOK; {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s not ready after dependency checks", readinessPath)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	health.Shutdown()
+	// Passing dependency checks don't make the app ready again.
+	time.Sleep(50 * time.Millisecond)
+
+	want := map[string]int{
+		readinessPath:    http.StatusServiceUnavailable,
+		livenessPath:     http.StatusOK,
+		"/v1/bikes/bike": http.StatusOK,
+	}
+	for path, code := range want {
+		if got := get(path); got != code {
+			t.Errorf("GET %s status = %d, want %d", path, got, code)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package httpgateway
+
+import (
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/nglogic/go-application-guide/internal/transport/grpc"
+	"github.com/sirupsen/logrus"
+)
+
+// receiptPatterns are paths of receipt documents in each format.
+var receiptPatterns = map[string]bikerental.ReceiptFormat{
+	"/v1/reservations/{id}/receipt.pdf":  bikerental.ReceiptFormatPDF,
+	"/v1/reservations/{id}/receipt.html": bikerental.ReceiptFormatHTML,
+}
+
+// registerReceiptHandlers registers endpoints serving receipt documents.
+// They are not grpc methods, because responses are binary documents, not protobuf messages.
+// Optional "brand" query parameter selects receipt templates.
+func registerReceiptHandlers(mux *runtime.ServeMux, log logrus.FieldLogger, receipts bikerental.ReceiptService) error {
+	for pattern, format := range receiptPatterns {
+		if err := mux.HandlePath(http.MethodGet, pattern, receiptHandler(mux, log, receipts, format)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func receiptHandler(
+	mux *runtime.ServeMux,
+	log logrus.FieldLogger,
+	receipts bikerental.ReceiptService,
+	format bikerental.ReceiptFormat,
+) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx := r.Context()
+
+		doc, err := receipts.GetReceipt(ctx, bikerental.ReceiptRequest{
+			ReservationID: pathParams["id"],
+			Format:        format,
+			Brand:         r.URL.Query().Get("brand"),
+		})
+		if err != nil {
+			if !app.IsValidationError(err) && !app.IsNotFoundError(err) {
+				app.AugmentLogFromCtx(ctx, log).Errorf("handling request for receipt: %v", err)
+			}
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+			runtime.HTTPError(ctx, mux, outbound, w, r, grpc.NewServerError(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", doc.ContentType)
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(doc.Data); err != nil {
+			app.AugmentLogFromCtx(ctx, log).Errorf("writing receipt response: %v", err)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package httpgateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/pkg/api/bikerentalv1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Paths of server streaming endpoints.
+const (
+	watchReservationsPath     = "/v1/reservations:watch"
+	watchBikeAvailabilityPath = "/v1/bikes:watchAvailability"
+)
+
+// isStreamRequest returns true if request is handled by a streaming endpoint, open until client disconnects.
+func isStreamRequest(r *http.Request) bool {
+	return r.Method == http.MethodGet && (r.URL.Path == watchReservationsPath || r.URL.Path == watchBikeAvailabilityPath)
+}
+
+// registerStreamHandlers registers endpoints of server streaming methods.
+// In-process gateway doesn't support streaming calls, so these handlers call the server with a stream writing to http response.
+// They replace handlers registered for the same paths by the gateway.
+//
+// Messages are written as newline-delimited JSON, wrapped like in the gateway: {"result": {...}} or {"error": {...}}.
+// If client accepts "text/event-stream", they are written as Server-Sent Events, with errors in "error" events.
+func registerStreamHandlers(mux *runtime.ServeMux, log logrus.FieldLogger, srv bikerentalv1.BikeRentalServiceServer) error {
+	handlers := map[string]runtime.HandlerFunc{
+		watchReservationsPath: func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			req := &bikerentalv1.WatchReservationsRequest{
+				BikeId:    r.URL.Query().Get("bike_id"),
+				StationId: r.URL.Query().Get("station_id"),
+			}
+			serveStream(mux, log, w, r, func(s *httpStream) error {
+				return srv.WatchReservations(req, watchReservationsStream{s})
+			})
+		},
+		watchBikeAvailabilityPath: func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			req := &bikerentalv1.WatchBikeAvailabilityRequest{
+				BikeId:    r.URL.Query().Get("bike_id"),
+				StationId: r.URL.Query().Get("station_id"),
+			}
+			serveStream(mux, log, w, r, func(s *httpStream) error {
+				return srv.WatchBikeAvailability(req, watchBikeAvailabilityStream{s})
+			})
+		},
+	}
+	for pattern, h := range handlers {
+		if err := mux.HandlePath(http.MethodGet, pattern, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func serveStream(
+	mux *runtime.ServeMux,
+	log logrus.FieldLogger,
+	w http.ResponseWriter,
+	r *http.Request,
+	call func(*httpStream) error,
+) {
+	ctx := r.Context()
+	_, outbound := runtime.MarshalerForRequest(mux, r)
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		runtime.HTTPError(ctx, mux, outbound, w, r, status.Error(codes.Internal, "streaming not supported"))
+		return
+	}
+
+	s := &httpStream{
+		ctx:       ctx,
+		w:         w,
+		flusher:   flusher,
+		marshaler: outbound,
+		sse:       strings.Contains(r.Header.Get("Accept"), "text/event-stream"),
+	}
+	if s.sse {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	if err := call(s); err != nil {
+		if writeErr := s.writeError(err); writeErr != nil {
+			app.AugmentLogFromCtx(ctx, log).Errorf("writing stream error: %v", writeErr)
+		}
+	}
+}
+
+// httpStream is a server stream writing messages to http response.
+type httpStream struct {
+	ctx       context.Context
+	w         http.ResponseWriter
+	flusher   http.Flusher
+	marshaler runtime.Marshaler
+	sse       bool
+}
+
+// SetHeader does nothing, headers are sent before the call.
+func (s *httpStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+// SendHeader does nothing, headers are sent before the call.
+func (s *httpStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+// SetTrailer does nothing, http stream has no trailers.
+func (s *httpStream) SetTrailer(metadata.MD) {}
+
+// Context returns request context.
+func (s *httpStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg writes message to the response.
+func (s *httpStream) SendMsg(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	data, err := s.marshaler.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("encoding message: %w", err)
+	}
+	return s.write("result", data)
+}
+
+// RecvMsg returns io.EOF, server streaming methods have only one request message.
+func (s *httpStream) RecvMsg(interface{}) error {
+	return io.EOF
+}
+
+func (s *httpStream) writeError(err error) error {
+	data, marshalErr := s.marshaler.Marshal(status.Convert(err).Proto())
+	if marshalErr != nil {
+		return fmt.Errorf("encoding error: %w", marshalErr)
+	}
+	return s.write("error", data)
+}
+
+func (s *httpStream) write(field string, data []byte) error {
+	// Gateway marshaler writes JSON in one line, so it fits in one line of the stream.
+	var err error
+	switch {
+	case !s.sse:
+		_, err = fmt.Fprintf(s.w, "{%q:%s}\n", field, data)
+	case field == "error":
+		_, err = fmt.Fprintf(s.w, "event: error\ndata: %s\n\n", data)
+	default:
+		_, err = fmt.Fprintf(s.w, "data: %s\n\n", data)
+	}
+	if err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+type watchReservationsStream struct {
+	*httpStream
+}
+
+func (s watchReservationsStream) Send(m *bikerentalv1.ReservationChange) error {
+	return s.SendMsg(m)
+}
+
+type watchBikeAvailabilityStream struct {
+	*httpStream
+}
+
+func (s watchBikeAvailabilityStream) Send(m *bikerentalv1.BikeAvailabilityChange) error {
+	return s.SendMsg(m)
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// IdempotencyKeyHeader is a header with idempotency key chosen by the client.
+// Retries of a call with the same key get response of the first call.
+const IdempotencyKeyHeader = "idempotency-key"
+
+// runIdempotent runs the call once per idempotency key from request metadata, and writes its response to resp.
+// Calls without the key are just run.
+// Errors of the call are returned unchanged, other errors are converted to server errors.
+func (s *Server) runIdempotent(
+	ctx context.Context,
+	method string,
+	req, resp proto.Message,
+	call func(context.Context) (proto.Message, error),
+) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	key := firstValue(md.Get(IdempotencyKeyHeader))
+	if key == "" {
+		r, err := call(ctx)
+		if err != nil {
+			return err
+		}
+		proto.Merge(resp, r)
+		return nil
+	}
+
+	request, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		s.logError(ctx, err, method)
+		return NewServerError(err)
+	}
+
+	var callErr error
+	response, err := s.idempotencyService.Run(
+		ctx,
+		bikerental.IdempotentCall{
+			Key:     key,
+			Method:  method,
+			Request: request,
+		},
+		func(ctx context.Context) ([]byte, error) {
+			r, err := call(ctx)
+			if err != nil {
+				callErr = err
+				return nil, err
+			}
+			return proto.Marshal(r)
+		},
+	)
+	if callErr != nil {
+		return callErr
+	}
+	if err != nil {
+		s.logError(ctx, err, method)
+		return NewServerError(err)
+	}
+
+	if err := proto.Unmarshal(response, resp); err != nil {
+		s.logError(ctx, err, method)
+		return NewServerError(err)
+	}
+	return nil
+}
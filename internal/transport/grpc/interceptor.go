@@ -3,25 +3,34 @@ package grpc
 import (
 	context "context"
 
-	"github.com/google/uuid"
 	"github.com/nglogic/go-application-guide/internal/app"
 	grpc "google.golang.org/grpc"
 )
 
-// TraceIDUnaryServerInterceptor returns a new unary server interceptor for generating trace id.
-func TraceIDUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+// LogCtxUnaryServerInterceptor returns a new unary server interceptor adding request information to context for logging.
+func LogCtxUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		ctx = app.CtxWithTraceID(ctx, uuid.NewString())
+		ctx = app.CtxWithLogField(ctx, "grpc.method", info.FullMethod)
 
 		return handler(ctx, req)
 	}
 }
 
-// LogCtxUnaryServerInterceptor returns a new unary server interceptor adding request information to context for logging.
-func LogCtxUnaryServerInterceptor() grpc.UnaryServerInterceptor {
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		ctx = app.CtxWithLogField(ctx, "grpc.method", info.FullMethod)
+// LogCtxStreamServerInterceptor returns a new stream server interceptor adding request information to context for logging.
+func LogCtxStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := app.CtxWithLogField(ss.Context(), "grpc.method", info.FullMethod)
 
-		return handler(ctx, req)
+		return handler(srv, &serverStreamWithCtx{ServerStream: ss, ctx: ctx})
 	}
 }
+
+// serverStreamWithCtx is a server stream with context replaced by interceptor.
+type serverStreamWithCtx struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStreamWithCtx) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// RPCMetrics are prometheus metrics of rpc calls, made with grpc or with http gateway.
+// Calls are labeled with rpc method names, e.g. "CreateReservation".
+type RPCMetrics struct {
+	grpcDuration *prometheus.HistogramVec
+	grpcStreams  *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+}
+
+// NewRPCMetrics creates new metrics instance, and registers its metrics.
+func NewRPCMetrics(reg prometheus.Registerer) (*RPCMetrics, error) {
+	if reg == nil {
+		return nil, errors.New("registerer is required")
+	}
+
+	m := &RPCMetrics{
+		grpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "bikerental",
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Duration of unary grpc calls by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		grpcStreams: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bikerental",
+			Name:      "grpc_streams_total",
+			Help:      "Number of finished grpc streams by method and status code.",
+		}, []string{"method", "code"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "bikerental",
+			Name:      "http_request_duration_seconds",
+			Help:      "Duration of http gateway requests by rpc method and http status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+	}
+	for _, c := range []prometheus.Collector{m.grpcDuration, m.grpcStreams, m.httpDuration} {
+		if err := reg.Register(c); err != nil {
+			return nil, fmt.Errorf("registering rpc metrics: %w", err)
+		}
+	}
+	return m, nil
+}
+
+// ObserveHTTPRequest records duration and status code of http gateway request.
+// Method is empty for requests not matching any rpc method.
+func (m *RPCMetrics) ObserveHTTPRequest(method string, statusCode int, duration time.Duration) {
+	if method == "" {
+		method = "unknown"
+	}
+	m.httpDuration.WithLabelValues(method, strconv.Itoa(statusCode)).Observe(duration.Seconds())
+}
+
+// MetricsUnaryServerInterceptor returns a new unary server interceptor recording duration and status codes of calls.
+// It has to be the first interceptor, so calls rejected by other interceptors are recorded too.
+func MetricsUnaryServerInterceptor(m *RPCMetrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.grpcDuration.
+			WithLabelValues(path.Base(info.FullMethod), status.Code(err).String()).
+			Observe(time.Since(start).Seconds())
+		return resp, err
+	}
+}
+
+// MetricsStreamServerInterceptor returns a new stream server interceptor counting finished streams by status code.
+// Streams are open until clients disconnect, so their duration is not recorded.
+func MetricsStreamServerInterceptor(m *RPCMetrics) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		m.grpcStreams.WithLabelValues(path.Base(info.FullMethod), status.Code(err).String()).Inc()
+		return err
+	}
+}
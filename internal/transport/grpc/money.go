@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/nglogic/go-application-guide/pkg/api/bikerentalv1"
+)
+
+const nanosPerUnit = 1000000000
+
+// newAppMoney converts api money to amount in minor units of the currency.
+// Returns validation error if amount is more precise than currency minor unit.
+func newAppMoney(m *bikerentalv1.Money) (bikerental.Money, error) {
+	currency := bikerental.Currency(m.CurrencyCode)
+	if err := currency.Validate(); err != nil {
+		return bikerental.Money{}, err
+	}
+	if m.Nanos <= -nanosPerUnit || m.Nanos >= nanosPerUnit {
+		return bikerental.Money{}, app.NewValidationError("nanos have to be in (-1e9, 1e9) range")
+	}
+	if (m.Units > 0 && m.Nanos < 0) || (m.Units < 0 && m.Nanos > 0) {
+		return bikerental.Money{}, app.NewValidationError("units and nanos have to have the same sign")
+	}
+
+	minorUnit := pow10(currency.MinorUnits())
+	nanosPerMinorUnit := int32(nanosPerUnit / minorUnit)
+	if m.Nanos%nanosPerMinorUnit != 0 {
+		return bikerental.Money{}, app.NewValidationError(fmt.Sprintf("amount is more precise than %s minor unit", currency))
+	}
+	if m.Units > math.MaxInt64/minorUnit || m.Units < math.MinInt64/minorUnit {
+		return bikerental.Money{}, app.NewValidationError("amount is too large")
+	}
+
+	return bikerental.NewMoney(m.Units*minorUnit+int64(m.Nanos/nanosPerMinorUnit), currency), nil
+}
+
+// newAppMoneyOrLegacy converts api money, or deprecated integer field in minor units if money is not set.
+func newAppMoneyOrLegacy(m *bikerentalv1.Money, legacy int32, legacyCurrency bikerental.Currency) (bikerental.Money, error) {
+	if m == nil {
+		return bikerental.NewMoney(int64(legacy), legacyCurrency), nil
+	}
+	return newAppMoney(m)
+}
+
+func newResponseMoney(m bikerental.Money) *bikerentalv1.Money {
+	if m.Currency == "" {
+		return nil
+	}
+	minorUnit := pow10(m.Currency.MinorUnits())
+	return &bikerentalv1.Money{
+		CurrencyCode: string(m.Currency),
+		Units:        m.Amount / minorUnit,
+		Nanos:        int32(m.Amount%minorUnit) * int32(nanosPerUnit/minorUnit),
+	}
+}
+
+// newResponseLegacyAmount returns amount in minor units for deprecated integer fields.
+// Amounts out of int32 range are clamped.
+func newResponseLegacyAmount(m bikerental.Money) int32 {
+	switch {
+	case m.Amount > math.MaxInt32:
+		return math.MaxInt32
+	case m.Amount < math.MinInt32:
+		return math.MinInt32
+	default:
+		return int32(m.Amount)
+	}
+}
+
+func pow10(n int) int64 {
+	result := int64(1)
+	for i := 0; i < n; i++ {
+		result *= 10
+	}
+	return result
+}
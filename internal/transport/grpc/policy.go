@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	context "context"
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/pkg/api/bikerentalv1"
+)
+
+// Sets of roles allowed to call methods.
+var (
+	anyRole   = []app.Role{app.RoleAdmin, app.RoleStaff, app.RoleCustomer}
+	staffRole = []app.Role{app.RoleAdmin, app.RoleStaff}
+	adminRole = []app.Role{app.RoleAdmin}
+)
+
+// methodRoles are roles allowed to call service methods.
+// Methods available for customers check in app services if the customer owns requested data,
+// and if customers are allowed to change its state, e.g. customers can cancel only approved reservations.
+var methodRoles = map[string][]app.Role{
+	"ListBikes":             anyRole,
+	"GetBike":               anyRole,
+	"CreateBike":            adminRole,
+	"UpdateBike":            adminRole,
+	"DeleteBike":            adminRole,
+	"GetBikeAvailability":   anyRole,
+	"ListReservations":      anyRole,
+	"GetPriceQuote":         anyRole,
+	"CreateReservation":     anyRole,
+	"CancelReservation":     anyRole,
+	"CompleteReservation":   staffRole,
+	"RefundReservation":     staffRole,
+	"GetReservationInvoice": anyRole,
+	"WatchReservations":     staffRole,
+	"WatchBikeAvailability": staffRole,
+
+	"FileDamageReport":     staffRole,
+	"ListDamageReports":    staffRole,
+	"GetDamageReportPhoto": staffRole,
+
+	"ListPromoCodes":  adminRole,
+	"CreatePromoCode": adminRole,
+
+	"GetLoyaltyAccount":  anyRole,
+	"ListLoyaltyEntries": anyRole,
+
+	"ListCompanies":       staffRole,
+	"GetCompany":          staffRole,
+	"CreateCompany":       adminRole,
+	"UpdateCompany":       adminRole,
+	"ListCompanyMembers":  staffRole,
+	"AddCompanyMember":    adminRole,
+	"RemoveCompanyMember": adminRole,
+	"GetCompanyStatement": staffRole,
+
+	"ListBlocklist":       staffRole,
+	"AddToBlocklist":      adminRole,
+	"RemoveFromBlocklist": adminRole,
+
+	"ListWebhookSubscriptions":  adminRole,
+	"CreateWebhookSubscription": adminRole,
+	"DeleteWebhookSubscription": adminRole,
+	"ListWebhookDeliveries":     adminRole,
+	"RedeliverWebhook":          adminRole,
+
+	"ListAPIKeys":  adminRole,
+	"IssueAPIKey":  adminRole,
+	"RevokeAPIKey": adminRole,
+
+	"ListOpenIntervals": anyRole,
+}
+
+// checkMethodRoles returns error if any service method has no roles defined,
+// so new methods are not available until they are added to the policy.
+func checkMethodRoles() error {
+	methods := bikerentalv1.File_nglogic_bikerental_v1_service_proto.Services().ByName("BikeRentalService").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		name := string(methods.Get(i).Name())
+		if _, ok := methodRoles[name]; !ok {
+			return fmt.Errorf("no roles defined for method %s", name)
+		}
+	}
+	return nil
+}
+
+// authorize checks if the caller's role is allowed to call the method.
+// It's called by server methods, not an interceptor, because http gateway calls them directly.
+func (s *Server) authorize(ctx context.Context, method string) error {
+	caller, ok := app.IdentityFromCtx(ctx)
+	if !ok {
+		return NewServerError(app.ErrUnauthenticated)
+	}
+	for _, r := range methodRoles[method] {
+		if r == caller.Role {
+			return nil
+		}
+	}
+	return NewServerError(app.NewForbiddenError(fmt.Sprintf("role '%s' can't call %s", caller.Role, method)))
+}
@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// RetryAfterHeader is a header with delay in seconds after which rate limited call can be retried.
+const RetryAfterHeader = "retry-after"
+
+// IPRateLimitUnaryServerInterceptor returns a new unary server interceptor limiting calls per IP address.
+// It has to be chained before authentication, so callers with invalid credentials are limited too.
+func IPRateLimitUnaryServerInterceptor(limits bikerental.RateLimitService, log logrus.FieldLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkIPRateLimitFromPeer(ctx, limits, log, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// IPRateLimitStreamServerInterceptor returns a new stream server interceptor limiting calls per IP address.
+// Stream is counted as one call, when it's opened.
+func IPRateLimitStreamServerInterceptor(limits bikerental.RateLimitService, log logrus.FieldLogger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkIPRateLimitFromPeer(ss.Context(), limits, log, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// RateLimitUnaryServerInterceptor returns a new unary server interceptor limiting calls of API clients.
+// It has to be chained after authentication, so clients are identified by caller identity.
+func RateLimitUnaryServerInterceptor(limits bikerental.RateLimitService, log logrus.FieldLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkRateLimitOfCaller(ctx, limits, log, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStreamServerInterceptor returns a new stream server interceptor limiting calls of API clients.
+// Stream is counted as one call, when it's opened.
+func RateLimitStreamServerInterceptor(limits bikerental.RateLimitService, log logrus.FieldLogger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkRateLimitOfCaller(ss.Context(), limits, log, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func checkIPRateLimitFromPeer(ctx context.Context, limits bikerental.RateLimitService, log logrus.FieldLogger, fullMethod string) error {
+	// Probes are not API clients.
+	if isHealthMethod(fullMethod) {
+		return nil
+	}
+
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+
+	return setRetryAfterHeader(ctx, CheckIPRateLimit(ctx, limits, log, addr))
+}
+
+func checkRateLimitOfCaller(ctx context.Context, limits bikerental.RateLimitService, log logrus.FieldLogger, fullMethod string) error {
+	// Probes are not API clients.
+	if isHealthMethod(fullMethod) {
+		return nil
+	}
+
+	return setRetryAfterHeader(ctx, CheckRateLimit(ctx, limits, log, path.Base(fullMethod)))
+}
+
+func setRetryAfterHeader(ctx context.Context, err error) error {
+	if retryAfter, ok := RetryAfter(err); ok {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, retryAfter))
+	}
+	return err
+}
+
+// CheckIPRateLimit counts the call from the remote address, all methods together.
+// Returns server error with codes.ResourceExhausted if the address exceeded its limit.
+// Calls are allowed if limits can't be checked, limiter errors are only logged.
+func CheckIPRateLimit(ctx context.Context, limits bikerental.RateLimitService, log logrus.FieldLogger, remoteAddr string) error {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	return rateLimitResult(ctx, log, limits.CheckIPRateLimit(ctx, host))
+}
+
+// CheckRateLimit counts the call of the caller from context to the method.
+// Returns server error with codes.ResourceExhausted if the client exceeded its limits,
+// or codes.Unauthenticated if the caller is unknown.
+// Calls are allowed if limits can't be checked, limiter errors are only logged.
+func CheckRateLimit(ctx context.Context, limits bikerental.RateLimitService, log logrus.FieldLogger, method string) error {
+	caller, ok := app.IdentityFromCtx(ctx)
+	if !ok {
+		return NewServerError(app.ErrUnauthenticated)
+	}
+	client := fmt.Sprintf("%s:%s/%s", caller.Method, caller.TenantID, caller.Subject)
+	return rateLimitResult(ctx, log, limits.CheckRateLimit(ctx, client, method))
+}
+
+func rateLimitResult(ctx context.Context, log logrus.FieldLogger, err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case app.IsRateLimitError(err):
+		return NewServerError(err)
+	default:
+		app.AugmentLogFromCtx(ctx, log).Errorf("checking rate limit: %v", err)
+		return nil
+	}
+}
@@ -1,16 +1,38 @@
 package grpc
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
 	"github.com/nglogic/go-application-guide/pkg/api/bikerentalv1"
 )
 
-func newAppBikeFromRequestData(data *bikerentalv1.BikeData) *bikerental.Bike {
+func newAppBikeFromRequestData(data *bikerentalv1.BikeData) (*bikerental.Bike, error) {
+	var t bikerental.BikeType
+	switch data.Type {
+	case bikerentalv1.BikeType_BIKE_TYPE_ELECTRIC:
+		t = bikerental.BikeTypeElectric
+	case bikerentalv1.BikeType_BIKE_TYPE_CARGO:
+		t = bikerental.BikeTypeCargo
+	default:
+		t = bikerental.BikeTypeStandard
+	}
+
+	price, err := newAppMoneyOrLegacy(data.HourlyPrice, data.PricePerHour, bikerental.BaseCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hourly price: %w", err)
+	}
+
 	return &bikerental.Bike{
+		Type:         t,
 		ModelName:    data.ModelName,
 		Weight:       float64(data.Weight),
-		PricePerHour: int(data.PricePerHour),
-	}
+		PricePerHour: price,
+		OutOfService: data.OutOfService,
+		StationID:    data.StationId,
+	}, nil
 }
 
 func newAppCustomerFromRequest(rc *bikerentalv1.Customer) *bikerental.Customer {
@@ -19,20 +41,24 @@ func newAppCustomerFromRequest(rc *bikerentalv1.Customer) *bikerental.Customer {
 	}
 
 	data := rc.GetData()
-	ct := bikerental.CustomerTypeUnknown
-	switch data.GetType() {
-	case bikerentalv1.CustomerType_CUSTOMER_TYPE_INDIVIDUAL:
-		ct = bikerental.CustomerTypeIndividual
-	case bikerentalv1.CustomerType_CUSTOMER_TYPE_BUSINESS:
-		ct = bikerental.CustomerTypeBusiness
-	}
-
 	return &bikerental.Customer{
 		ID:        rc.Id,
-		Type:      ct,
+		Type:      newAppCustomerType(data.GetType()),
 		FirstName: data.GetFirstName(),
 		Surname:   data.GetSurname(),
 		Email:     data.GetEmail(),
+		VATID:     data.GetVatId(),
+	}
+}
+
+func newAppCustomerType(ct bikerentalv1.CustomerType) bikerental.CustomerType {
+	switch ct {
+	case bikerentalv1.CustomerType_CUSTOMER_TYPE_INDIVIDUAL:
+		return bikerental.CustomerTypeIndividual
+	case bikerentalv1.CustomerType_CUSTOMER_TYPE_BUSINESS:
+		return bikerental.CustomerTypeBusiness
+	default:
+		return bikerental.CustomerTypeUnknown
 	}
 }
 
@@ -46,3 +72,149 @@ func newAppLocationFromRequest(rl *bikerentalv1.Location) *bikerental.Location {
 		Long: float64(rl.Long),
 	}
 }
+
+func newAppFileDamageReportRequest(r *bikerentalv1.FileDamageReportRequest) (bikerental.FileDamageReportRequest, error) {
+	var severity bikerental.DamageSeverity
+	switch r.Severity {
+	case bikerentalv1.DamageSeverity_DAMAGE_SEVERITY_LOW:
+		severity = bikerental.DamageSeverityLow
+	case bikerentalv1.DamageSeverity_DAMAGE_SEVERITY_MEDIUM:
+		severity = bikerental.DamageSeverityMedium
+	case bikerentalv1.DamageSeverity_DAMAGE_SEVERITY_HIGH:
+		severity = bikerental.DamageSeverityHigh
+	default:
+		severity = bikerental.DamageSeverityUnknown
+	}
+
+	photos := make([]bikerental.DamagePhoto, 0, len(r.Photos))
+	for _, p := range r.Photos {
+		photos = append(photos, bikerental.DamagePhoto{
+			ContentType: p.ContentType,
+			Data:        p.Data,
+		})
+	}
+
+	// Deprecated field has no currency, reservation currency is used.
+	repairCost, err := newAppMoneyOrLegacy(r.RepairCost, r.RepairCostEstimate, "")
+	if err != nil {
+		return bikerental.FileDamageReportRequest{}, fmt.Errorf("invalid repair cost: %w", err)
+	}
+
+	return bikerental.FileDamageReportRequest{
+		ReservationID:      r.ReservationId,
+		BikeID:             r.BikeId,
+		Description:        r.Description,
+		Severity:           severity,
+		RepairCostEstimate: repairCost,
+		Photos:             photos,
+	}, nil
+}
+
+func newAppPromoCodeFromRequest(p *bikerentalv1.PromoCode) (bikerental.PromoCode, error) {
+	var t bikerental.PromoCodeType
+	switch p.Type {
+	case bikerentalv1.PromoCodeType_PROMO_CODE_TYPE_PERCENTAGE:
+		t = bikerental.PromoCodeTypePercentage
+	case bikerentalv1.PromoCodeType_PROMO_CODE_TYPE_FIXED:
+		t = bikerental.PromoCodeTypeFixed
+	default:
+		t = bikerental.PromoCodeTypeUnknown
+	}
+
+	var expiresAt time.Time
+	if p.ExpiresAt != nil {
+		expiresAt = p.ExpiresAt.AsTime()
+	}
+
+	amount, err := newAppMoneyOrLegacy(p.FixedAmount, p.Amount, bikerental.BaseCurrency)
+	if err != nil {
+		return bikerental.PromoCode{}, fmt.Errorf("invalid fixed amount: %w", err)
+	}
+
+	return bikerental.PromoCode{
+		Code:                      p.Code,
+		Type:                      t,
+		Percent:                   p.Percent,
+		Amount:                    amount,
+		ExpiresAt:                 expiresAt,
+		MaxRedemptions:            int(p.MaxRedemptions),
+		MaxRedemptionsPerCustomer: int(p.MaxRedemptionsPerCustomer),
+		CustomerType:              newAppCustomerType(p.CustomerType),
+		BikeModelName:             p.BikeModelName,
+		Stackable:                 p.Stackable,
+	}, nil
+}
+
+func newAppCompanyFromRequestData(data *bikerentalv1.CompanyData) (bikerental.Company, error) {
+	limit, err := newAppMoneyOrLegacy(data.MonthlySpendingLimit, data.SpendingLimit, bikerental.BaseCurrency)
+	if err != nil {
+		return bikerental.Company{}, fmt.Errorf("invalid monthly spending limit: %w", err)
+	}
+
+	return bikerental.Company{
+		Name:            data.Name,
+		DiscountPercent: data.DiscountPercent,
+		SpendingLimit:   limit,
+	}, nil
+}
+
+func newAppBlocklistEntryFromRequest(e *bikerentalv1.BlocklistEntry) bikerental.BlocklistEntry {
+	var kind bikerental.BlocklistEntryKind
+	switch e.Kind {
+	case bikerentalv1.BlocklistEntryKind_BLOCKLIST_ENTRY_KIND_CUSTOMER_ID:
+		kind = bikerental.BlocklistEntryKindCustomerID
+	case bikerentalv1.BlocklistEntryKind_BLOCKLIST_ENTRY_KIND_EMAIL_DOMAIN:
+		kind = bikerental.BlocklistEntryKindEmailDomain
+	default:
+		kind = bikerental.BlocklistEntryKindUnknown
+	}
+
+	return bikerental.BlocklistEntry{
+		Kind:   kind,
+		Value:  e.Value,
+		Reason: e.Reason,
+	}
+}
+
+func newAppWebhookSubscriptionFromRequest(s *bikerentalv1.WebhookSubscription) bikerental.WebhookSubscription {
+	types := make([]bikerental.EventType, 0, len(s.EventTypes))
+	for _, t := range s.EventTypes {
+		types = append(types, bikerental.EventType(t))
+	}
+	return bikerental.WebhookSubscription{
+		ID:         s.Id,
+		URL:        s.Url,
+		EventTypes: types,
+		Secret:     s.Secret,
+	}
+}
+
+func newAppListWebhookDeliveriesRequest(req *bikerentalv1.ListWebhookDeliveriesRequest) bikerental.ListWebhookDeliveriesRequest {
+	var st bikerental.WebhookDeliveryStatus
+	switch req.Status {
+	case bikerentalv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:
+		st = bikerental.WebhookDeliveryStatusPending
+	case bikerentalv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED:
+		st = bikerental.WebhookDeliveryStatusDelivered
+	case bikerentalv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD:
+		st = bikerental.WebhookDeliveryStatusDead
+	}
+	return bikerental.ListWebhookDeliveriesRequest{
+		SubscriptionID: req.SubscriptionId,
+		Status:         st,
+		Limit:          int(req.Limit),
+	}
+}
+
+func newAppRole(r bikerentalv1.Role) app.Role {
+	switch r {
+	case bikerentalv1.Role_ROLE_ADMIN:
+		return app.RoleAdmin
+	case bikerentalv1.Role_ROLE_STAFF:
+		return app.RoleStaff
+	case bikerentalv1.Role_ROLE_CUSTOMER:
+		return app.RoleCustomer
+	default:
+		return app.RoleEmpty
+	}
+}
@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"github.com/nglogic/go-application-guide/internal/app"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
 	"github.com/nglogic/go-application-guide/pkg/api/bikerentalv1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -21,16 +22,71 @@ func newResponseBike(b *bikerental.Bike) *bikerentalv1.Bike {
 	if b == nil {
 		return nil
 	}
+	var t bikerentalv1.BikeType
+	switch b.Type {
+	case bikerental.BikeTypeStandard:
+		t = bikerentalv1.BikeType_BIKE_TYPE_STANDARD
+	case bikerental.BikeTypeElectric:
+		t = bikerentalv1.BikeType_BIKE_TYPE_ELECTRIC
+	case bikerental.BikeTypeCargo:
+		t = bikerentalv1.BikeType_BIKE_TYPE_CARGO
+	default:
+		t = bikerentalv1.BikeType_BIKE_TYPE_UNKNOWN
+	}
+
 	return &bikerentalv1.Bike{
 		Id: b.ID,
 		Data: &bikerentalv1.BikeData{
+			Type:         t,
 			ModelName:    b.ModelName,
 			Weight:       float32(b.Weight),
-			PricePerHour: int32(b.PricePerHour),
+			PricePerHour: newResponseLegacyAmount(b.PricePerHour),
+			HourlyPrice:  newResponseMoney(b.PricePerHour),
+			OutOfService: b.OutOfService,
+			StationId:    b.StationID,
 		},
 	}
 }
 
+func newResponsePriceQuote(q *bikerental.PriceQuote) *bikerentalv1.PriceQuote {
+	if q == nil {
+		return nil
+	}
+
+	items := make([]*bikerentalv1.PriceQuoteItem, 0, len(q.Items))
+	for _, it := range q.Items {
+		items = append(items, &bikerentalv1.PriceQuoteItem{
+			StartTime:    timestamppb.New(it.StartTime),
+			EndTime:      timestamppb.New(it.EndTime),
+			Description:  it.Description,
+			PricePerHour: newResponseLegacyAmount(it.PricePerHour),
+			HourlyPrice:  newResponseMoney(it.PricePerHour),
+			ChargedHours: it.ChargedHours,
+			Value:        newResponseLegacyAmount(it.Value),
+			Amount:       newResponseMoney(it.Value),
+		})
+	}
+	return &bikerentalv1.PriceQuote{
+		Items:      items,
+		TotalValue: newResponseLegacyAmount(q.TotalValue),
+		Total:      newResponseMoney(q.TotalValue),
+	}
+}
+
+func newListOpenIntervalsResponse(intervals []bikerental.OpenInterval) *bikerentalv1.ListOpenIntervalsResponse {
+	respIntervals := make([]*bikerentalv1.OpenInterval, 0, len(intervals))
+	for _, i := range intervals {
+		respIntervals = append(respIntervals, &bikerentalv1.OpenInterval{
+			StartTime: timestamppb.New(i.StartTime),
+			EndTime:   timestamppb.New(i.EndTime),
+		})
+	}
+
+	return &bikerentalv1.ListOpenIntervalsResponse{
+		Intervals: respIntervals,
+	}
+}
+
 func newCreateReservationResponse(r *bikerental.ReservationResponse) *bikerentalv1.CreateReservationResponse {
 	if r == nil {
 		return nil
@@ -48,14 +104,54 @@ func newResponseReservation(r *bikerental.Reservation) *bikerentalv1.Reservation
 		return nil
 	}
 	return &bikerentalv1.Reservation{
-		Id:              r.ID,
-		Status:          newResponseReservationStatus(r.Status),
-		Customer:        newResponseCustomer(&r.Customer),
-		Bike:            newResponseBike(&r.Bike),
-		StartTime:       timestamppb.New(r.StartTime),
-		EndTime:         timestamppb.New(r.EndTime),
-		TotalValue:      int32(r.TotalValue),
-		AppliedDiscount: int32(r.AppliedDiscount),
+		Id:               r.ID,
+		Status:           newResponseReservationStatus(r.Status),
+		Customer:         newResponseCustomer(&r.Customer),
+		Bike:             newResponseBike(&r.Bike),
+		StartTime:        timestamppb.New(r.StartTime),
+		EndTime:          timestamppb.New(r.EndTime),
+		TotalValue:       newResponseLegacyAmount(r.TotalValue),
+		AppliedDiscount:  newResponseLegacyAmount(r.AppliedDiscount),
+		PromoCode:        r.PromoCode,
+		RedeemedPoints:   int32(r.RedeemedPoints),
+		PaymentStatus:    newResponsePaymentStatus(r.PaymentStatus),
+		DepositAmount:    newResponseLegacyAmount(r.DepositAmount),
+		DepositStatus:    newResponseDepositStatus(r.DepositStatus),
+		DepositForfeited: newResponseLegacyAmount(r.DepositForfeited),
+		Total:            newResponseMoney(r.TotalValue),
+		Discount:         newResponseMoney(r.AppliedDiscount),
+		Deposit:          newResponseMoney(r.DepositAmount),
+		ForfeitedDeposit: newResponseMoney(r.DepositForfeited),
+		Tax:              newResponseReservationTax(r.Tax),
+		DiscountRule:     r.DiscountRule,
+	}
+}
+
+func newResponseDepositStatus(s bikerental.DepositStatus) bikerentalv1.DepositStatus {
+	switch s {
+	case bikerental.DepositStatusHeld:
+		return bikerentalv1.DepositStatus_DEPOSIT_STATUS_HELD
+	case bikerental.DepositStatusReleased:
+		return bikerentalv1.DepositStatus_DEPOSIT_STATUS_RELEASED
+	case bikerental.DepositStatusForfeited:
+		return bikerentalv1.DepositStatus_DEPOSIT_STATUS_FORFEITED
+	default:
+		return bikerentalv1.DepositStatus_DEPOSIT_STATUS_NONE
+	}
+}
+
+func newResponsePaymentStatus(s bikerental.PaymentStatus) bikerentalv1.PaymentStatus {
+	switch s {
+	case bikerental.PaymentStatusAuthorized:
+		return bikerentalv1.PaymentStatus_PAYMENT_STATUS_AUTHORIZED
+	case bikerental.PaymentStatusCaptured:
+		return bikerentalv1.PaymentStatus_PAYMENT_STATUS_CAPTURED
+	case bikerental.PaymentStatusRefunded:
+		return bikerentalv1.PaymentStatus_PAYMENT_STATUS_REFUNDED
+	case bikerental.PaymentStatusVoided:
+		return bikerentalv1.PaymentStatus_PAYMENT_STATUS_VOIDED
+	default:
+		return bikerentalv1.PaymentStatus_PAYMENT_STATUS_NONE
 	}
 }
 
@@ -63,26 +159,30 @@ func newResponseCustomer(c *bikerental.Customer) *bikerentalv1.Customer {
 	if c == nil {
 		return nil
 	}
-	var t bikerentalv1.CustomerType
-	switch c.Type {
-	case bikerental.CustomerTypeIndividual:
-		t = bikerentalv1.CustomerType_CUSTOMER_TYPE_INDIVIDUAL
-	case bikerental.CustomerTypeBusiness:
-		t = bikerentalv1.CustomerType_CUSTOMER_TYPE_BUSINESS
-	default:
-		t = bikerentalv1.CustomerType_CUSTOMER_TYPE_UNKNOWN
-	}
 	return &bikerentalv1.Customer{
 		Id: c.ID,
 		Data: &bikerentalv1.CustomerData{
-			Type:      t,
+			Type:      newResponseCustomerType(c.Type),
 			FirstName: c.FirstName,
 			Surname:   c.Surname,
 			Email:     c.Email,
+			CompanyId: c.CompanyID,
+			VatId:     c.VATID,
 		},
 	}
 }
 
+func newResponseCustomerType(ct bikerental.CustomerType) bikerentalv1.CustomerType {
+	switch ct {
+	case bikerental.CustomerTypeIndividual:
+		return bikerentalv1.CustomerType_CUSTOMER_TYPE_INDIVIDUAL
+	case bikerental.CustomerTypeBusiness:
+		return bikerentalv1.CustomerType_CUSTOMER_TYPE_BUSINESS
+	default:
+		return bikerentalv1.CustomerType_CUSTOMER_TYPE_UNKNOWN
+	}
+}
+
 func newResponseReservationStatus(s bikerental.ReservationStatus) bikerentalv1.ReservationStatus {
 	var status bikerentalv1.ReservationStatus
 	switch s {
@@ -92,8 +192,458 @@ func newResponseReservationStatus(s bikerental.ReservationStatus) bikerentalv1.R
 		status = bikerentalv1.ReservationStatus_RESERVATION_STATUS_REJECTED
 	case bikerental.ReservationStatusCanceled:
 		status = bikerentalv1.ReservationStatus_RESERVATION_STATUS_CANCELLED
+	case bikerental.ReservationStatusCompleted:
+		status = bikerentalv1.ReservationStatus_RESERVATION_STATUS_COMPLETED
 	default:
 		status = bikerentalv1.ReservationStatus_RESERVATION_STATUS_UNKNOWN
 	}
 	return status
 }
+
+func newResponseDamageReport(r *bikerental.DamageReport) *bikerentalv1.DamageReport {
+	if r == nil {
+		return nil
+	}
+
+	var severity bikerentalv1.DamageSeverity
+	switch r.Severity {
+	case bikerental.DamageSeverityLow:
+		severity = bikerentalv1.DamageSeverity_DAMAGE_SEVERITY_LOW
+	case bikerental.DamageSeverityMedium:
+		severity = bikerentalv1.DamageSeverity_DAMAGE_SEVERITY_MEDIUM
+	case bikerental.DamageSeverityHigh:
+		severity = bikerentalv1.DamageSeverity_DAMAGE_SEVERITY_HIGH
+	default:
+		severity = bikerentalv1.DamageSeverity_DAMAGE_SEVERITY_UNKNOWN
+	}
+
+	return &bikerentalv1.DamageReport{
+		Id:                 r.ID,
+		ReservationId:      r.ReservationID,
+		BikeId:             r.BikeID,
+		Description:        r.Description,
+		Severity:           severity,
+		RepairCostEstimate: newResponseLegacyAmount(r.RepairCostEstimate),
+		RepairCost:         newResponseMoney(r.RepairCostEstimate),
+		PhotoIds:           r.PhotoIDs,
+		CreatedAt:          timestamppb.New(r.CreatedAt),
+	}
+}
+
+func newListPromoCodesResponse(codes []bikerental.PromoCode) *bikerentalv1.ListPromoCodesResponse {
+	respCodes := make([]*bikerentalv1.PromoCode, 0, len(codes))
+	for i := range codes {
+		respCodes = append(respCodes, newResponsePromoCode(&codes[i]))
+	}
+
+	return &bikerentalv1.ListPromoCodesResponse{
+		PromoCodes: respCodes,
+	}
+}
+
+func newResponsePromoCode(p *bikerental.PromoCode) *bikerentalv1.PromoCode {
+	if p == nil {
+		return nil
+	}
+
+	var t bikerentalv1.PromoCodeType
+	switch p.Type {
+	case bikerental.PromoCodeTypePercentage:
+		t = bikerentalv1.PromoCodeType_PROMO_CODE_TYPE_PERCENTAGE
+	case bikerental.PromoCodeTypeFixed:
+		t = bikerentalv1.PromoCodeType_PROMO_CODE_TYPE_FIXED
+	default:
+		t = bikerentalv1.PromoCodeType_PROMO_CODE_TYPE_UNKNOWN
+	}
+
+	var expiresAt *timestamppb.Timestamp
+	if !p.ExpiresAt.IsZero() {
+		expiresAt = timestamppb.New(p.ExpiresAt)
+	}
+
+	var fixedAmount *bikerentalv1.Money
+	if p.Type == bikerental.PromoCodeTypeFixed {
+		fixedAmount = newResponseMoney(p.Amount)
+	}
+
+	return &bikerentalv1.PromoCode{
+		Code:                      p.Code,
+		Type:                      t,
+		Percent:                   p.Percent,
+		Amount:                    newResponseLegacyAmount(p.Amount),
+		ExpiresAt:                 expiresAt,
+		MaxRedemptions:            int32(p.MaxRedemptions),
+		MaxRedemptionsPerCustomer: int32(p.MaxRedemptionsPerCustomer),
+		CustomerType:              newResponseCustomerType(p.CustomerType),
+		BikeModelName:             p.BikeModelName,
+		Stackable:                 p.Stackable,
+		Redemptions:               int32(p.Redemptions),
+		CreatedAt:                 timestamppb.New(p.CreatedAt),
+		FixedAmount:               fixedAmount,
+	}
+}
+
+func newResponseLoyaltyAccount(a *bikerental.LoyaltyAccount) *bikerentalv1.LoyaltyAccount {
+	if a == nil {
+		return nil
+	}
+
+	var tier bikerentalv1.LoyaltyTier
+	switch a.Tier() {
+	case bikerental.LoyaltyTierSilver:
+		tier = bikerentalv1.LoyaltyTier_LOYALTY_TIER_SILVER
+	case bikerental.LoyaltyTierGold:
+		tier = bikerentalv1.LoyaltyTier_LOYALTY_TIER_GOLD
+	default:
+		tier = bikerentalv1.LoyaltyTier_LOYALTY_TIER_NONE
+	}
+
+	return &bikerentalv1.LoyaltyAccount{
+		CustomerId:   a.CustomerID,
+		Balance:      int32(a.Balance),
+		EarnedPoints: int32(a.EarnedPoints),
+		Tier:         tier,
+	}
+}
+
+func newListLoyaltyEntriesResponse(entries []bikerental.LoyaltyEntry) *bikerentalv1.ListLoyaltyEntriesResponse {
+	respEntries := make([]*bikerentalv1.LoyaltyEntry, 0, len(entries))
+	for _, e := range entries {
+		var kind bikerentalv1.LoyaltyEntryKind
+		switch e.Kind {
+		case bikerental.LoyaltyEntryKindAccrual:
+			kind = bikerentalv1.LoyaltyEntryKind_LOYALTY_ENTRY_KIND_ACCRUAL
+		case bikerental.LoyaltyEntryKindRedemption:
+			kind = bikerentalv1.LoyaltyEntryKind_LOYALTY_ENTRY_KIND_REDEMPTION
+		case bikerental.LoyaltyEntryKindReversal:
+			kind = bikerentalv1.LoyaltyEntryKind_LOYALTY_ENTRY_KIND_REVERSAL
+		case bikerental.LoyaltyEntryKindRefund:
+			kind = bikerentalv1.LoyaltyEntryKind_LOYALTY_ENTRY_KIND_REFUND
+		default:
+			kind = bikerentalv1.LoyaltyEntryKind_LOYALTY_ENTRY_KIND_UNKNOWN
+		}
+
+		respEntries = append(respEntries, &bikerentalv1.LoyaltyEntry{
+			Id:            e.ID,
+			CustomerId:    e.CustomerID,
+			ReservationId: e.ReservationID,
+			Kind:          kind,
+			Points:        int32(e.Points),
+			CreatedAt:     timestamppb.New(e.CreatedAt),
+		})
+	}
+
+	return &bikerentalv1.ListLoyaltyEntriesResponse{
+		Entries: respEntries,
+	}
+}
+
+func newListCompaniesResponse(companies []bikerental.Company) *bikerentalv1.ListCompaniesResponse {
+	respCompanies := make([]*bikerentalv1.Company, 0, len(companies))
+	for i := range companies {
+		respCompanies = append(respCompanies, newResponseCompany(&companies[i]))
+	}
+
+	return &bikerentalv1.ListCompaniesResponse{
+		Companies: respCompanies,
+	}
+}
+
+func newResponseCompany(c *bikerental.Company) *bikerentalv1.Company {
+	if c == nil {
+		return nil
+	}
+	return &bikerentalv1.Company{
+		Id: c.ID,
+		Data: &bikerentalv1.CompanyData{
+			Name:            c.Name,
+			DiscountPercent: c.DiscountPercent,
+			SpendingLimit:   newResponseLegacyAmount(c.SpendingLimit),
+			// Limit is always in base currency, even if it's not set.
+			MonthlySpendingLimit: newResponseMoney(bikerental.NewMoney(c.SpendingLimit.Amount, bikerental.BaseCurrency)),
+		},
+	}
+}
+
+func newResponseCompanyStatement(s *bikerental.CompanyStatement) *bikerentalv1.CompanyStatement {
+	if s == nil {
+		return nil
+	}
+
+	reservations := make([]*bikerentalv1.Reservation, 0, len(s.Reservations))
+	for i := range s.Reservations {
+		reservations = append(reservations, newResponseReservation(&s.Reservations[i]))
+	}
+
+	return &bikerentalv1.CompanyStatement{
+		Company:         newResponseCompany(&s.Company),
+		Year:            int32(s.Year),
+		Month:           int32(s.Month),
+		Reservations:    reservations,
+		TotalValue:      newResponseLegacyAmount(s.TotalValue),
+		AppliedDiscount: newResponseLegacyAmount(s.AppliedDiscount),
+		Total:           newResponseMoney(s.TotalValue),
+		Discount:        newResponseMoney(s.AppliedDiscount),
+	}
+}
+
+func newListBlocklistResponse(entries []bikerental.BlocklistEntry) *bikerentalv1.ListBlocklistResponse {
+	respEntries := make([]*bikerentalv1.BlocklistEntry, 0, len(entries))
+	for i := range entries {
+		respEntries = append(respEntries, newResponseBlocklistEntry(&entries[i]))
+	}
+
+	return &bikerentalv1.ListBlocklistResponse{
+		Entries: respEntries,
+	}
+}
+
+func newResponseBlocklistEntry(e *bikerental.BlocklistEntry) *bikerentalv1.BlocklistEntry {
+	if e == nil {
+		return nil
+	}
+
+	var kind bikerentalv1.BlocklistEntryKind
+	switch e.Kind {
+	case bikerental.BlocklistEntryKindCustomerID:
+		kind = bikerentalv1.BlocklistEntryKind_BLOCKLIST_ENTRY_KIND_CUSTOMER_ID
+	case bikerental.BlocklistEntryKindEmailDomain:
+		kind = bikerentalv1.BlocklistEntryKind_BLOCKLIST_ENTRY_KIND_EMAIL_DOMAIN
+	default:
+		kind = bikerentalv1.BlocklistEntryKind_BLOCKLIST_ENTRY_KIND_UNKNOWN
+	}
+
+	return &bikerentalv1.BlocklistEntry{
+		Id:        e.ID,
+		Kind:      kind,
+		Value:     e.Value,
+		Reason:    e.Reason,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+}
+
+func newResponseReservationTax(t bikerental.ReservationTax) *bikerentalv1.ReservationTax {
+	if t.Treatment == bikerental.TaxTreatmentNone {
+		return nil
+	}
+	return &bikerentalv1.ReservationTax{
+		Country:   t.Country,
+		Treatment: newResponseTaxTreatment(t.Treatment),
+		Rate:      t.Rate,
+		Net:       newResponseMoney(t.Net),
+		Tax:       newResponseMoney(t.Tax),
+	}
+}
+
+func newResponseTaxTreatment(t bikerental.TaxTreatment) bikerentalv1.TaxTreatment {
+	switch t {
+	case bikerental.TaxTreatmentStandard:
+		return bikerentalv1.TaxTreatment_TAX_TREATMENT_STANDARD
+	case bikerental.TaxTreatmentReverseCharge:
+		return bikerentalv1.TaxTreatment_TAX_TREATMENT_REVERSE_CHARGE
+	default:
+		return bikerentalv1.TaxTreatment_TAX_TREATMENT_NONE
+	}
+}
+
+func newResponseInvoice(i *bikerental.Invoice) *bikerentalv1.Invoice {
+	if i == nil {
+		return nil
+	}
+
+	lines := make([]*bikerentalv1.InvoiceLine, 0, len(i.Lines))
+	for _, l := range i.Lines {
+		lines = append(lines, &bikerentalv1.InvoiceLine{
+			Description: l.Description,
+			Discount:    newResponseMoney(l.Discount),
+			TaxRate:     l.TaxRate,
+			Net:         newResponseMoney(l.Net),
+			Tax:         newResponseMoney(l.Tax),
+			Gross:       newResponseMoney(l.Gross),
+		})
+	}
+	taxLines := make([]*bikerentalv1.InvoiceTaxLine, 0, len(i.TaxLines))
+	for _, l := range i.TaxLines {
+		taxLines = append(taxLines, &bikerentalv1.InvoiceTaxLine{
+			Rate: l.Rate,
+			Net:  newResponseMoney(l.Net),
+			Tax:  newResponseMoney(l.Tax),
+		})
+	}
+
+	return &bikerentalv1.Invoice{
+		Number:        i.Number,
+		ReservationId: i.ReservationID,
+		IssuedAt:      timestamppb.New(i.IssuedAt),
+		Customer:      newResponseCustomer(&i.Customer),
+		Country:       i.Country,
+		Treatment:     newResponseTaxTreatment(i.Treatment),
+		Lines:         lines,
+		TaxLines:      taxLines,
+		Net:           newResponseMoney(i.Net),
+		Tax:           newResponseMoney(i.Tax),
+		Gross:         newResponseMoney(i.Gross),
+		Note:          i.Note,
+	}
+}
+
+func newListWebhookSubscriptionsResponse(subs []bikerental.WebhookSubscription) *bikerentalv1.ListWebhookSubscriptionsResponse {
+	respSubs := make([]*bikerentalv1.WebhookSubscription, 0, len(subs))
+	for i := range subs {
+		respSubs = append(respSubs, newResponseWebhookSubscription(&subs[i]))
+	}
+
+	return &bikerentalv1.ListWebhookSubscriptionsResponse{
+		Subscriptions: respSubs,
+	}
+}
+
+func newResponseWebhookSubscription(s *bikerental.WebhookSubscription) *bikerentalv1.WebhookSubscription {
+	if s == nil {
+		return nil
+	}
+
+	types := make([]string, 0, len(s.EventTypes))
+	for _, t := range s.EventTypes {
+		types = append(types, string(t))
+	}
+
+	return &bikerentalv1.WebhookSubscription{
+		Id:         s.ID,
+		Url:        s.URL,
+		EventTypes: types,
+		Secret:     s.Secret,
+		CreatedAt:  timestamppb.New(s.CreatedAt),
+	}
+}
+
+func newListWebhookDeliveriesResponse(deliveries []bikerental.WebhookDelivery) *bikerentalv1.ListWebhookDeliveriesResponse {
+	respDeliveries := make([]*bikerentalv1.WebhookDelivery, 0, len(deliveries))
+	for i := range deliveries {
+		respDeliveries = append(respDeliveries, newResponseWebhookDelivery(&deliveries[i]))
+	}
+
+	return &bikerentalv1.ListWebhookDeliveriesResponse{
+		Deliveries: respDeliveries,
+	}
+}
+
+func newResponseWebhookDelivery(d *bikerental.WebhookDelivery) *bikerentalv1.WebhookDelivery {
+	if d == nil {
+		return nil
+	}
+
+	var st bikerentalv1.WebhookDeliveryStatus
+	switch d.Status {
+	case bikerental.WebhookDeliveryStatusPending:
+		st = bikerentalv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case bikerental.WebhookDeliveryStatusDelivered:
+		st = bikerentalv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED
+	case bikerental.WebhookDeliveryStatusDead:
+		st = bikerentalv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD
+	default:
+		st = bikerentalv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNKNOWN
+	}
+
+	var deliveredAt *timestamppb.Timestamp
+	if !d.DeliveredAt.IsZero() {
+		deliveredAt = timestamppb.New(d.DeliveredAt)
+	}
+
+	log := make([]*bikerentalv1.WebhookAttempt, 0, len(d.Log))
+	for _, a := range d.Log {
+		log = append(log, &bikerentalv1.WebhookAttempt{
+			AttemptedAt: timestamppb.New(a.AttemptedAt),
+			StatusCode:  int32(a.StatusCode),
+			Error:       a.Error,
+			DurationMs:  a.Duration.Milliseconds(),
+		})
+	}
+
+	return &bikerentalv1.WebhookDelivery{
+		Id:             d.ID,
+		SubscriptionId: d.SubscriptionID,
+		EventId:        d.Event.ID,
+		EventType:      string(d.Event.Type),
+		AggregateId:    d.Event.AggregateID,
+		Status:         st,
+		Attempts:       int32(d.Attempts),
+		NextAttemptAt:  timestamppb.New(d.NextAttemptAt),
+		LastError:      d.LastError,
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+		DeliveredAt:    deliveredAt,
+		Log:            log,
+	}
+}
+
+func newResponseReservationChange(c bikerental.ReservationChange) *bikerentalv1.ReservationChange {
+	var t bikerentalv1.ReservationChangeType
+	switch c.Type {
+	case bikerental.EventTypeReservationCreated:
+		t = bikerentalv1.ReservationChangeType_RESERVATION_CHANGE_TYPE_CREATED
+	case bikerental.EventTypeReservationCanceled:
+		t = bikerentalv1.ReservationChangeType_RESERVATION_CHANGE_TYPE_CANCELED
+	case bikerental.EventTypeReservationCompleted:
+		t = bikerentalv1.ReservationChangeType_RESERVATION_CHANGE_TYPE_COMPLETED
+	default:
+		t = bikerentalv1.ReservationChangeType_RESERVATION_CHANGE_TYPE_UNKNOWN
+	}
+
+	return &bikerentalv1.ReservationChange{
+		Type:        t,
+		Reservation: newResponseReservation(&c.Reservation),
+		OccurredAt:  timestamppb.New(c.OccurredAt),
+	}
+}
+
+func newResponseBikeAvailabilityChange(c bikerental.BikeAvailabilityChange) *bikerentalv1.BikeAvailabilityChange {
+	return &bikerentalv1.BikeAvailabilityChange{
+		BikeId:     c.BikeID,
+		StationId:  c.StationID,
+		StartTime:  timestamppb.New(c.StartTime),
+		EndTime:    timestamppb.New(c.EndTime),
+		Available:  c.Available,
+		OccurredAt: timestamppb.New(c.OccurredAt),
+	}
+}
+
+func newListAPIKeysResponse(keys []bikerental.APIKey) *bikerentalv1.ListAPIKeysResponse {
+	respKeys := make([]*bikerentalv1.APIKey, 0, len(keys))
+	for _, k := range keys {
+		respKeys = append(respKeys, newResponseAPIKey(k))
+	}
+
+	return &bikerentalv1.ListAPIKeysResponse{
+		Keys: respKeys,
+	}
+}
+
+func newResponseAPIKey(k bikerental.APIKey) *bikerentalv1.APIKey {
+	var revokedAt *timestamppb.Timestamp
+	if k.IsRevoked() {
+		revokedAt = timestamppb.New(k.RevokedAt)
+	}
+
+	return &bikerentalv1.APIKey{
+		Id:        k.ID,
+		TenantId:  k.TenantID,
+		Name:      k.Name,
+		Role:      newResponseRole(k.Role),
+		Prefix:    k.Prefix,
+		CreatedAt: timestamppb.New(k.CreatedAt),
+		RevokedAt: revokedAt,
+	}
+}
+
+func newResponseRole(r app.Role) bikerentalv1.Role {
+	switch r {
+	case app.RoleAdmin:
+		return bikerentalv1.Role_ROLE_ADMIN
+	case app.RoleStaff:
+		return bikerentalv1.Role_ROLE_STAFF
+	case app.RoleCustomer:
+		return bikerentalv1.Role_ROLE_CUSTOMER
+	default:
+		return bikerentalv1.Role_ROLE_UNKNOWN
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"time"
 
 	empty "github.com/golang/protobuf/ptypes/empty"
 	"github.com/nglogic/go-application-guide/internal/app"
@@ -13,41 +14,119 @@ import (
 	"github.com/sirupsen/logrus"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // Server implements rpc ServiceServer.
 type Server struct {
 	bikeService        bikerental.BikeService
 	reservationService bikerental.ReservationService
+	damageService      bikerental.DamageReportService
+	openingHours       bikerental.OpeningHoursService
+	promoCodeService   bikerental.PromoCodeService
+	loyaltyService     bikerental.LoyaltyService
+	companyService     bikerental.CompanyService
+	riskService        bikerental.RiskService
+	webhookService     bikerental.WebhookService
+	watchService       bikerental.WatchService
+	apiKeyService      bikerental.APIKeyService
+	idempotencyService bikerental.IdempotencyService
 	log                logrus.FieldLogger
 }
 
+// Services are app services used by the server. All of them are required.
+type Services struct {
+	Bikes        bikerental.BikeService
+	Reservations bikerental.ReservationService
+	Damage       bikerental.DamageReportService
+	OpeningHours bikerental.OpeningHoursService
+	PromoCodes   bikerental.PromoCodeService
+	Loyalty      bikerental.LoyaltyService
+	Companies    bikerental.CompanyService
+	Risk         bikerental.RiskService
+	Webhooks     bikerental.WebhookService
+	Watch        bikerental.WatchService
+	APIKeys      bikerental.APIKeyService
+	Idempotency  bikerental.IdempotencyService
+}
+
+func (s Services) validate() error {
+	if s.Bikes == nil {
+		return errors.New("bike service is nil")
+	}
+	if s.Reservations == nil {
+		return errors.New("reservation service is nil")
+	}
+	if s.Damage == nil {
+		return errors.New("damage report service is nil")
+	}
+	if s.OpeningHours == nil {
+		return errors.New("opening hours service is nil")
+	}
+	if s.PromoCodes == nil {
+		return errors.New("promo code service is nil")
+	}
+	if s.Loyalty == nil {
+		return errors.New("loyalty service is nil")
+	}
+	if s.Companies == nil {
+		return errors.New("company service is nil")
+	}
+	if s.Risk == nil {
+		return errors.New("risk service is nil")
+	}
+	if s.Webhooks == nil {
+		return errors.New("webhook service is nil")
+	}
+	if s.Watch == nil {
+		return errors.New("watch service is nil")
+	}
+	if s.APIKeys == nil {
+		return errors.New("api key service is nil")
+	}
+	if s.Idempotency == nil {
+		return errors.New("idempotency service is nil")
+	}
+	return nil
+}
+
 // NewServer creates new Server instance.
-func NewServer(
-	bikeService bikerental.BikeService,
-	reservationService bikerental.ReservationService,
-	log logrus.FieldLogger,
-) (*Server, error) {
-	if bikeService == nil {
-		return nil, errors.New("bike service is nil")
+func NewServer(services Services, log logrus.FieldLogger) (*Server, error) {
+	if err := services.validate(); err != nil {
+		return nil, err
 	}
-	if reservationService == nil {
-		return nil, errors.New("reservation service is nil")
+	if err := checkMethodRoles(); err != nil {
+		return nil, fmt.Errorf("invalid access policy: %w", err)
 	}
 	if log == nil {
 		return nil, errors.New("logger is nil")
 	}
 
 	return &Server{
-		bikeService:        bikeService,
-		reservationService: reservationService,
+		bikeService:        services.Bikes,
+		reservationService: services.Reservations,
+		damageService:      services.Damage,
+		openingHours:       services.OpeningHours,
+		promoCodeService:   services.PromoCodes,
+		loyaltyService:     services.Loyalty,
+		companyService:     services.Companies,
+		riskService:        services.Risk,
+		webhookService:     services.Webhooks,
+		watchService:       services.Watch,
+		apiKeyService:      services.APIKeys,
+		idempotencyService: services.Idempotency,
 		log:                log,
 	}, nil
 }
 
 // ListBikes returns list of all bikes.
 func (s *Server) ListBikes(ctx context.Context, _ *empty.Empty) (*bikerentalv1.ListBikesResponse, error) {
+	if err := s.authorize(ctx, "ListBikes"); err != nil {
+		return nil, err
+	}
+
 	bikes, err := s.bikeService.List(ctx)
 	if err != nil {
 		s.logError(ctx, err, "ListBikes")
@@ -58,6 +137,10 @@ func (s *Server) ListBikes(ctx context.Context, _ *empty.Empty) (*bikerentalv1.L
 
 // GetBike returns a bike.
 func (s *Server) GetBike(ctx context.Context, req *bikerentalv1.GetBikeRequest) (*bikerentalv1.Bike, error) {
+	if err := s.authorize(ctx, "GetBike"); err != nil {
+		return nil, err
+	}
+
 	b, err := s.bikeService.Get(ctx, req.Id)
 	if err != nil {
 		s.logError(ctx, err, "GetBike")
@@ -67,11 +150,29 @@ func (s *Server) GetBike(ctx context.Context, req *bikerentalv1.GetBikeRequest)
 }
 
 // CreateBike creates new bike.
+// Retries with the same idempotency key get the bike created by the first call.
 func (s *Server) CreateBike(ctx context.Context, req *bikerentalv1.CreateBikeRequest) (*bikerentalv1.Bike, error) {
+	if err := s.authorize(ctx, "CreateBike"); err != nil {
+		return nil, err
+	}
+
+	resp := &bikerentalv1.Bike{}
+	if err := s.runIdempotent(ctx, "CreateBike", req, resp, func(ctx context.Context) (proto.Message, error) {
+		return s.createBike(ctx, req)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *Server) createBike(ctx context.Context, req *bikerentalv1.CreateBikeRequest) (*bikerentalv1.Bike, error) {
 	if req.Data == nil {
 		return nil, status.Error(codes.InvalidArgument, "bike data can't be empty")
 	}
-	b := newAppBikeFromRequestData(req.Data)
+	b, err := newAppBikeFromRequestData(req.Data)
+	if err != nil {
+		return nil, NewServerError(err)
+	}
 	createdBike, err := s.bikeService.Add(ctx, *b)
 	if err != nil {
 		s.logError(ctx, err, "CreateBike")
@@ -85,10 +186,17 @@ func (s *Server) CreateBike(ctx context.Context, req *bikerentalv1.CreateBikeReq
 
 // UpdateBike updates a bike.
 func (s *Server) UpdateBike(ctx context.Context, req *bikerentalv1.UpdateBikeRequest) (*empty.Empty, error) {
+	if err := s.authorize(ctx, "UpdateBike"); err != nil {
+		return nil, err
+	}
+
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "bike id can't be empty")
 	}
-	b := newAppBikeFromRequestData(req.Data)
+	b, err := newAppBikeFromRequestData(req.Data)
+	if err != nil {
+		return nil, NewServerError(err)
+	}
 	if err := s.bikeService.Update(ctx, req.Id, *b); err != nil {
 		s.logError(ctx, err, "UpdateBike")
 		return nil, NewServerError(err)
@@ -101,6 +209,10 @@ func (s *Server) UpdateBike(ctx context.Context, req *bikerentalv1.UpdateBikeReq
 
 // DeleteBike deletes a bike.
 func (s *Server) DeleteBike(ctx context.Context, req *bikerentalv1.DeleteBikeRequest) (*empty.Empty, error) {
+	if err := s.authorize(ctx, "DeleteBike"); err != nil {
+		return nil, err
+	}
+
 	if err := s.bikeService.Delete(ctx, req.Id); err != nil {
 		s.logError(ctx, err, "DeleteBike")
 		return nil, NewServerError(err)
@@ -113,6 +225,10 @@ func (s *Server) DeleteBike(ctx context.Context, req *bikerentalv1.DeleteBikeReq
 
 // GetBikeAvailability checks bike availability in given time ranges.
 func (s *Server) GetBikeAvailability(ctx context.Context, req *bikerentalv1.GetBikeAvailabilityRequest) (*bikerentalv1.GetBikeAvailabilityResponse, error) {
+	if err := s.authorize(ctx, "GetBikeAvailability"); err != nil {
+		return nil, err
+	}
+
 	available, err := s.reservationService.GetBikeAvailability(
 		ctx,
 		req.BikeId,
@@ -131,6 +247,10 @@ func (s *Server) GetBikeAvailability(ctx context.Context, req *bikerentalv1.GetB
 
 // ListReservations returns list of reservations for a bike.
 func (s *Server) ListReservations(ctx context.Context, req *bikerentalv1.ListReservationsRequest) (*bikerentalv1.ListReservationsResponse, error) {
+	if err := s.authorize(ctx, "ListReservations"); err != nil {
+		return nil, err
+	}
+
 	reservations, err := s.reservationService.ListReservations(ctx, bikerental.ListReservationsRequest{
 		BikeID:    req.BikeId,
 		StartTime: req.StartTime.AsTime(),
@@ -150,9 +270,43 @@ func (s *Server) ListReservations(ctx context.Context, req *bikerentalv1.ListRes
 	}, nil
 }
 
+// GetPriceQuote returns itemized price of renting a bike.
+func (s *Server) GetPriceQuote(ctx context.Context, req *bikerentalv1.GetPriceQuoteRequest) (*bikerentalv1.PriceQuote, error) {
+	if err := s.authorize(ctx, "GetPriceQuote"); err != nil {
+		return nil, err
+	}
+
+	quote, err := s.reservationService.GetPriceQuote(
+		ctx,
+		req.BikeId,
+		req.StartTime.AsTime(),
+		req.EndTime.AsTime(),
+	)
+	if err != nil {
+		s.logError(ctx, err, "GetPriceQuote")
+		return nil, NewServerError(err)
+	}
+
+	return newResponsePriceQuote(quote), nil
+}
+
 // CreateReservation creates new reservation.
-// Returns created object with new id.
+// Returns created object with new id. Retries with the same idempotency key get response of the first call.
 func (s *Server) CreateReservation(ctx context.Context, req *bikerentalv1.CreateReservationRequest) (*bikerentalv1.CreateReservationResponse, error) {
+	if err := s.authorize(ctx, "CreateReservation"); err != nil {
+		return nil, err
+	}
+
+	resp := &bikerentalv1.CreateReservationResponse{}
+	if err := s.runIdempotent(ctx, "CreateReservation", req, resp, func(ctx context.Context) (proto.Message, error) {
+		return s.createReservation(ctx, req)
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *Server) createReservation(ctx context.Context, req *bikerentalv1.CreateReservationRequest) (*bikerentalv1.CreateReservationResponse, error) {
 	if req.Customer == nil {
 		return nil, status.Error(codes.InvalidArgument, "customer can't be empty")
 	}
@@ -164,11 +318,13 @@ func (s *Server) CreateReservation(ctx context.Context, req *bikerentalv1.Create
 	location := newAppLocationFromRequest(req.Location)
 
 	resp, err := s.reservationService.CreateReservation(ctx, bikerental.CreateReservationRequest{
-		BikeID:    req.BikeId,
-		Customer:  *customer,
-		Location:  *location,
-		StartTime: req.StartTime.AsTime(),
-		EndTime:   req.EndTime.AsTime(),
+		BikeID:       req.BikeId,
+		Customer:     *customer,
+		Location:     *location,
+		StartTime:    req.StartTime.AsTime(),
+		EndTime:      req.EndTime.AsTime(),
+		PromoCode:    req.PromoCode,
+		RedeemPoints: int(req.RedeemPoints),
 	})
 	if err != nil {
 		s.logError(ctx, err, "CreateReservation")
@@ -186,6 +342,10 @@ func (s *Server) CreateReservation(ctx context.Context, req *bikerentalv1.Create
 
 // CancelReservation cancels reservation for a bike.
 func (s *Server) CancelReservation(ctx context.Context, req *bikerentalv1.CancelReservationRequest) (*empty.Empty, error) {
+	if err := s.authorize(ctx, "CancelReservation"); err != nil {
+		return nil, err
+	}
+
 	if err := s.reservationService.CancelReservation(ctx, req.BikeId, req.Id); err != nil {
 		s.logError(ctx, err, "CancelReservation")
 		return nil, NewServerError(err)
@@ -194,6 +354,580 @@ func (s *Server) CancelReservation(ctx context.Context, req *bikerentalv1.Cancel
 	return &empty.Empty{}, nil
 }
 
+// CompleteReservation marks reservation for a bike as completed.
+func (s *Server) CompleteReservation(ctx context.Context, req *bikerentalv1.CompleteReservationRequest) (*empty.Empty, error) {
+	if err := s.authorize(ctx, "CompleteReservation"); err != nil {
+		return nil, err
+	}
+
+	if err := s.reservationService.CompleteReservation(ctx, req.BikeId, req.Id); err != nil {
+		s.logError(ctx, err, "CompleteReservation")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "CompleteReservation", "reservation completed: %s", req.Id)
+
+	return &empty.Empty{}, nil
+}
+
+// RefundReservation refunds payment of completed reservation for a bike.
+func (s *Server) RefundReservation(ctx context.Context, req *bikerentalv1.RefundReservationRequest) (*empty.Empty, error) {
+	if err := s.authorize(ctx, "RefundReservation"); err != nil {
+		return nil, err
+	}
+
+	if err := s.reservationService.RefundReservation(ctx, req.BikeId, req.Id); err != nil {
+		s.logError(ctx, err, "RefundReservation")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "RefundReservation", "reservation refunded: %s", req.Id)
+
+	return &empty.Empty{}, nil
+}
+
+// GetReservationInvoice returns VAT invoice of a completed reservation.
+func (s *Server) GetReservationInvoice(ctx context.Context, req *bikerentalv1.GetReservationInvoiceRequest) (*bikerentalv1.Invoice, error) {
+	if err := s.authorize(ctx, "GetReservationInvoice"); err != nil {
+		return nil, err
+	}
+
+	invoice, err := s.reservationService.GetInvoice(ctx, req.BikeId, req.Id)
+	if err != nil {
+		s.logError(ctx, err, "GetReservationInvoice")
+		return nil, NewServerError(err)
+	}
+
+	return newResponseInvoice(invoice), nil
+}
+
+// WatchReservations streams reservation changes until client cancels the call.
+func (s *Server) WatchReservations(req *bikerentalv1.WatchReservationsRequest, stream bikerentalv1.BikeRentalService_WatchReservationsServer) error {
+	ctx := stream.Context()
+	if err := s.authorize(ctx, "WatchReservations"); err != nil {
+		return err
+	}
+	filter := bikerental.WatchFilter{
+		BikeID:    req.BikeId,
+		StationID: req.StationId,
+	}
+	err := s.watchService.WatchReservations(ctx, filter, func(c bikerental.ReservationChange) error {
+		return stream.Send(newResponseReservationChange(c))
+	})
+	if err != nil {
+		s.logError(ctx, err, "WatchReservations")
+		return newWatchError(err)
+	}
+	return nil
+}
+
+// WatchBikeAvailability streams bike availability changes until client cancels the call.
+func (s *Server) WatchBikeAvailability(
+	req *bikerentalv1.WatchBikeAvailabilityRequest,
+	stream bikerentalv1.BikeRentalService_WatchBikeAvailabilityServer,
+) error {
+	ctx := stream.Context()
+	if err := s.authorize(ctx, "WatchBikeAvailability"); err != nil {
+		return err
+	}
+	filter := bikerental.WatchFilter{
+		BikeID:    req.BikeId,
+		StationID: req.StationId,
+	}
+	err := s.watchService.WatchBikeAvailability(ctx, filter, func(c bikerental.BikeAvailabilityChange) error {
+		return stream.Send(newResponseBikeAvailabilityChange(c))
+	})
+	if err != nil {
+		s.logError(ctx, err, "WatchBikeAvailability")
+		return newWatchError(err)
+	}
+	return nil
+}
+
+// FileDamageReport creates new damage report for a reservation.
+func (s *Server) FileDamageReport(ctx context.Context, req *bikerentalv1.FileDamageReportRequest) (*bikerentalv1.DamageReport, error) {
+	if err := s.authorize(ctx, "FileDamageReport"); err != nil {
+		return nil, err
+	}
+
+	appReq, err := newAppFileDamageReportRequest(req)
+	if err != nil {
+		return nil, NewServerError(err)
+	}
+	report, err := s.damageService.FileReport(ctx, appReq)
+	if err != nil {
+		s.logError(ctx, err, "FileDamageReport")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "FileDamageReport", "damage report created: %s", report.ID)
+
+	return newResponseDamageReport(report), nil
+}
+
+// ListDamageReports returns list of damage reports for a bike.
+func (s *Server) ListDamageReports(ctx context.Context, req *bikerentalv1.ListDamageReportsRequest) (*bikerentalv1.ListDamageReportsResponse, error) {
+	if err := s.authorize(ctx, "ListDamageReports"); err != nil {
+		return nil, err
+	}
+
+	reports, err := s.damageService.ListReports(ctx, bikerental.ListDamageReportsRequest{
+		BikeID:        req.BikeId,
+		ReservationID: req.ReservationId,
+	})
+	if err != nil {
+		s.logError(ctx, err, "ListDamageReports")
+		return nil, NewServerError(err)
+	}
+
+	var outrs []*bikerentalv1.DamageReport
+	for i := range reports {
+		outrs = append(outrs, newResponseDamageReport(&reports[i]))
+	}
+	return &bikerentalv1.ListDamageReportsResponse{
+		DamageReports: outrs,
+	}, nil
+}
+
+// GetDamageReportPhoto returns a photo attached to damage report.
+func (s *Server) GetDamageReportPhoto(ctx context.Context, req *bikerentalv1.GetDamageReportPhotoRequest) (*bikerentalv1.DamageReportPhoto, error) {
+	if err := s.authorize(ctx, "GetDamageReportPhoto"); err != nil {
+		return nil, err
+	}
+
+	photo, err := s.damageService.GetPhoto(ctx, req.BikeId, req.ReportId, req.PhotoId)
+	if err != nil {
+		s.logError(ctx, err, "GetDamageReportPhoto")
+		return nil, NewServerError(err)
+	}
+
+	return &bikerentalv1.DamageReportPhoto{
+		ContentType: photo.ContentType,
+		Data:        photo.Data,
+	}, nil
+}
+
+// ListPromoCodes returns list of all promo codes.
+func (s *Server) ListPromoCodes(ctx context.Context, _ *empty.Empty) (*bikerentalv1.ListPromoCodesResponse, error) {
+	if err := s.authorize(ctx, "ListPromoCodes"); err != nil {
+		return nil, err
+	}
+
+	codes, err := s.promoCodeService.ListPromoCodes(ctx)
+	if err != nil {
+		s.logError(ctx, err, "ListPromoCodes")
+		return nil, NewServerError(err)
+	}
+	return newListPromoCodesResponse(codes), nil
+}
+
+// CreatePromoCode creates new promo code.
+func (s *Server) CreatePromoCode(ctx context.Context, req *bikerentalv1.CreatePromoCodeRequest) (*bikerentalv1.PromoCode, error) {
+	if err := s.authorize(ctx, "CreatePromoCode"); err != nil {
+		return nil, err
+	}
+
+	if req.PromoCode == nil {
+		return nil, status.Error(codes.InvalidArgument, "promo code can't be empty")
+	}
+	code, err := newAppPromoCodeFromRequest(req.PromoCode)
+	if err != nil {
+		return nil, NewServerError(err)
+	}
+	p, err := s.promoCodeService.CreatePromoCode(ctx, code)
+	if err != nil {
+		s.logError(ctx, err, "CreatePromoCode")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "CreatePromoCode", "promo code created: %s", p.Code)
+
+	return newResponsePromoCode(p), nil
+}
+
+// GetLoyaltyAccount returns customer loyalty account.
+func (s *Server) GetLoyaltyAccount(ctx context.Context, req *bikerentalv1.GetLoyaltyAccountRequest) (*bikerentalv1.LoyaltyAccount, error) {
+	if err := s.authorize(ctx, "GetLoyaltyAccount"); err != nil {
+		return nil, err
+	}
+
+	account, err := s.loyaltyService.GetAccount(ctx, req.CustomerId)
+	if err != nil {
+		s.logError(ctx, err, "GetLoyaltyAccount")
+		return nil, NewServerError(err)
+	}
+	return newResponseLoyaltyAccount(account), nil
+}
+
+// ListLoyaltyEntries returns customer loyalty ledger entries.
+func (s *Server) ListLoyaltyEntries(ctx context.Context, req *bikerentalv1.ListLoyaltyEntriesRequest) (*bikerentalv1.ListLoyaltyEntriesResponse, error) {
+	if err := s.authorize(ctx, "ListLoyaltyEntries"); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.loyaltyService.ListEntries(ctx, req.CustomerId)
+	if err != nil {
+		s.logError(ctx, err, "ListLoyaltyEntries")
+		return nil, NewServerError(err)
+	}
+	return newListLoyaltyEntriesResponse(entries), nil
+}
+
+// ListCompanies returns list of all companies.
+func (s *Server) ListCompanies(ctx context.Context, _ *empty.Empty) (*bikerentalv1.ListCompaniesResponse, error) {
+	if err := s.authorize(ctx, "ListCompanies"); err != nil {
+		return nil, err
+	}
+
+	companies, err := s.companyService.ListCompanies(ctx)
+	if err != nil {
+		s.logError(ctx, err, "ListCompanies")
+		return nil, NewServerError(err)
+	}
+	return newListCompaniesResponse(companies), nil
+}
+
+// GetCompany returns a company.
+func (s *Server) GetCompany(ctx context.Context, req *bikerentalv1.GetCompanyRequest) (*bikerentalv1.Company, error) {
+	if err := s.authorize(ctx, "GetCompany"); err != nil {
+		return nil, err
+	}
+
+	c, err := s.companyService.GetCompany(ctx, req.Id)
+	if err != nil {
+		s.logError(ctx, err, "GetCompany")
+		return nil, NewServerError(err)
+	}
+	return newResponseCompany(c), nil
+}
+
+// CreateCompany creates new company.
+func (s *Server) CreateCompany(ctx context.Context, req *bikerentalv1.CreateCompanyRequest) (*bikerentalv1.Company, error) {
+	if err := s.authorize(ctx, "CreateCompany"); err != nil {
+		return nil, err
+	}
+
+	if req.Data == nil {
+		return nil, status.Error(codes.InvalidArgument, "company data can't be empty")
+	}
+	company, err := newAppCompanyFromRequestData(req.Data)
+	if err != nil {
+		return nil, NewServerError(err)
+	}
+	c, err := s.companyService.CreateCompany(ctx, company)
+	if err != nil {
+		s.logError(ctx, err, "CreateCompany")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "CreateCompany", "company created: %s", c.ID)
+
+	return newResponseCompany(c), nil
+}
+
+// UpdateCompany updates a company.
+func (s *Server) UpdateCompany(ctx context.Context, req *bikerentalv1.UpdateCompanyRequest) (*empty.Empty, error) {
+	if err := s.authorize(ctx, "UpdateCompany"); err != nil {
+		return nil, err
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "company id can't be empty")
+	}
+	if req.Data == nil {
+		return nil, status.Error(codes.InvalidArgument, "company data can't be empty")
+	}
+	company, err := newAppCompanyFromRequestData(req.Data)
+	if err != nil {
+		return nil, NewServerError(err)
+	}
+	if err := s.companyService.UpdateCompany(ctx, req.Id, company); err != nil {
+		s.logError(ctx, err, "UpdateCompany")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "UpdateCompany", "company updated: %s", req.Id)
+
+	return &empty.Empty{}, nil
+}
+
+// ListCompanyMembers returns list of company members.
+func (s *Server) ListCompanyMembers(ctx context.Context, req *bikerentalv1.ListCompanyMembersRequest) (*bikerentalv1.ListCompanyMembersResponse, error) {
+	if err := s.authorize(ctx, "ListCompanyMembers"); err != nil {
+		return nil, err
+	}
+
+	members, err := s.companyService.ListMembers(ctx, req.CompanyId)
+	if err != nil {
+		s.logError(ctx, err, "ListCompanyMembers")
+		return nil, NewServerError(err)
+	}
+
+	respMembers := make([]*bikerentalv1.Customer, 0, len(members))
+	for i := range members {
+		respMembers = append(respMembers, newResponseCustomer(&members[i]))
+	}
+	return &bikerentalv1.ListCompanyMembersResponse{
+		Members: respMembers,
+	}, nil
+}
+
+// AddCompanyMember adds existing customer to a company.
+func (s *Server) AddCompanyMember(ctx context.Context, req *bikerentalv1.AddCompanyMemberRequest) (*bikerentalv1.Customer, error) {
+	if err := s.authorize(ctx, "AddCompanyMember"); err != nil {
+		return nil, err
+	}
+
+	c, err := s.companyService.AddMember(ctx, req.CompanyId, req.CustomerId)
+	if err != nil {
+		s.logError(ctx, err, "AddCompanyMember")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "AddCompanyMember", "customer %s added to company %s", req.CustomerId, req.CompanyId)
+
+	return newResponseCustomer(c), nil
+}
+
+// RemoveCompanyMember removes customer from a company.
+func (s *Server) RemoveCompanyMember(ctx context.Context, req *bikerentalv1.RemoveCompanyMemberRequest) (*empty.Empty, error) {
+	if err := s.authorize(ctx, "RemoveCompanyMember"); err != nil {
+		return nil, err
+	}
+
+	if err := s.companyService.RemoveMember(ctx, req.CompanyId, req.CustomerId); err != nil {
+		s.logError(ctx, err, "RemoveCompanyMember")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "RemoveCompanyMember", "customer %s removed from company %s", req.CustomerId, req.CompanyId)
+
+	return &empty.Empty{}, nil
+}
+
+// GetCompanyStatement returns monthly company statement.
+func (s *Server) GetCompanyStatement(ctx context.Context, req *bikerentalv1.GetCompanyStatementRequest) (*bikerentalv1.CompanyStatement, error) {
+	if err := s.authorize(ctx, "GetCompanyStatement"); err != nil {
+		return nil, err
+	}
+
+	statement, err := s.companyService.GetStatement(ctx, req.CompanyId, int(req.Year), time.Month(req.Month))
+	if err != nil {
+		s.logError(ctx, err, "GetCompanyStatement")
+		return nil, NewServerError(err)
+	}
+	return newResponseCompanyStatement(statement), nil
+}
+
+// ListBlocklist returns all customer blocklist entries.
+func (s *Server) ListBlocklist(ctx context.Context, _ *empty.Empty) (*bikerentalv1.ListBlocklistResponse, error) {
+	if err := s.authorize(ctx, "ListBlocklist"); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.riskService.ListBlocklist(ctx)
+	if err != nil {
+		s.logError(ctx, err, "ListBlocklist")
+		return nil, NewServerError(err)
+	}
+	return newListBlocklistResponse(entries), nil
+}
+
+// AddToBlocklist creates new customer blocklist entry.
+func (s *Server) AddToBlocklist(ctx context.Context, req *bikerentalv1.AddToBlocklistRequest) (*bikerentalv1.BlocklistEntry, error) {
+	if err := s.authorize(ctx, "AddToBlocklist"); err != nil {
+		return nil, err
+	}
+
+	if req.Entry == nil {
+		return nil, status.Error(codes.InvalidArgument, "blocklist entry can't be empty")
+	}
+	e, err := s.riskService.AddToBlocklist(ctx, newAppBlocklistEntryFromRequest(req.Entry))
+	if err != nil {
+		s.logError(ctx, err, "AddToBlocklist")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "AddToBlocklist", "blocklist entry created: %s", e.ID)
+
+	return newResponseBlocklistEntry(e), nil
+}
+
+// RemoveFromBlocklist deletes customer blocklist entry.
+func (s *Server) RemoveFromBlocklist(ctx context.Context, req *bikerentalv1.RemoveFromBlocklistRequest) (*empty.Empty, error) {
+	if err := s.authorize(ctx, "RemoveFromBlocklist"); err != nil {
+		return nil, err
+	}
+
+	if err := s.riskService.RemoveFromBlocklist(ctx, req.Id); err != nil {
+		s.logError(ctx, err, "RemoveFromBlocklist")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "RemoveFromBlocklist", "blocklist entry deleted: %s", req.Id)
+
+	return &empty.Empty{}, nil
+}
+
+// ListWebhookSubscriptions returns all webhook subscriptions, without secrets.
+func (s *Server) ListWebhookSubscriptions(ctx context.Context, _ *empty.Empty) (*bikerentalv1.ListWebhookSubscriptionsResponse, error) {
+	if err := s.authorize(ctx, "ListWebhookSubscriptions"); err != nil {
+		return nil, err
+	}
+
+	subs, err := s.webhookService.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		s.logError(ctx, err, "ListWebhookSubscriptions")
+		return nil, NewServerError(err)
+	}
+	return newListWebhookSubscriptionsResponse(subs), nil
+}
+
+// CreateWebhookSubscription creates new webhook subscription.
+func (s *Server) CreateWebhookSubscription(
+	ctx context.Context,
+	req *bikerentalv1.CreateWebhookSubscriptionRequest,
+) (*bikerentalv1.WebhookSubscription, error) {
+	if err := s.authorize(ctx, "CreateWebhookSubscription"); err != nil {
+		return nil, err
+	}
+
+	if req.Subscription == nil {
+		return nil, status.Error(codes.InvalidArgument, "subscription can't be empty")
+	}
+	sub, err := s.webhookService.CreateWebhookSubscription(ctx, newAppWebhookSubscriptionFromRequest(req.Subscription))
+	if err != nil {
+		s.logError(ctx, err, "CreateWebhookSubscription")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "CreateWebhookSubscription", "webhook subscription created: %s", sub.ID)
+
+	return newResponseWebhookSubscription(sub), nil
+}
+
+// DeleteWebhookSubscription deletes webhook subscription.
+func (s *Server) DeleteWebhookSubscription(ctx context.Context, req *bikerentalv1.DeleteWebhookSubscriptionRequest) (*empty.Empty, error) {
+	if err := s.authorize(ctx, "DeleteWebhookSubscription"); err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookService.DeleteWebhookSubscription(ctx, req.Id); err != nil {
+		s.logError(ctx, err, "DeleteWebhookSubscription")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "DeleteWebhookSubscription", "webhook subscription deleted: %s", req.Id)
+
+	return &empty.Empty{}, nil
+}
+
+// ListWebhookDeliveries returns delivery log of a webhook subscription.
+func (s *Server) ListWebhookDeliveries(
+	ctx context.Context,
+	req *bikerentalv1.ListWebhookDeliveriesRequest,
+) (*bikerentalv1.ListWebhookDeliveriesResponse, error) {
+	if err := s.authorize(ctx, "ListWebhookDeliveries"); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.webhookService.ListWebhookDeliveries(ctx, newAppListWebhookDeliveriesRequest(req))
+	if err != nil {
+		s.logError(ctx, err, "ListWebhookDeliveries")
+		return nil, NewServerError(err)
+	}
+	return newListWebhookDeliveriesResponse(deliveries), nil
+}
+
+// RedeliverWebhook schedules webhook delivery to be sent again.
+func (s *Server) RedeliverWebhook(ctx context.Context, req *bikerentalv1.RedeliverWebhookRequest) (*bikerentalv1.WebhookDelivery, error) {
+	if err := s.authorize(ctx, "RedeliverWebhook"); err != nil {
+		return nil, err
+	}
+
+	d, err := s.webhookService.RedeliverWebhook(ctx, req.SubscriptionId, req.Id)
+	if err != nil {
+		s.logError(ctx, err, "RedeliverWebhook")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "RedeliverWebhook", "webhook delivery scheduled: %s", d.ID)
+
+	return newResponseWebhookDelivery(d), nil
+}
+
+// ListAPIKeys returns API keys of the caller's tenant.
+func (s *Server) ListAPIKeys(ctx context.Context, _ *empty.Empty) (*bikerentalv1.ListAPIKeysResponse, error) {
+	if err := s.authorize(ctx, "ListAPIKeys"); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.apiKeyService.ListAPIKeys(ctx)
+	if err != nil {
+		s.logError(ctx, err, "ListAPIKeys")
+		return nil, NewServerError(err)
+	}
+	return newListAPIKeysResponse(keys), nil
+}
+
+// IssueAPIKey creates new API key for the caller's tenant.
+func (s *Server) IssueAPIKey(ctx context.Context, req *bikerentalv1.IssueAPIKeyRequest) (*bikerentalv1.IssueAPIKeyResponse, error) {
+	if err := s.authorize(ctx, "IssueAPIKey"); err != nil {
+		return nil, err
+	}
+
+	issued, err := s.apiKeyService.IssueAPIKey(ctx, bikerental.IssueAPIKeyRequest{
+		Name: req.Name,
+		Role: newAppRole(req.Role),
+	})
+	if err != nil {
+		s.logError(ctx, err, "IssueAPIKey")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "IssueAPIKey", "api key issued: %s", issued.Key.ID)
+
+	return &bikerentalv1.IssueAPIKeyResponse{
+		Key:    newResponseAPIKey(issued.Key),
+		Secret: issued.Secret,
+	}, nil
+}
+
+// RevokeAPIKey revokes API key of the caller's tenant.
+func (s *Server) RevokeAPIKey(ctx context.Context, req *bikerentalv1.RevokeAPIKeyRequest) (*empty.Empty, error) {
+	if err := s.authorize(ctx, "RevokeAPIKey"); err != nil {
+		return nil, err
+	}
+
+	if err := s.apiKeyService.RevokeAPIKey(ctx, req.Id); err != nil {
+		s.logError(ctx, err, "RevokeAPIKey")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "RevokeAPIKey", "api key revoked: %s", req.Id)
+
+	return &empty.Empty{}, nil
+}
+
+// ListOpenIntervals returns time ranges when a station is open.
+func (s *Server) ListOpenIntervals(ctx context.Context, req *bikerentalv1.ListOpenIntervalsRequest) (*bikerentalv1.ListOpenIntervalsResponse, error) {
+	if err := s.authorize(ctx, "ListOpenIntervals"); err != nil {
+		return nil, err
+	}
+
+	intervals, err := s.openingHours.ListOpenIntervals(ctx, bikerental.ListOpenIntervalsRequest{
+		StationID: req.StationId,
+		StartTime: req.StartTime.AsTime(),
+		EndTime:   req.EndTime.AsTime(),
+	})
+	if err != nil {
+		s.logError(ctx, err, "ListOpenIntervals")
+		return nil, NewServerError(err)
+	}
+
+	return newListOpenIntervalsResponse(intervals), nil
+}
+
 func (s *Server) logError(ctx context.Context, err error, endpoint string) {
 	switch {
 	case app.IsValidationError(err):
@@ -202,6 +936,15 @@ func (s *Server) logError(ctx context.Context, err error, endpoint string) {
 	case app.IsNotFoundError(err):
 		// Don't log if requested resource doesn't exist.
 		return
+	case app.IsUnauthenticatedError(err):
+		// Don't log calls with invalid credentials.
+		return
+	case app.IsForbiddenError(err):
+		// Don't log calls denied by access policy.
+		return
+	case app.IsPreconditionError(err):
+		// Don't log calls reusing idempotency keys with different requests.
+		return
 	default:
 		app.AugmentLogFromCtx(ctx, s.log).Errorf("handling request for %s: %v", endpoint, err)
 	}
@@ -214,21 +957,45 @@ func (s *Server) logInfo(ctx context.Context, endpoint string, format string, ar
 	)
 }
 
-// RunServer starts grpc server with ServiceServer service.
-// Server is gracefully shut down on context cancellation.
+// RunServer starts grpc server with ServiceServer service and grpc health service.
+// All calls have to be authenticated with API key or JWT, and are rate limited per IP address and per client, except for health checks.
+// IP address limit is checked before authentication, so invalid credentials can't be tried without limits.
+// Duration and status codes of calls are recorded in metrics, and calls are traced.
+// Server is gracefully shut down on context cancellation. It should be cancelled a drain delay after Health.Shutdown,
+// so health service reports not serving before connections are drained.
 func RunServer(
 	ctx context.Context,
 	log logrus.FieldLogger,
 	srv bikerentalv1.BikeRentalServiceServer,
+	authenticator bikerental.Authenticator,
+	limits bikerental.RateLimitService,
+	metrics *RPCMetrics,
+	health *Health,
 	lis net.Listener,
 ) error {
 	s := grpc.NewServer(
-		grpc.UnaryInterceptor(TraceIDUnaryServerInterceptor()),
+		grpc.ChainUnaryInterceptor(
+			MetricsUnaryServerInterceptor(metrics),
+			TracingUnaryServerInterceptor(),
+			IPRateLimitUnaryServerInterceptor(limits, log),
+			AuthUnaryServerInterceptor(authenticator, log),
+			RateLimitUnaryServerInterceptor(limits, log),
+		),
+		grpc.ChainStreamInterceptor(
+			MetricsStreamServerInterceptor(metrics),
+			TracingStreamServerInterceptor(),
+			IPRateLimitStreamServerInterceptor(limits, log),
+			AuthStreamServerInterceptor(authenticator, log),
+			RateLimitStreamServerInterceptor(limits, log),
+		),
 	)
 	bikerentalv1.RegisterBikeRentalServiceServer(s, srv)
+	healthpb.RegisterHealthServer(s, health.server)
 	go func() {
 		<-ctx.Done()
 		log.Infof("grpc server: shutting down")
+		// Health is usually shut down already, but not when the app is stopped because of an error.
+		health.Shutdown()
 		s.GracefulStop()
 	}()
 
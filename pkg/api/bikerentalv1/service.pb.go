@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.23.0
+// 	protoc-gen-go v1.26.0
 // 	protoc        v3.6.1
 // source: nglogic/bikerental/v1/service.proto
 
@@ -12,7 +12,6 @@ package bikerentalv1
 
 import (
 	context "context"
-	proto "github.com/golang/protobuf/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -32,9 +31,57 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
+type BikeType int32
+
+const (
+	BikeType_BIKE_TYPE_UNKNOWN  BikeType = 0
+	BikeType_BIKE_TYPE_STANDARD BikeType = 1
+	BikeType_BIKE_TYPE_ELECTRIC BikeType = 2
+	BikeType_BIKE_TYPE_CARGO    BikeType = 3
+)
+
+// Enum value maps for BikeType.
+var (
+	BikeType_name = map[int32]string{
+		0: "BIKE_TYPE_UNKNOWN",
+		1: "BIKE_TYPE_STANDARD",
+		2: "BIKE_TYPE_ELECTRIC",
+		3: "BIKE_TYPE_CARGO",
+	}
+	BikeType_value = map[string]int32{
+		"BIKE_TYPE_UNKNOWN":  0,
+		"BIKE_TYPE_STANDARD": 1,
+		"BIKE_TYPE_ELECTRIC": 2,
+		"BIKE_TYPE_CARGO":    3,
+	}
+)
+
+func (x BikeType) Enum() *BikeType {
+	p := new(BikeType)
+	*p = x
+	return p
+}
+
+func (x BikeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BikeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (BikeType) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[0]
+}
+
+func (x BikeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BikeType.Descriptor instead.
+func (BikeType) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{0}
+}
 
 type CustomerType int32
 
@@ -69,11 +116,11 @@ func (x CustomerType) String() string {
 }
 
 func (CustomerType) Descriptor() protoreflect.EnumDescriptor {
-	return file_nglogic_bikerental_v1_service_proto_enumTypes[0].Descriptor()
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[1].Descriptor()
 }
 
 func (CustomerType) Type() protoreflect.EnumType {
-	return &file_nglogic_bikerental_v1_service_proto_enumTypes[0]
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[1]
 }
 
 func (x CustomerType) Number() protoreflect.EnumNumber {
@@ -82,7 +129,7 @@ func (x CustomerType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CustomerType.Descriptor instead.
 func (CustomerType) EnumDescriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{0}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{1}
 }
 
 type ReservationStatus int32
@@ -92,6 +139,7 @@ const (
 	ReservationStatus_RESERVATION_STATUS_REJECTED  ReservationStatus = 1
 	ReservationStatus_RESERVATION_STATUS_APPROVED  ReservationStatus = 2
 	ReservationStatus_RESERVATION_STATUS_CANCELLED ReservationStatus = 3
+	ReservationStatus_RESERVATION_STATUS_COMPLETED ReservationStatus = 4
 )
 
 // Enum value maps for ReservationStatus.
@@ -101,12 +149,14 @@ var (
 		1: "RESERVATION_STATUS_REJECTED",
 		2: "RESERVATION_STATUS_APPROVED",
 		3: "RESERVATION_STATUS_CANCELLED",
+		4: "RESERVATION_STATUS_COMPLETED",
 	}
 	ReservationStatus_value = map[string]int32{
 		"RESERVATION_STATUS_UNKNOWN":   0,
 		"RESERVATION_STATUS_REJECTED":  1,
 		"RESERVATION_STATUS_APPROVED":  2,
 		"RESERVATION_STATUS_CANCELLED": 3,
+		"RESERVATION_STATUS_COMPLETED": 4,
 	}
 )
 
@@ -121,11 +171,11 @@ func (x ReservationStatus) String() string {
 }
 
 func (ReservationStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_nglogic_bikerental_v1_service_proto_enumTypes[1].Descriptor()
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[2].Descriptor()
 }
 
 func (ReservationStatus) Type() protoreflect.EnumType {
-	return &file_nglogic_bikerental_v1_service_proto_enumTypes[1]
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[2]
 }
 
 func (x ReservationStatus) Number() protoreflect.EnumNumber {
@@ -134,382 +184,611 @@ func (x ReservationStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReservationStatus.Descriptor instead.
 func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{1}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{2}
 }
 
-type Bike struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ReservationChangeType int32
 
-	Id   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Data *BikeData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-}
+const (
+	ReservationChangeType_RESERVATION_CHANGE_TYPE_UNKNOWN   ReservationChangeType = 0
+	ReservationChangeType_RESERVATION_CHANGE_TYPE_CREATED   ReservationChangeType = 1
+	ReservationChangeType_RESERVATION_CHANGE_TYPE_CANCELED  ReservationChangeType = 2
+	ReservationChangeType_RESERVATION_CHANGE_TYPE_COMPLETED ReservationChangeType = 3
+)
 
-func (x *Bike) Reset() {
-	*x = Bike{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ReservationChangeType.
+var (
+	ReservationChangeType_name = map[int32]string{
+		0: "RESERVATION_CHANGE_TYPE_UNKNOWN",
+		1: "RESERVATION_CHANGE_TYPE_CREATED",
+		2: "RESERVATION_CHANGE_TYPE_CANCELED",
+		3: "RESERVATION_CHANGE_TYPE_COMPLETED",
+	}
+	ReservationChangeType_value = map[string]int32{
+		"RESERVATION_CHANGE_TYPE_UNKNOWN":   0,
+		"RESERVATION_CHANGE_TYPE_CREATED":   1,
+		"RESERVATION_CHANGE_TYPE_CANCELED":  2,
+		"RESERVATION_CHANGE_TYPE_COMPLETED": 3,
 	}
-}
+)
 
-func (x *Bike) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ReservationChangeType) Enum() *ReservationChangeType {
+	p := new(ReservationChangeType)
+	*p = x
+	return p
 }
 
-func (*Bike) ProtoMessage() {}
+func (x ReservationChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *Bike) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ReservationChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[3].Descriptor()
 }
 
-// Deprecated: Use Bike.ProtoReflect.Descriptor instead.
-func (*Bike) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{0}
+func (ReservationChangeType) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[3]
 }
 
-func (x *Bike) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+func (x ReservationChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Bike) GetData() *BikeData {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Deprecated: Use ReservationChangeType.Descriptor instead.
+func (ReservationChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{3}
 }
 
-type BikeData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type DamageSeverity int32
 
-	ModelName    string  `protobuf:"bytes,1,opt,name=modelName,proto3" json:"modelName,omitempty"`
-	Weight       float32 `protobuf:"fixed32,2,opt,name=weight,proto3" json:"weight,omitempty"`
-	PricePerHour int32   `protobuf:"varint,3,opt,name=pricePerHour,proto3" json:"pricePerHour,omitempty"`
-}
+const (
+	DamageSeverity_DAMAGE_SEVERITY_UNKNOWN DamageSeverity = 0
+	DamageSeverity_DAMAGE_SEVERITY_LOW     DamageSeverity = 1
+	DamageSeverity_DAMAGE_SEVERITY_MEDIUM  DamageSeverity = 2
+	DamageSeverity_DAMAGE_SEVERITY_HIGH    DamageSeverity = 3
+)
 
-func (x *BikeData) Reset() {
-	*x = BikeData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for DamageSeverity.
+var (
+	DamageSeverity_name = map[int32]string{
+		0: "DAMAGE_SEVERITY_UNKNOWN",
+		1: "DAMAGE_SEVERITY_LOW",
+		2: "DAMAGE_SEVERITY_MEDIUM",
+		3: "DAMAGE_SEVERITY_HIGH",
+	}
+	DamageSeverity_value = map[string]int32{
+		"DAMAGE_SEVERITY_UNKNOWN": 0,
+		"DAMAGE_SEVERITY_LOW":     1,
+		"DAMAGE_SEVERITY_MEDIUM":  2,
+		"DAMAGE_SEVERITY_HIGH":    3,
 	}
+)
+
+func (x DamageSeverity) Enum() *DamageSeverity {
+	p := new(DamageSeverity)
+	*p = x
+	return p
 }
 
-func (x *BikeData) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x DamageSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*BikeData) ProtoMessage() {}
+func (DamageSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[4].Descriptor()
+}
 
-func (x *BikeData) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (DamageSeverity) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[4]
 }
 
-// Deprecated: Use BikeData.ProtoReflect.Descriptor instead.
-func (*BikeData) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{1}
+func (x DamageSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *BikeData) GetModelName() string {
-	if x != nil {
-		return x.ModelName
-	}
-	return ""
+// Deprecated: Use DamageSeverity.Descriptor instead.
+func (DamageSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *BikeData) GetWeight() float32 {
-	if x != nil {
-		return x.Weight
+type PaymentStatus int32
+
+const (
+	PaymentStatus_PAYMENT_STATUS_NONE       PaymentStatus = 0
+	PaymentStatus_PAYMENT_STATUS_AUTHORIZED PaymentStatus = 1
+	PaymentStatus_PAYMENT_STATUS_CAPTURED   PaymentStatus = 2
+	PaymentStatus_PAYMENT_STATUS_REFUNDED   PaymentStatus = 3
+	PaymentStatus_PAYMENT_STATUS_VOIDED     PaymentStatus = 4
+)
+
+// Enum value maps for PaymentStatus.
+var (
+	PaymentStatus_name = map[int32]string{
+		0: "PAYMENT_STATUS_NONE",
+		1: "PAYMENT_STATUS_AUTHORIZED",
+		2: "PAYMENT_STATUS_CAPTURED",
+		3: "PAYMENT_STATUS_REFUNDED",
+		4: "PAYMENT_STATUS_VOIDED",
+	}
+	PaymentStatus_value = map[string]int32{
+		"PAYMENT_STATUS_NONE":       0,
+		"PAYMENT_STATUS_AUTHORIZED": 1,
+		"PAYMENT_STATUS_CAPTURED":   2,
+		"PAYMENT_STATUS_REFUNDED":   3,
+		"PAYMENT_STATUS_VOIDED":     4,
 	}
-	return 0
+)
+
+func (x PaymentStatus) Enum() *PaymentStatus {
+	p := new(PaymentStatus)
+	*p = x
+	return p
 }
 
-func (x *BikeData) GetPricePerHour() int32 {
-	if x != nil {
-		return x.PricePerHour
-	}
-	return 0
+func (x PaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type Customer struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[5].Descriptor()
+}
 
-	Id   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Data *CustomerData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+func (PaymentStatus) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[5]
 }
 
-func (x *Customer) Reset() {
-	*x = Customer{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x PaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Customer) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use PaymentStatus.Descriptor instead.
+func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (*Customer) ProtoMessage() {}
+type TaxTreatment int32
 
-func (x *Customer) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	// Reservations made before tax calculation have no tax data.
+	TaxTreatment_TAX_TREATMENT_NONE           TaxTreatment = 0
+	TaxTreatment_TAX_TREATMENT_STANDARD       TaxTreatment = 1
+	TaxTreatment_TAX_TREATMENT_REVERSE_CHARGE TaxTreatment = 2
+)
+
+// Enum value maps for TaxTreatment.
+var (
+	TaxTreatment_name = map[int32]string{
+		0: "TAX_TREATMENT_NONE",
+		1: "TAX_TREATMENT_STANDARD",
+		2: "TAX_TREATMENT_REVERSE_CHARGE",
 	}
-	return mi.MessageOf(x)
-}
+	TaxTreatment_value = map[string]int32{
+		"TAX_TREATMENT_NONE":           0,
+		"TAX_TREATMENT_STANDARD":       1,
+		"TAX_TREATMENT_REVERSE_CHARGE": 2,
+	}
+)
 
-// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
-func (*Customer) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{2}
+func (x TaxTreatment) Enum() *TaxTreatment {
+	p := new(TaxTreatment)
+	*p = x
+	return p
 }
 
-func (x *Customer) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+func (x TaxTreatment) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Customer) GetData() *CustomerData {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+func (TaxTreatment) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[6].Descriptor()
 }
 
-type CustomerData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Type      CustomerType `protobuf:"varint,2,opt,name=type,proto3,enum=nglogic.bikerental.v1.CustomerType" json:"type,omitempty"`
-	FirstName string       `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	Surname   string       `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
-	Email     string       `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+func (TaxTreatment) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[6]
 }
 
-func (x *CustomerData) Reset() {
-	*x = CustomerData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x TaxTreatment) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CustomerData) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use TaxTreatment.Descriptor instead.
+func (TaxTreatment) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (*CustomerData) ProtoMessage() {}
+type DepositStatus int32
 
-func (x *CustomerData) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	DepositStatus_DEPOSIT_STATUS_NONE      DepositStatus = 0
+	DepositStatus_DEPOSIT_STATUS_HELD      DepositStatus = 1
+	DepositStatus_DEPOSIT_STATUS_RELEASED  DepositStatus = 2
+	DepositStatus_DEPOSIT_STATUS_FORFEITED DepositStatus = 3
+)
+
+// Enum value maps for DepositStatus.
+var (
+	DepositStatus_name = map[int32]string{
+		0: "DEPOSIT_STATUS_NONE",
+		1: "DEPOSIT_STATUS_HELD",
+		2: "DEPOSIT_STATUS_RELEASED",
+		3: "DEPOSIT_STATUS_FORFEITED",
+	}
+	DepositStatus_value = map[string]int32{
+		"DEPOSIT_STATUS_NONE":      0,
+		"DEPOSIT_STATUS_HELD":      1,
+		"DEPOSIT_STATUS_RELEASED":  2,
+		"DEPOSIT_STATUS_FORFEITED": 3,
 	}
-	return mi.MessageOf(x)
-}
+)
 
-// Deprecated: Use CustomerData.ProtoReflect.Descriptor instead.
-func (*CustomerData) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{3}
+func (x DepositStatus) Enum() *DepositStatus {
+	p := new(DepositStatus)
+	*p = x
+	return p
 }
 
-func (x *CustomerData) GetType() CustomerType {
-	if x != nil {
-		return x.Type
-	}
-	return CustomerType_CUSTOMER_TYPE_UNKNOWN
+func (x DepositStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CustomerData) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+func (DepositStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[7].Descriptor()
 }
 
-func (x *CustomerData) GetSurname() string {
-	if x != nil {
-		return x.Surname
-	}
-	return ""
+func (DepositStatus) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[7]
 }
 
-func (x *CustomerData) GetEmail() string {
-	if x != nil {
-		return x.Email
+func (x DepositStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositStatus.Descriptor instead.
+func (DepositStatus) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+type PromoCodeType int32
+
+const (
+	PromoCodeType_PROMO_CODE_TYPE_UNKNOWN    PromoCodeType = 0
+	PromoCodeType_PROMO_CODE_TYPE_PERCENTAGE PromoCodeType = 1
+	PromoCodeType_PROMO_CODE_TYPE_FIXED      PromoCodeType = 2
+)
+
+// Enum value maps for PromoCodeType.
+var (
+	PromoCodeType_name = map[int32]string{
+		0: "PROMO_CODE_TYPE_UNKNOWN",
+		1: "PROMO_CODE_TYPE_PERCENTAGE",
+		2: "PROMO_CODE_TYPE_FIXED",
 	}
-	return ""
+	PromoCodeType_value = map[string]int32{
+		"PROMO_CODE_TYPE_UNKNOWN":    0,
+		"PROMO_CODE_TYPE_PERCENTAGE": 1,
+		"PROMO_CODE_TYPE_FIXED":      2,
+	}
+)
+
+func (x PromoCodeType) Enum() *PromoCodeType {
+	p := new(PromoCodeType)
+	*p = x
+	return p
 }
 
-type Reservation struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x PromoCodeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	Id              string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status          ReservationStatus    `protobuf:"varint,2,opt,name=status,proto3,enum=nglogic.bikerental.v1.ReservationStatus" json:"status,omitempty"`
-	Customer        *Customer            `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
-	Bike            *Bike                `protobuf:"bytes,4,opt,name=bike,proto3" json:"bike,omitempty"`
-	StartTime       *timestamp.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime         *timestamp.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	TotalValue      int32                `protobuf:"varint,7,opt,name=totalValue,proto3" json:"totalValue,omitempty"`
-	AppliedDiscount int32                `protobuf:"varint,8,opt,name=appliedDiscount,proto3" json:"appliedDiscount,omitempty"`
+func (PromoCodeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[8].Descriptor()
 }
 
-func (x *Reservation) Reset() {
-	*x = Reservation{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (PromoCodeType) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[8]
 }
 
-func (x *Reservation) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x PromoCodeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*Reservation) ProtoMessage() {}
+// Deprecated: Use PromoCodeType.Descriptor instead.
+func (PromoCodeType) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{8}
+}
 
-func (x *Reservation) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type LoyaltyTier int32
+
+const (
+	LoyaltyTier_LOYALTY_TIER_NONE   LoyaltyTier = 0
+	LoyaltyTier_LOYALTY_TIER_SILVER LoyaltyTier = 1
+	LoyaltyTier_LOYALTY_TIER_GOLD   LoyaltyTier = 2
+)
+
+// Enum value maps for LoyaltyTier.
+var (
+	LoyaltyTier_name = map[int32]string{
+		0: "LOYALTY_TIER_NONE",
+		1: "LOYALTY_TIER_SILVER",
+		2: "LOYALTY_TIER_GOLD",
 	}
-	return mi.MessageOf(x)
+	LoyaltyTier_value = map[string]int32{
+		"LOYALTY_TIER_NONE":   0,
+		"LOYALTY_TIER_SILVER": 1,
+		"LOYALTY_TIER_GOLD":   2,
+	}
+)
+
+func (x LoyaltyTier) Enum() *LoyaltyTier {
+	p := new(LoyaltyTier)
+	*p = x
+	return p
 }
 
-// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
-func (*Reservation) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{4}
+func (x LoyaltyTier) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Reservation) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+func (LoyaltyTier) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[9].Descriptor()
 }
 
-func (x *Reservation) GetStatus() ReservationStatus {
-	if x != nil {
-		return x.Status
-	}
-	return ReservationStatus_RESERVATION_STATUS_UNKNOWN
+func (LoyaltyTier) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[9]
 }
 
-func (x *Reservation) GetCustomer() *Customer {
-	if x != nil {
-		return x.Customer
-	}
-	return nil
+func (x LoyaltyTier) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Reservation) GetBike() *Bike {
-	if x != nil {
-		return x.Bike
-	}
-	return nil
+// Deprecated: Use LoyaltyTier.Descriptor instead.
+func (LoyaltyTier) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Reservation) GetStartTime() *timestamp.Timestamp {
-	if x != nil {
-		return x.StartTime
+type LoyaltyEntryKind int32
+
+const (
+	LoyaltyEntryKind_LOYALTY_ENTRY_KIND_UNKNOWN    LoyaltyEntryKind = 0
+	LoyaltyEntryKind_LOYALTY_ENTRY_KIND_ACCRUAL    LoyaltyEntryKind = 1
+	LoyaltyEntryKind_LOYALTY_ENTRY_KIND_REDEMPTION LoyaltyEntryKind = 2
+	LoyaltyEntryKind_LOYALTY_ENTRY_KIND_REVERSAL   LoyaltyEntryKind = 3
+	LoyaltyEntryKind_LOYALTY_ENTRY_KIND_REFUND     LoyaltyEntryKind = 4
+)
+
+// Enum value maps for LoyaltyEntryKind.
+var (
+	LoyaltyEntryKind_name = map[int32]string{
+		0: "LOYALTY_ENTRY_KIND_UNKNOWN",
+		1: "LOYALTY_ENTRY_KIND_ACCRUAL",
+		2: "LOYALTY_ENTRY_KIND_REDEMPTION",
+		3: "LOYALTY_ENTRY_KIND_REVERSAL",
+		4: "LOYALTY_ENTRY_KIND_REFUND",
+	}
+	LoyaltyEntryKind_value = map[string]int32{
+		"LOYALTY_ENTRY_KIND_UNKNOWN":    0,
+		"LOYALTY_ENTRY_KIND_ACCRUAL":    1,
+		"LOYALTY_ENTRY_KIND_REDEMPTION": 2,
+		"LOYALTY_ENTRY_KIND_REVERSAL":   3,
+		"LOYALTY_ENTRY_KIND_REFUND":     4,
 	}
-	return nil
+)
+
+func (x LoyaltyEntryKind) Enum() *LoyaltyEntryKind {
+	p := new(LoyaltyEntryKind)
+	*p = x
+	return p
 }
 
-func (x *Reservation) GetEndTime() *timestamp.Timestamp {
-	if x != nil {
-		return x.EndTime
+func (x LoyaltyEntryKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoyaltyEntryKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[10].Descriptor()
+}
+
+func (LoyaltyEntryKind) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[10]
+}
+
+func (x LoyaltyEntryKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoyaltyEntryKind.Descriptor instead.
+func (LoyaltyEntryKind) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+type BlocklistEntryKind int32
+
+const (
+	BlocklistEntryKind_BLOCKLIST_ENTRY_KIND_UNKNOWN      BlocklistEntryKind = 0
+	BlocklistEntryKind_BLOCKLIST_ENTRY_KIND_CUSTOMER_ID  BlocklistEntryKind = 1
+	BlocklistEntryKind_BLOCKLIST_ENTRY_KIND_EMAIL_DOMAIN BlocklistEntryKind = 2
+)
+
+// Enum value maps for BlocklistEntryKind.
+var (
+	BlocklistEntryKind_name = map[int32]string{
+		0: "BLOCKLIST_ENTRY_KIND_UNKNOWN",
+		1: "BLOCKLIST_ENTRY_KIND_CUSTOMER_ID",
+		2: "BLOCKLIST_ENTRY_KIND_EMAIL_DOMAIN",
 	}
-	return nil
+	BlocklistEntryKind_value = map[string]int32{
+		"BLOCKLIST_ENTRY_KIND_UNKNOWN":      0,
+		"BLOCKLIST_ENTRY_KIND_CUSTOMER_ID":  1,
+		"BLOCKLIST_ENTRY_KIND_EMAIL_DOMAIN": 2,
+	}
+)
+
+func (x BlocklistEntryKind) Enum() *BlocklistEntryKind {
+	p := new(BlocklistEntryKind)
+	*p = x
+	return p
 }
 
-func (x *Reservation) GetTotalValue() int32 {
-	if x != nil {
-		return x.TotalValue
+func (x BlocklistEntryKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlocklistEntryKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[11].Descriptor()
+}
+
+func (BlocklistEntryKind) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[11]
+}
+
+func (x BlocklistEntryKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlocklistEntryKind.Descriptor instead.
+func (BlocklistEntryKind) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNKNOWN   WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING   WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED WebhookDeliveryStatus = 2
+	// Delivery failed after all retries.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNKNOWN",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_DELIVERED",
+		3: "WEBHOOK_DELIVERY_STATUS_DEAD",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNKNOWN":   0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":   1,
+		"WEBHOOK_DELIVERY_STATUS_DELIVERED": 2,
+		"WEBHOOK_DELIVERY_STATUS_DEAD":      3,
 	}
-	return 0
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
 }
 
-func (x *Reservation) GetAppliedDiscount() int32 {
-	if x != nil {
-		return x.AppliedDiscount
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[12].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[12]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+type Role int32
+
+const (
+	Role_ROLE_UNKNOWN Role = 0
+	// Can do everything, also manage bikes and system configuration.
+	Role_ROLE_ADMIN Role = 1
+	// Works at the rental desk, can manage reservations of all customers.
+	Role_ROLE_STAFF Role = 2
+	// Can manage only own reservations.
+	Role_ROLE_CUSTOMER Role = 3
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "ROLE_UNKNOWN",
+		1: "ROLE_ADMIN",
+		2: "ROLE_STAFF",
+		3: "ROLE_CUSTOMER",
+	}
+	Role_value = map[string]int32{
+		"ROLE_UNKNOWN":  0,
+		"ROLE_ADMIN":    1,
+		"ROLE_STAFF":    2,
+		"ROLE_CUSTOMER": 3,
 	}
-	return 0
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
 }
 
-type Location struct {
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[13].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[13]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+// Money is an amount of money in a currency, like google.type.Money.
+type Money struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lat  float32 `protobuf:"fixed32,1,opt,name=lat,proto3" json:"lat,omitempty"`
-	Long float32 `protobuf:"fixed32,2,opt,name=long,proto3" json:"long,omitempty"`
+	// ISO 4217 currency code, e.g. "EUR".
+	CurrencyCode string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Whole units of the amount.
+	Units int64 `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	// Nano (10^-9) units of the amount. Has to be a multiple of currency minor unit, e.g. 10000000 for a cent.
+	Nanos int32 `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`
 }
 
-func (x *Location) Reset() {
-	*x = Location{}
+func (x *Money) Reset() {
+	*x = Money{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[5]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Location) String() string {
+func (x *Money) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Location) ProtoMessage() {}
+func (*Money) ProtoMessage() {}
 
-func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[5]
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,50 +799,58 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Location.ProtoReflect.Descriptor instead.
-func (*Location) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Location) GetLat() float32 {
+func (x *Money) GetCurrencyCode() string {
 	if x != nil {
-		return x.Lat
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
 	}
 	return 0
 }
 
-func (x *Location) GetLong() float32 {
+func (x *Money) GetNanos() int32 {
 	if x != nil {
-		return x.Long
+		return x.Nanos
 	}
 	return 0
 }
 
-type ListBikesResponse struct {
+type Bike struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bikes []*Bike `protobuf:"bytes,1,rep,name=bikes,proto3" json:"bikes,omitempty"`
+	Id   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data *BikeData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *ListBikesResponse) Reset() {
-	*x = ListBikesResponse{}
+func (x *Bike) Reset() {
+	*x = Bike{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[6]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBikesResponse) String() string {
+func (x *Bike) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBikesResponse) ProtoMessage() {}
+func (*Bike) ProtoMessage() {}
 
-func (x *ListBikesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[6]
+func (x *Bike) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,43 +861,61 @@ func (x *ListBikesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBikesResponse.ProtoReflect.Descriptor instead.
-func (*ListBikesResponse) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Bike.ProtoReflect.Descriptor instead.
+func (*Bike) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ListBikesResponse) GetBikes() []*Bike {
+func (x *Bike) GetId() string {
 	if x != nil {
-		return x.Bikes
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Bike) GetData() *BikeData {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-type GetBikeRequest struct {
+type BikeData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModelName string  `protobuf:"bytes,1,opt,name=modelName,proto3" json:"modelName,omitempty"`
+	Weight    float32 `protobuf:"fixed32,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Deprecated: use hourlyPrice. Price in eurocents, used only if hourlyPrice is not set.
+	//
+	// Deprecated: Do not use.
+	PricePerHour int32  `protobuf:"varint,3,opt,name=pricePerHour,proto3" json:"pricePerHour,omitempty"`
+	OutOfService bool   `protobuf:"varint,4,opt,name=outOfService,proto3" json:"outOfService,omitempty"`
+	StationId    string `protobuf:"bytes,5,opt,name=stationId,proto3" json:"stationId,omitempty"`
+	// Security deposit depends on bike type. Unknown type is treated as standard bike.
+	Type BikeType `protobuf:"varint,6,opt,name=type,proto3,enum=nglogic.bikerental.v1.BikeType" json:"type,omitempty"`
+	// Price currency is a currency of all bike reservations.
+	HourlyPrice *Money `protobuf:"bytes,7,opt,name=hourlyPrice,proto3" json:"hourlyPrice,omitempty"`
 }
 
-func (x *GetBikeRequest) Reset() {
-	*x = GetBikeRequest{}
+func (x *BikeData) Reset() {
+	*x = BikeData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[7]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBikeRequest) String() string {
+func (x *BikeData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBikeRequest) ProtoMessage() {}
+func (*BikeData) ProtoMessage() {}
 
-func (x *GetBikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[7]
+func (x *BikeData) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,91 +926,87 @@ func (x *GetBikeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBikeRequest.ProtoReflect.Descriptor instead.
-func (*GetBikeRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BikeData.ProtoReflect.Descriptor instead.
+func (*BikeData) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetBikeRequest) GetId() string {
+func (x *BikeData) GetModelName() string {
 	if x != nil {
-		return x.Id
+		return x.ModelName
 	}
 	return ""
 }
 
-type CreateBikeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Data *BikeData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+func (x *BikeData) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
 }
 
-func (x *CreateBikeRequest) Reset() {
-	*x = CreateBikeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Deprecated: Do not use.
+func (x *BikeData) GetPricePerHour() int32 {
+	if x != nil {
+		return x.PricePerHour
 	}
+	return 0
 }
 
-func (x *CreateBikeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BikeData) GetOutOfService() bool {
+	if x != nil {
+		return x.OutOfService
+	}
+	return false
 }
 
-func (*CreateBikeRequest) ProtoMessage() {}
-
-func (x *CreateBikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *BikeData) GetStationId() string {
+	if x != nil {
+		return x.StationId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateBikeRequest.ProtoReflect.Descriptor instead.
-func (*CreateBikeRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{8}
+func (x *BikeData) GetType() BikeType {
+	if x != nil {
+		return x.Type
+	}
+	return BikeType_BIKE_TYPE_UNKNOWN
 }
 
-func (x *CreateBikeRequest) GetData() *BikeData {
+func (x *BikeData) GetHourlyPrice() *Money {
 	if x != nil {
-		return x.Data
+		return x.HourlyPrice
 	}
 	return nil
 }
 
-type UpdateBikeRequest struct {
+type Customer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Data *BikeData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Id   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data *CustomerData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *UpdateBikeRequest) Reset() {
-	*x = UpdateBikeRequest{}
+func (x *Customer) Reset() {
+	*x = Customer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[9]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateBikeRequest) String() string {
+func (x *Customer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBikeRequest) ProtoMessage() {}
+func (*Customer) ProtoMessage() {}
 
-func (x *UpdateBikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[9]
+func (x *Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,50 +1017,58 @@ func (x *UpdateBikeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBikeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBikeRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
+func (*Customer) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdateBikeRequest) GetId() string {
+func (x *Customer) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateBikeRequest) GetData() *BikeData {
+func (x *Customer) GetData() *CustomerData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type DeleteBikeRequest struct {
+type CustomerData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      CustomerType `protobuf:"varint,2,opt,name=type,proto3,enum=nglogic.bikerental.v1.CustomerType" json:"type,omitempty"`
+	FirstName string       `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	Surname   string       `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
+	Email     string       `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	// Set for company members. Read only, use company members methods to change it.
+	CompanyId string `protobuf:"bytes,6,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// Optional VAT identification number of business customers, e.g. "DE123456789".
+	// Business customers from other EU countries are invoiced with reverse charge.
+	VatId string `protobuf:"bytes,7,opt,name=vat_id,json=vatId,proto3" json:"vat_id,omitempty"`
 }
 
-func (x *DeleteBikeRequest) Reset() {
-	*x = DeleteBikeRequest{}
+func (x *CustomerData) Reset() {
+	*x = CustomerData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[10]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteBikeRequest) String() string {
+func (x *CustomerData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBikeRequest) ProtoMessage() {}
+func (*CustomerData) ProtoMessage() {}
 
-func (x *DeleteBikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[10]
+func (x *CustomerData) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,106 +1079,112 @@ func (x *DeleteBikeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBikeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBikeRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CustomerData.ProtoReflect.Descriptor instead.
+func (*CustomerData) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeleteBikeRequest) GetId() string {
+func (x *CustomerData) GetType() CustomerType {
 	if x != nil {
-		return x.Id
+		return x.Type
 	}
-	return ""
-}
-
-type GetBikeAvailabilityRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
-	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	return CustomerType_CUSTOMER_TYPE_UNKNOWN
 }
 
-func (x *GetBikeAvailabilityRequest) Reset() {
-	*x = GetBikeAvailabilityRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CustomerData) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
 	}
+	return ""
 }
 
-func (x *GetBikeAvailabilityRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetBikeAvailabilityRequest) ProtoMessage() {}
-
-func (x *GetBikeAvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CustomerData) GetSurname() string {
+	if x != nil {
+		return x.Surname
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetBikeAvailabilityRequest.ProtoReflect.Descriptor instead.
-func (*GetBikeAvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{11}
+	return ""
 }
 
-func (x *GetBikeAvailabilityRequest) GetBikeId() string {
+func (x *CustomerData) GetEmail() string {
 	if x != nil {
-		return x.BikeId
+		return x.Email
 	}
 	return ""
 }
 
-func (x *GetBikeAvailabilityRequest) GetStartTime() *timestamp.Timestamp {
+func (x *CustomerData) GetCompanyId() string {
 	if x != nil {
-		return x.StartTime
+		return x.CompanyId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetBikeAvailabilityRequest) GetEndTime() *timestamp.Timestamp {
+func (x *CustomerData) GetVatId() string {
 	if x != nil {
-		return x.EndTime
+		return x.VatId
 	}
-	return nil
+	return ""
 }
 
-type GetBikeAvailabilityResponse struct {
+type Reservation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Available bool `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	Id        string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status    ReservationStatus    `protobuf:"varint,2,opt,name=status,proto3,enum=nglogic.bikerental.v1.ReservationStatus" json:"status,omitempty"`
+	Customer  *Customer            `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
+	Bike      *Bike                `protobuf:"bytes,4,opt,name=bike,proto3" json:"bike,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Deprecated: use total.
+	//
+	// Deprecated: Do not use.
+	TotalValue int32 `protobuf:"varint,7,opt,name=totalValue,proto3" json:"totalValue,omitempty"`
+	// Deprecated: use discount.
+	//
+	// Deprecated: Do not use.
+	AppliedDiscount int32         `protobuf:"varint,8,opt,name=appliedDiscount,proto3" json:"appliedDiscount,omitempty"`
+	PromoCode       string        `protobuf:"bytes,9,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	RedeemedPoints  int32         `protobuf:"varint,10,opt,name=redeemedPoints,proto3" json:"redeemedPoints,omitempty"`
+	PaymentStatus   PaymentStatus `protobuf:"varint,11,opt,name=paymentStatus,proto3,enum=nglogic.bikerental.v1.PaymentStatus" json:"paymentStatus,omitempty"`
+	// Deprecated: use deposit.
+	//
+	// Deprecated: Do not use.
+	DepositAmount int32         `protobuf:"varint,12,opt,name=depositAmount,proto3" json:"depositAmount,omitempty"`
+	DepositStatus DepositStatus `protobuf:"varint,13,opt,name=depositStatus,proto3,enum=nglogic.bikerental.v1.DepositStatus" json:"depositStatus,omitempty"`
+	// Deprecated: use forfeitedDeposit.
+	//
+	// Deprecated: Do not use.
+	DepositForfeited int32 `protobuf:"varint,14,opt,name=depositForfeited,proto3" json:"depositForfeited,omitempty"`
+	// All reservation amounts are in bike currency.
+	Total            *Money `protobuf:"bytes,15,opt,name=total,proto3" json:"total,omitempty"`
+	Discount         *Money `protobuf:"bytes,16,opt,name=discount,proto3" json:"discount,omitempty"`
+	Deposit          *Money `protobuf:"bytes,17,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	ForfeitedDeposit *Money `protobuf:"bytes,18,opt,name=forfeitedDeposit,proto3" json:"forfeitedDeposit,omitempty"`
+	// Net/tax split of the total.
+	Tax *ReservationTax `protobuf:"bytes,19,opt,name=tax,proto3" json:"tax,omitempty"`
+	// Name of the rule that granted the discount, e.g. "loyalty tier".
+	DiscountRule string `protobuf:"bytes,20,opt,name=discount_rule,json=discountRule,proto3" json:"discount_rule,omitempty"`
 }
 
-func (x *GetBikeAvailabilityResponse) Reset() {
-	*x = GetBikeAvailabilityResponse{}
+func (x *Reservation) Reset() {
+	*x = Reservation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[12]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBikeAvailabilityResponse) String() string {
+func (x *Reservation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBikeAvailabilityResponse) ProtoMessage() {}
+func (*Reservation) ProtoMessage() {}
 
-func (x *GetBikeAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[12]
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,124 +1195,181 @@ func (x *GetBikeAvailabilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBikeAvailabilityResponse.ProtoReflect.Descriptor instead.
-func (*GetBikeAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetBikeAvailabilityResponse) GetAvailable() bool {
+func (x *Reservation) GetId() string {
 	if x != nil {
-		return x.Available
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-type CreateReservationRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
-	Customer  *Customer            `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
-	Location  *Location            `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	StartTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+func (x *Reservation) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNKNOWN
 }
 
-func (x *CreateReservationRequest) Reset() {
-	*x = CreateReservationRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Reservation) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
 	}
+	return nil
 }
 
-func (x *CreateReservationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Reservation) GetBike() *Bike {
+	if x != nil {
+		return x.Bike
+	}
+	return nil
 }
 
-func (*CreateReservationRequest) ProtoMessage() {}
-
-func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Reservation) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateReservationRequest.ProtoReflect.Descriptor instead.
-func (*CreateReservationRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{13}
+func (x *Reservation) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
 }
 
-func (x *CreateReservationRequest) GetBikeId() string {
+// Deprecated: Do not use.
+func (x *Reservation) GetTotalValue() int32 {
 	if x != nil {
-		return x.BikeId
+		return x.TotalValue
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *Reservation) GetAppliedDiscount() int32 {
+	if x != nil {
+		return x.AppliedDiscount
+	}
+	return 0
+}
+
+func (x *Reservation) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
 	}
 	return ""
 }
 
-func (x *CreateReservationRequest) GetCustomer() *Customer {
+func (x *Reservation) GetRedeemedPoints() int32 {
 	if x != nil {
-		return x.Customer
+		return x.RedeemedPoints
+	}
+	return 0
+}
+
+func (x *Reservation) GetPaymentStatus() PaymentStatus {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return PaymentStatus_PAYMENT_STATUS_NONE
+}
+
+// Deprecated: Do not use.
+func (x *Reservation) GetDepositAmount() int32 {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return 0
+}
+
+func (x *Reservation) GetDepositStatus() DepositStatus {
+	if x != nil {
+		return x.DepositStatus
+	}
+	return DepositStatus_DEPOSIT_STATUS_NONE
+}
+
+// Deprecated: Do not use.
+func (x *Reservation) GetDepositForfeited() int32 {
+	if x != nil {
+		return x.DepositForfeited
+	}
+	return 0
+}
+
+func (x *Reservation) GetTotal() *Money {
+	if x != nil {
+		return x.Total
 	}
 	return nil
 }
 
-func (x *CreateReservationRequest) GetLocation() *Location {
+func (x *Reservation) GetDiscount() *Money {
 	if x != nil {
-		return x.Location
+		return x.Discount
 	}
 	return nil
 }
 
-func (x *CreateReservationRequest) GetStartTime() *timestamp.Timestamp {
+func (x *Reservation) GetDeposit() *Money {
 	if x != nil {
-		return x.StartTime
+		return x.Deposit
 	}
 	return nil
 }
 
-func (x *CreateReservationRequest) GetEndTime() *timestamp.Timestamp {
+func (x *Reservation) GetForfeitedDeposit() *Money {
 	if x != nil {
-		return x.EndTime
+		return x.ForfeitedDeposit
 	}
 	return nil
 }
 
-type CreateReservationResponse struct {
+func (x *Reservation) GetTax() *ReservationTax {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *Reservation) GetDiscountRule() string {
+	if x != nil {
+		return x.DiscountRule
+	}
+	return ""
+}
+
+type Location struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reservation *Reservation      `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
-	Status      ReservationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=nglogic.bikerental.v1.ReservationStatus" json:"status,omitempty"`
-	Reason      string            `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Lat  float32 `protobuf:"fixed32,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Long float32 `protobuf:"fixed32,2,opt,name=long,proto3" json:"long,omitempty"`
 }
 
-func (x *CreateReservationResponse) Reset() {
-	*x = CreateReservationResponse{}
+func (x *Location) Reset() {
+	*x = Location{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[14]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateReservationResponse) String() string {
+func (x *Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReservationResponse) ProtoMessage() {}
+func (*Location) ProtoMessage() {}
 
-func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[14]
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1008,59 +1380,50 @@ func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReservationResponse.ProtoReflect.Descriptor instead.
-func (*CreateReservationResponse) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *CreateReservationResponse) GetReservation() *Reservation {
-	if x != nil {
-		return x.Reservation
-	}
-	return nil
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateReservationResponse) GetStatus() ReservationStatus {
+func (x *Location) GetLat() float32 {
 	if x != nil {
-		return x.Status
+		return x.Lat
 	}
-	return ReservationStatus_RESERVATION_STATUS_UNKNOWN
+	return 0
 }
 
-func (x *CreateReservationResponse) GetReason() string {
+func (x *Location) GetLong() float32 {
 	if x != nil {
-		return x.Reason
+		return x.Long
 	}
-	return ""
+	return 0
 }
 
-type ListReservationsRequest struct {
+type ListBikesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
-	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Bikes []*Bike `protobuf:"bytes,1,rep,name=bikes,proto3" json:"bikes,omitempty"`
 }
 
-func (x *ListReservationsRequest) Reset() {
-	*x = ListReservationsRequest{}
+func (x *ListBikesResponse) Reset() {
+	*x = ListBikesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[15]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListReservationsRequest) String() string {
+func (x *ListBikesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReservationsRequest) ProtoMessage() {}
+func (*ListBikesResponse) ProtoMessage() {}
 
-func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[15]
+func (x *ListBikesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1071,57 +1434,43 @@ func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
-func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *ListReservationsRequest) GetBikeId() string {
-	if x != nil {
-		return x.BikeId
-	}
-	return ""
-}
-
-func (x *ListReservationsRequest) GetStartTime() *timestamp.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+// Deprecated: Use ListBikesResponse.ProtoReflect.Descriptor instead.
+func (*ListBikesResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListReservationsRequest) GetEndTime() *timestamp.Timestamp {
+func (x *ListBikesResponse) GetBikes() []*Bike {
 	if x != nil {
-		return x.EndTime
+		return x.Bikes
 	}
 	return nil
 }
 
-type ListReservationsResponse struct {
+type GetBikeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reservations []*Reservation `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListReservationsResponse) Reset() {
-	*x = ListReservationsResponse{}
+func (x *GetBikeRequest) Reset() {
+	*x = GetBikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[16]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListReservationsResponse) String() string {
+func (x *GetBikeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReservationsResponse) ProtoMessage() {}
+func (*GetBikeRequest) ProtoMessage() {}
 
-func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[16]
+func (x *GetBikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1132,44 +1481,43 @@ func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
-func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetBikeRequest.ProtoReflect.Descriptor instead.
+func (*GetBikeRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListReservationsResponse) GetReservations() []*Reservation {
+func (x *GetBikeRequest) GetId() string {
 	if x != nil {
-		return x.Reservations
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type CancelReservationRequest struct {
+type CreateBikeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	Data *BikeData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CancelReservationRequest) Reset() {
-	*x = CancelReservationRequest{}
+func (x *CreateBikeRequest) Reset() {
+	*x = CreateBikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[17]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelReservationRequest) String() string {
+func (x *CreateBikeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelReservationRequest) ProtoMessage() {}
+func (*CreateBikeRequest) ProtoMessage() {}
 
-func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[17]
+func (x *CreateBikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,954 +1528,8852 @@ func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelReservationRequest.ProtoReflect.Descriptor instead.
-func (*CancelReservationRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CreateBikeRequest.ProtoReflect.Descriptor instead.
+func (*CreateBikeRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CancelReservationRequest) GetId() string {
+func (x *CreateBikeRequest) GetData() *BikeData {
 	if x != nil {
-		return x.Id
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *CancelReservationRequest) GetBikeId() string {
-	if x != nil {
-		return x.BikeId
+type UpdateBikeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data *BikeData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UpdateBikeRequest) Reset() {
+	*x = UpdateBikeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-var File_nglogic_bikerental_v1_service_proto protoreflect.FileDescriptor
+func (x *UpdateBikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_nglogic_bikerental_v1_service_proto_rawDesc = []byte{
-	0x0a, 0x23, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
-	0x6e, 0x74, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
-	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a, 0x04, 0x42, 0x69, 0x6b, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
-	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x64, 0x0a, 0x08, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x22, 0x53, 0x0a, 0x08, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
-	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x96, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x23, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
-	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x89, 0x03, 0x0a, 0x0b, 0x52, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
-	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x63,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x62, 0x69, 0x6b, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
-	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x69, 0x6b, 0x65, 0x52, 0x04, 0x62, 0x69, 0x6b, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x44, 0x69, 0x73,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x30, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03,
-	0x6c, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x04, 0x6c, 0x6f, 0x6e, 0x67, 0x22, 0x46, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42,
-	0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05,
-	0x62, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x67,
-	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x05, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x22,
-	0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x48, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
-	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x58, 0x0a, 0x11, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
-	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
-	0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa7, 0x01, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65,
-	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x41,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x22, 0x9f, 0x02, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
-	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
-	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
-	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x44, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
-	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
-	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x62, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c,
-	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
-	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x43, 0x0a, 0x18,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49,
-	0x64, 0x2a, 0x63, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
-	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e,
-	0x44, 0x49, 0x56, 0x49, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x55,
-	0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x55, 0x53, 0x49,
-	0x4e, 0x45, 0x53, 0x53, 0x10, 0x02, 0x2a, 0x97, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a,
-	0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b,
-	0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a,
-	0x1b, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x20,
-	0x0a, 0x1c, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03,
-	0x32, 0xb4, 0x09, 0x0a, 0x11, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69,
-	0x6b, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e, 0x6e, 0x67,
-	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f,
-	0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x67, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42,
-	0x69, 0x6b, 0x65, 0x12, 0x25, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
-	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x67, 0x6c,
-	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12,
-	0x10, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a,
-	0x7d, 0x12, 0x6c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x12,
-	0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
-	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69,
-	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
-	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x09,
-	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x3a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x68, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x12, 0x28, 0x2e,
-	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b,
-	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x12, 0x6e, 0x0a, 0x0a, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x12, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
-	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x18, 0x1a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
-	0x3d, 0x2a, 0x7d, 0x3a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0xa8, 0x01, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x12, 0x31, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
-	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b,
-	0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
-	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
-	0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b,
-	0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x12, 0x9f, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
-	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
-	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62,
-	0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa5, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6e,
-	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
-	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b,
-	0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x96,
-	0x01, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
-	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x38, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x30, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73,
-	0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d,
-	0x3a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x67, 0x6f,
-	0x2d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x67, 0x75, 0x69,
-	0x64, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x72,
-	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x76, 0x31, 0x3b, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
-	0x61, 0x6c, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (*UpdateBikeRequest) ProtoMessage() {}
+
+func (x *UpdateBikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var (
-	file_nglogic_bikerental_v1_service_proto_rawDescOnce sync.Once
-	file_nglogic_bikerental_v1_service_proto_rawDescData = file_nglogic_bikerental_v1_service_proto_rawDesc
-)
+// Deprecated: Use UpdateBikeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBikeRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{10}
+}
 
-func file_nglogic_bikerental_v1_service_proto_rawDescGZIP() []byte {
-	file_nglogic_bikerental_v1_service_proto_rawDescOnce.Do(func() {
-		file_nglogic_bikerental_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_nglogic_bikerental_v1_service_proto_rawDescData)
-	})
-	return file_nglogic_bikerental_v1_service_proto_rawDescData
+func (x *UpdateBikeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-var file_nglogic_bikerental_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_nglogic_bikerental_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
-var file_nglogic_bikerental_v1_service_proto_goTypes = []interface{}{
-	(CustomerType)(0),                   // 0: nglogic.bikerental.v1.CustomerType
-	(ReservationStatus)(0),              // 1: nglogic.bikerental.v1.ReservationStatus
-	(*Bike)(nil),                        // 2: nglogic.bikerental.v1.Bike
-	(*BikeData)(nil),                    // 3: nglogic.bikerental.v1.BikeData
-	(*Customer)(nil),                    // 4: nglogic.bikerental.v1.Customer
-	(*CustomerData)(nil),                // 5: nglogic.bikerental.v1.CustomerData
-	(*Reservation)(nil),                 // 6: nglogic.bikerental.v1.Reservation
-	(*Location)(nil),                    // 7: nglogic.bikerental.v1.Location
-	(*ListBikesResponse)(nil),           // 8: nglogic.bikerental.v1.ListBikesResponse
-	(*GetBikeRequest)(nil),              // 9: nglogic.bikerental.v1.GetBikeRequest
-	(*CreateBikeRequest)(nil),           // 10: nglogic.bikerental.v1.CreateBikeRequest
-	(*UpdateBikeRequest)(nil),           // 11: nglogic.bikerental.v1.UpdateBikeRequest
-	(*DeleteBikeRequest)(nil),           // 12: nglogic.bikerental.v1.DeleteBikeRequest
-	(*GetBikeAvailabilityRequest)(nil),  // 13: nglogic.bikerental.v1.GetBikeAvailabilityRequest
-	(*GetBikeAvailabilityResponse)(nil), // 14: nglogic.bikerental.v1.GetBikeAvailabilityResponse
-	(*CreateReservationRequest)(nil),    // 15: nglogic.bikerental.v1.CreateReservationRequest
-	(*CreateReservationResponse)(nil),   // 16: nglogic.bikerental.v1.CreateReservationResponse
-	(*ListReservationsRequest)(nil),     // 17: nglogic.bikerental.v1.ListReservationsRequest
-	(*ListReservationsResponse)(nil),    // 18: nglogic.bikerental.v1.ListReservationsResponse
-	(*CancelReservationRequest)(nil),    // 19: nglogic.bikerental.v1.CancelReservationRequest
-	(*timestamp.Timestamp)(nil),         // 20: google.protobuf.Timestamp
-	(*empty.Empty)(nil),                 // 21: google.protobuf.Empty
+func (x *UpdateBikeRequest) GetData() *BikeData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
-var file_nglogic_bikerental_v1_service_proto_depIdxs = []int32{
-	3,  // 0: nglogic.bikerental.v1.Bike.data:type_name -> nglogic.bikerental.v1.BikeData
-	5,  // 1: nglogic.bikerental.v1.Customer.data:type_name -> nglogic.bikerental.v1.CustomerData
-	0,  // 2: nglogic.bikerental.v1.CustomerData.type:type_name -> nglogic.bikerental.v1.CustomerType
-	1,  // 3: nglogic.bikerental.v1.Reservation.status:type_name -> nglogic.bikerental.v1.ReservationStatus
-	4,  // 4: nglogic.bikerental.v1.Reservation.customer:type_name -> nglogic.bikerental.v1.Customer
-	2,  // 5: nglogic.bikerental.v1.Reservation.bike:type_name -> nglogic.bikerental.v1.Bike
-	20, // 6: nglogic.bikerental.v1.Reservation.start_time:type_name -> google.protobuf.Timestamp
-	20, // 7: nglogic.bikerental.v1.Reservation.end_time:type_name -> google.protobuf.Timestamp
-	2,  // 8: nglogic.bikerental.v1.ListBikesResponse.bikes:type_name -> nglogic.bikerental.v1.Bike
-	3,  // 9: nglogic.bikerental.v1.CreateBikeRequest.data:type_name -> nglogic.bikerental.v1.BikeData
-	3,  // 10: nglogic.bikerental.v1.UpdateBikeRequest.data:type_name -> nglogic.bikerental.v1.BikeData
-	20, // 11: nglogic.bikerental.v1.GetBikeAvailabilityRequest.start_time:type_name -> google.protobuf.Timestamp
-	20, // 12: nglogic.bikerental.v1.GetBikeAvailabilityRequest.end_time:type_name -> google.protobuf.Timestamp
-	4,  // 13: nglogic.bikerental.v1.CreateReservationRequest.customer:type_name -> nglogic.bikerental.v1.Customer
-	7,  // 14: nglogic.bikerental.v1.CreateReservationRequest.location:type_name -> nglogic.bikerental.v1.Location
-	20, // 15: nglogic.bikerental.v1.CreateReservationRequest.start_time:type_name -> google.protobuf.Timestamp
-	20, // 16: nglogic.bikerental.v1.CreateReservationRequest.end_time:type_name -> google.protobuf.Timestamp
-	6,  // 17: nglogic.bikerental.v1.CreateReservationResponse.reservation:type_name -> nglogic.bikerental.v1.Reservation
-	1,  // 18: nglogic.bikerental.v1.CreateReservationResponse.status:type_name -> nglogic.bikerental.v1.ReservationStatus
-	20, // 19: nglogic.bikerental.v1.ListReservationsRequest.start_time:type_name -> google.protobuf.Timestamp
-	20, // 20: nglogic.bikerental.v1.ListReservationsRequest.end_time:type_name -> google.protobuf.Timestamp
-	6,  // 21: nglogic.bikerental.v1.ListReservationsResponse.reservations:type_name -> nglogic.bikerental.v1.Reservation
-	21, // 22: nglogic.bikerental.v1.BikeRentalService.ListBikes:input_type -> google.protobuf.Empty
-	9,  // 23: nglogic.bikerental.v1.BikeRentalService.GetBike:input_type -> nglogic.bikerental.v1.GetBikeRequest
-	10, // 24: nglogic.bikerental.v1.BikeRentalService.CreateBike:input_type -> nglogic.bikerental.v1.CreateBikeRequest
-	12, // 25: nglogic.bikerental.v1.BikeRentalService.DeleteBike:input_type -> nglogic.bikerental.v1.DeleteBikeRequest
-	11, // 26: nglogic.bikerental.v1.BikeRentalService.UpdateBike:input_type -> nglogic.bikerental.v1.UpdateBikeRequest
-	13, // 27: nglogic.bikerental.v1.BikeRentalService.GetBikeAvailability:input_type -> nglogic.bikerental.v1.GetBikeAvailabilityRequest
-	17, // 28: nglogic.bikerental.v1.BikeRentalService.ListReservations:input_type -> nglogic.bikerental.v1.ListReservationsRequest
-	15, // 29: nglogic.bikerental.v1.BikeRentalService.CreateReservation:input_type -> nglogic.bikerental.v1.CreateReservationRequest
-	19, // 30: nglogic.bikerental.v1.BikeRentalService.CancelReservation:input_type -> nglogic.bikerental.v1.CancelReservationRequest
-	8,  // 31: nglogic.bikerental.v1.BikeRentalService.ListBikes:output_type -> nglogic.bikerental.v1.ListBikesResponse
-	2,  // 32: nglogic.bikerental.v1.BikeRentalService.GetBike:output_type -> nglogic.bikerental.v1.Bike
-	2,  // 33: nglogic.bikerental.v1.BikeRentalService.CreateBike:output_type -> nglogic.bikerental.v1.Bike
-	21, // 34: nglogic.bikerental.v1.BikeRentalService.DeleteBike:output_type -> google.protobuf.Empty
-	21, // 35: nglogic.bikerental.v1.BikeRentalService.UpdateBike:output_type -> google.protobuf.Empty
-	14, // 36: nglogic.bikerental.v1.BikeRentalService.GetBikeAvailability:output_type -> nglogic.bikerental.v1.GetBikeAvailabilityResponse
-	18, // 37: nglogic.bikerental.v1.BikeRentalService.ListReservations:output_type -> nglogic.bikerental.v1.ListReservationsResponse
-	16, // 38: nglogic.bikerental.v1.BikeRentalService.CreateReservation:output_type -> nglogic.bikerental.v1.CreateReservationResponse
-	21, // 39: nglogic.bikerental.v1.BikeRentalService.CancelReservation:output_type -> google.protobuf.Empty
-	31, // [31:40] is the sub-list for method output_type
-	22, // [22:31] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+
+type DeleteBikeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { file_nglogic_bikerental_v1_service_proto_init() }
-func file_nglogic_bikerental_v1_service_proto_init() {
-	if File_nglogic_bikerental_v1_service_proto != nil {
-		return
+func (x *DeleteBikeRequest) Reset() {
+	*x = DeleteBikeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_nglogic_bikerental_v1_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Bike); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *DeleteBikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBikeRequest) ProtoMessage() {}
+
+func (x *DeleteBikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BikeData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBikeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBikeRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteBikeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBikeAvailabilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *GetBikeAvailabilityRequest) Reset() {
+	*x = GetBikeAvailabilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBikeAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBikeAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetBikeAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Customer); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBikeAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetBikeAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetBikeAvailabilityRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *GetBikeAvailabilityRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetBikeAvailabilityRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type GetBikeAvailabilityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Available bool `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+}
+
+func (x *GetBikeAvailabilityResponse) Reset() {
+	*x = GetBikeAvailabilityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBikeAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBikeAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetBikeAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomerData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Reservation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Location); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBikesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBikeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBikeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBikeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBikeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBikeAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetBikeAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetBikeAvailabilityResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type GetPriceQuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *GetPriceQuoteRequest) Reset() {
+	*x = GetPriceQuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPriceQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceQuoteRequest) ProtoMessage() {}
+
+func (x *GetPriceQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBikeAvailabilityRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPriceQuoteRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *GetPriceQuoteRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetPriceQuoteRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type PriceQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*PriceQuoteItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Deprecated: use total.
+	//
+	// Deprecated: Do not use.
+	TotalValue int32  `protobuf:"varint,2,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	Total      *Money `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *PriceQuote) Reset() {
+	*x = PriceQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceQuote) ProtoMessage() {}
+
+func (x *PriceQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBikeAvailabilityResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceQuote.ProtoReflect.Descriptor instead.
+func (*PriceQuote) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PriceQuote) GetItems() []*PriceQuoteItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *PriceQuote) GetTotalValue() int32 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type PriceQuoteItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime   *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Description string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: use hourly_price.
+	//
+	// Deprecated: Do not use.
+	PricePerHour int32   `protobuf:"varint,4,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"`
+	ChargedHours float64 `protobuf:"fixed64,5,opt,name=charged_hours,json=chargedHours,proto3" json:"charged_hours,omitempty"`
+	// Deprecated: use amount.
+	//
+	// Deprecated: Do not use.
+	Value       int32  `protobuf:"varint,6,opt,name=value,proto3" json:"value,omitempty"`
+	HourlyPrice *Money `protobuf:"bytes,7,opt,name=hourly_price,json=hourlyPrice,proto3" json:"hourly_price,omitempty"`
+	Amount      *Money `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PriceQuoteItem) Reset() {
+	*x = PriceQuoteItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceQuoteItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceQuoteItem) ProtoMessage() {}
+
+func (x *PriceQuoteItem) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateReservationRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateReservationResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListReservationsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListReservationsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceQuoteItem.ProtoReflect.Descriptor instead.
+func (*PriceQuoteItem) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PriceQuoteItem) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *PriceQuoteItem) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *PriceQuoteItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *PriceQuoteItem) GetPricePerHour() int32 {
+	if x != nil {
+		return x.PricePerHour
+	}
+	return 0
+}
+
+func (x *PriceQuoteItem) GetChargedHours() float64 {
+	if x != nil {
+		return x.ChargedHours
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *PriceQuoteItem) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PriceQuoteItem) GetHourlyPrice() *Money {
+	if x != nil {
+		return x.HourlyPrice
+	}
+	return nil
+}
+
+func (x *PriceQuoteItem) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type CreateReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	Customer  *Customer            `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
+	Location  *Location            `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Optional promo code.
+	PromoCode string `protobuf:"bytes,6,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	// Optional number of loyalty points to redeem.
+	RedeemPoints int32 `protobuf:"varint,7,opt,name=redeem_points,json=redeemPoints,proto3" json:"redeem_points,omitempty"`
+}
+
+func (x *CreateReservationRequest) Reset() {
+	*x = CreateReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationRequest) ProtoMessage() {}
+
+func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelReservationRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationRequest.ProtoReflect.Descriptor instead.
+func (*CreateReservationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateReservationRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetRedeemPoints() int32 {
+	if x != nil {
+		return x.RedeemPoints
+	}
+	return 0
+}
+
+type CreateReservationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservation *Reservation      `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	Status      ReservationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=nglogic.bikerental.v1.ReservationStatus" json:"status,omitempty"`
+	Reason      string            `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CreateReservationResponse) Reset() {
+	*x = CreateReservationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationResponse) ProtoMessage() {}
+
+func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationResponse.ProtoReflect.Descriptor instead.
+func (*CreateReservationResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *CreateReservationResponse) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNKNOWN
+}
+
+func (x *CreateReservationResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListReservationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *ListReservationsRequest) Reset() {
+	*x = ListReservationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsRequest) ProtoMessage() {}
+
+func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListReservationsRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *ListReservationsRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListReservationsRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListReservationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservations []*Reservation `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+}
+
+func (x *ListReservationsResponse) Reset() {
+	*x = ListReservationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsResponse) ProtoMessage() {}
+
+func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListReservationsResponse) GetReservations() []*Reservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+type WatchReservationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional bike id filter.
+	BikeId string `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	// Optional station id filter.
+	StationId string `protobuf:"bytes,2,opt,name=station_id,json=stationId,proto3" json:"station_id,omitempty"`
+}
+
+func (x *WatchReservationsRequest) Reset() {
+	*x = WatchReservationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchReservationsRequest) ProtoMessage() {}
+
+func (x *WatchReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchReservationsRequest.ProtoReflect.Descriptor instead.
+func (*WatchReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WatchReservationsRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *WatchReservationsRequest) GetStationId() string {
+	if x != nil {
+		return x.StationId
+	}
+	return ""
+}
+
+type ReservationChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type ReservationChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=nglogic.bikerental.v1.ReservationChangeType" json:"type,omitempty"`
+	// Reservation after the change.
+	Reservation *Reservation         `protobuf:"bytes,2,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	OccurredAt  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+}
+
+func (x *ReservationChange) Reset() {
+	*x = ReservationChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationChange) ProtoMessage() {}
+
+func (x *ReservationChange) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationChange.ProtoReflect.Descriptor instead.
+func (*ReservationChange) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReservationChange) GetType() ReservationChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return ReservationChangeType_RESERVATION_CHANGE_TYPE_UNKNOWN
+}
+
+func (x *ReservationChange) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ReservationChange) GetOccurredAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type WatchBikeAvailabilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional bike id filter.
+	BikeId string `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	// Optional station id filter.
+	StationId string `protobuf:"bytes,2,opt,name=station_id,json=stationId,proto3" json:"station_id,omitempty"`
+}
+
+func (x *WatchBikeAvailabilityRequest) Reset() {
+	*x = WatchBikeAvailabilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchBikeAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBikeAvailabilityRequest) ProtoMessage() {}
+
+func (x *WatchBikeAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBikeAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*WatchBikeAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WatchBikeAvailabilityRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *WatchBikeAvailabilityRequest) GetStationId() string {
+	if x != nil {
+		return x.StationId
+	}
+	return ""
+}
+
+type BikeAvailabilityChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	StationId string               `protobuf:"bytes,2,opt,name=station_id,json=stationId,proto3" json:"station_id,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Available is false if the bike was reserved in the time range, true if reservation was canceled or completed.
+	Available  bool                 `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	OccurredAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+}
+
+func (x *BikeAvailabilityChange) Reset() {
+	*x = BikeAvailabilityChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BikeAvailabilityChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BikeAvailabilityChange) ProtoMessage() {}
+
+func (x *BikeAvailabilityChange) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BikeAvailabilityChange.ProtoReflect.Descriptor instead.
+func (*BikeAvailabilityChange) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BikeAvailabilityChange) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *BikeAvailabilityChange) GetStationId() string {
+	if x != nil {
+		return x.StationId
+	}
+	return ""
+}
+
+func (x *BikeAvailabilityChange) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *BikeAvailabilityChange) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *BikeAvailabilityChange) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *BikeAvailabilityChange) GetOccurredAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type CancelReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *CancelReservationRequest) Reset() {
+	*x = CancelReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationRequest) ProtoMessage() {}
+
+func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationRequest.ProtoReflect.Descriptor instead.
+func (*CancelReservationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CancelReservationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelReservationRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+type CompleteReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *CompleteReservationRequest) Reset() {
+	*x = CompleteReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteReservationRequest) ProtoMessage() {}
+
+func (x *CompleteReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteReservationRequest.ProtoReflect.Descriptor instead.
+func (*CompleteReservationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CompleteReservationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteReservationRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+type RefundReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *RefundReservationRequest) Reset() {
+	*x = RefundReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundReservationRequest) ProtoMessage() {}
+
+func (x *RefundReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundReservationRequest.ProtoReflect.Descriptor instead.
+func (*RefundReservationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RefundReservationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RefundReservationRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+type DamageReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReservationId string         `protobuf:"bytes,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	BikeId        string         `protobuf:"bytes,3,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	Description   string         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Severity      DamageSeverity `protobuf:"varint,5,opt,name=severity,proto3,enum=nglogic.bikerental.v1.DamageSeverity" json:"severity,omitempty"`
+	// Deprecated: use repair_cost.
+	//
+	// Deprecated: Do not use.
+	RepairCostEstimate int32                `protobuf:"varint,6,opt,name=repair_cost_estimate,json=repairCostEstimate,proto3" json:"repair_cost_estimate,omitempty"`
+	PhotoIds           []string             `protobuf:"bytes,7,rep,name=photo_ids,json=photoIds,proto3" json:"photo_ids,omitempty"`
+	CreatedAt          *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Estimate in reservation currency.
+	RepairCost *Money `protobuf:"bytes,9,opt,name=repair_cost,json=repairCost,proto3" json:"repair_cost,omitempty"`
+}
+
+func (x *DamageReport) Reset() {
+	*x = DamageReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DamageReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DamageReport) ProtoMessage() {}
+
+func (x *DamageReport) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DamageReport.ProtoReflect.Descriptor instead.
+func (*DamageReport) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DamageReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DamageReport) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *DamageReport) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *DamageReport) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DamageReport) GetSeverity() DamageSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return DamageSeverity_DAMAGE_SEVERITY_UNKNOWN
+}
+
+// Deprecated: Do not use.
+func (x *DamageReport) GetRepairCostEstimate() int32 {
+	if x != nil {
+		return x.RepairCostEstimate
+	}
+	return 0
+}
+
+func (x *DamageReport) GetPhotoIds() []string {
+	if x != nil {
+		return x.PhotoIds
+	}
+	return nil
+}
+
+func (x *DamageReport) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DamageReport) GetRepairCost() *Money {
+	if x != nil {
+		return x.RepairCost
+	}
+	return nil
+}
+
+type DamageReportPhoto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data        []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DamageReportPhoto) Reset() {
+	*x = DamageReportPhoto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DamageReportPhoto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DamageReportPhoto) ProtoMessage() {}
+
+func (x *DamageReportPhoto) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DamageReportPhoto.ProtoReflect.Descriptor instead.
+func (*DamageReportPhoto) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DamageReportPhoto) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DamageReportPhoto) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type FileDamageReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId        string         `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	ReservationId string         `protobuf:"bytes,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Description   string         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Severity      DamageSeverity `protobuf:"varint,4,opt,name=severity,proto3,enum=nglogic.bikerental.v1.DamageSeverity" json:"severity,omitempty"`
+	// Deprecated: use repair_cost. Estimate in reservation currency, used only if repair_cost is not set.
+	//
+	// Deprecated: Do not use.
+	RepairCostEstimate int32                `protobuf:"varint,5,opt,name=repair_cost_estimate,json=repairCostEstimate,proto3" json:"repair_cost_estimate,omitempty"`
+	Photos             []*DamageReportPhoto `protobuf:"bytes,6,rep,name=photos,proto3" json:"photos,omitempty"`
+	// Estimate has to be in reservation currency.
+	RepairCost *Money `protobuf:"bytes,7,opt,name=repair_cost,json=repairCost,proto3" json:"repair_cost,omitempty"`
+}
+
+func (x *FileDamageReportRequest) Reset() {
+	*x = FileDamageReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileDamageReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileDamageReportRequest) ProtoMessage() {}
+
+func (x *FileDamageReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileDamageReportRequest.ProtoReflect.Descriptor instead.
+func (*FileDamageReportRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FileDamageReportRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *FileDamageReportRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *FileDamageReportRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FileDamageReportRequest) GetSeverity() DamageSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return DamageSeverity_DAMAGE_SEVERITY_UNKNOWN
+}
+
+// Deprecated: Do not use.
+func (x *FileDamageReportRequest) GetRepairCostEstimate() int32 {
+	if x != nil {
+		return x.RepairCostEstimate
+	}
+	return 0
+}
+
+func (x *FileDamageReportRequest) GetPhotos() []*DamageReportPhoto {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
+func (x *FileDamageReportRequest) GetRepairCost() *Money {
+	if x != nil {
+		return x.RepairCost
+	}
+	return nil
+}
+
+type ListDamageReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId        string `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	ReservationId string `protobuf:"bytes,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+}
+
+func (x *ListDamageReportsRequest) Reset() {
+	*x = ListDamageReportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDamageReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDamageReportsRequest) ProtoMessage() {}
+
+func (x *ListDamageReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDamageReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListDamageReportsRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListDamageReportsRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *ListDamageReportsRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type ListDamageReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DamageReports []*DamageReport `protobuf:"bytes,1,rep,name=damage_reports,json=damageReports,proto3" json:"damage_reports,omitempty"`
+}
+
+func (x *ListDamageReportsResponse) Reset() {
+	*x = ListDamageReportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDamageReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDamageReportsResponse) ProtoMessage() {}
+
+func (x *ListDamageReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDamageReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListDamageReportsResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListDamageReportsResponse) GetDamageReports() []*DamageReport {
+	if x != nil {
+		return x.DamageReports
+	}
+	return nil
+}
+
+type GetDamageReportPhotoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId   string `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	ReportId string `protobuf:"bytes,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	PhotoId  string `protobuf:"bytes,3,opt,name=photo_id,json=photoId,proto3" json:"photo_id,omitempty"`
+}
+
+func (x *GetDamageReportPhotoRequest) Reset() {
+	*x = GetDamageReportPhotoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDamageReportPhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDamageReportPhotoRequest) ProtoMessage() {}
+
+func (x *GetDamageReportPhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDamageReportPhotoRequest.ProtoReflect.Descriptor instead.
+func (*GetDamageReportPhotoRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetDamageReportPhotoRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *GetDamageReportPhotoRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *GetDamageReportPhotoRequest) GetPhotoId() string {
+	if x != nil {
+		return x.PhotoId
+	}
+	return ""
+}
+
+type ListOpenIntervalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StationId string               `protobuf:"bytes,1,opt,name=station_id,json=stationId,proto3" json:"station_id,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *ListOpenIntervalsRequest) Reset() {
+	*x = ListOpenIntervalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOpenIntervalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenIntervalsRequest) ProtoMessage() {}
+
+func (x *ListOpenIntervalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenIntervalsRequest.ProtoReflect.Descriptor instead.
+func (*ListOpenIntervalsRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListOpenIntervalsRequest) GetStationId() string {
+	if x != nil {
+		return x.StationId
+	}
+	return ""
+}
+
+func (x *ListOpenIntervalsRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListOpenIntervalsRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListOpenIntervalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Intervals []*OpenInterval `protobuf:"bytes,1,rep,name=intervals,proto3" json:"intervals,omitempty"`
+}
+
+func (x *ListOpenIntervalsResponse) Reset() {
+	*x = ListOpenIntervalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOpenIntervalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenIntervalsResponse) ProtoMessage() {}
+
+func (x *ListOpenIntervalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenIntervalsResponse.ProtoReflect.Descriptor instead.
+func (*ListOpenIntervalsResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListOpenIntervalsResponse) GetIntervals() []*OpenInterval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+type OpenInterval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *OpenInterval) Reset() {
+	*x = OpenInterval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenInterval) ProtoMessage() {}
+
+func (x *OpenInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenInterval.ProtoReflect.Descriptor instead.
+func (*OpenInterval) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *OpenInterval) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *OpenInterval) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ReservationTax struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Country   string       `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	Treatment TaxTreatment `protobuf:"varint,2,opt,name=treatment,proto3,enum=nglogic.bikerental.v1.TaxTreatment" json:"treatment,omitempty"`
+	// VAT rate in percent.
+	Rate float64 `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Net  *Money  `protobuf:"bytes,4,opt,name=net,proto3" json:"net,omitempty"`
+	Tax  *Money  `protobuf:"bytes,5,opt,name=tax,proto3" json:"tax,omitempty"`
+}
+
+func (x *ReservationTax) Reset() {
+	*x = ReservationTax{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationTax) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationTax) ProtoMessage() {}
+
+func (x *ReservationTax) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationTax.ProtoReflect.Descriptor instead.
+func (*ReservationTax) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReservationTax) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ReservationTax) GetTreatment() TaxTreatment {
+	if x != nil {
+		return x.Treatment
+	}
+	return TaxTreatment_TAX_TREATMENT_NONE
+}
+
+func (x *ReservationTax) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ReservationTax) GetNet() *Money {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *ReservationTax) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+type GetReservationInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId string `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	Id     string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetReservationInvoiceRequest) Reset() {
+	*x = GetReservationInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReservationInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationInvoiceRequest) ProtoMessage() {}
+
+func (x *GetReservationInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetReservationInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetReservationInvoiceRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *GetReservationInvoiceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Invoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number        string               `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	ReservationId string               `protobuf:"bytes,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	IssuedAt      *timestamp.Timestamp `protobuf:"bytes,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	Customer      *Customer            `protobuf:"bytes,4,opt,name=customer,proto3" json:"customer,omitempty"`
+	// Country where the tax is due.
+	Country   string         `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	Treatment TaxTreatment   `protobuf:"varint,6,opt,name=treatment,proto3,enum=nglogic.bikerental.v1.TaxTreatment" json:"treatment,omitempty"`
+	Lines     []*InvoiceLine `protobuf:"bytes,7,rep,name=lines,proto3" json:"lines,omitempty"`
+	// Lines summed by tax rate.
+	TaxLines []*InvoiceTaxLine `protobuf:"bytes,8,rep,name=tax_lines,json=taxLines,proto3" json:"tax_lines,omitempty"`
+	Net      *Money            `protobuf:"bytes,9,opt,name=net,proto3" json:"net,omitempty"`
+	Tax      *Money            `protobuf:"bytes,10,opt,name=tax,proto3" json:"tax,omitempty"`
+	Gross    *Money            `protobuf:"bytes,11,opt,name=gross,proto3" json:"gross,omitempty"`
+	Note     string            `protobuf:"bytes,12,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Invoice) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Invoice) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *Invoice) GetIssuedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *Invoice) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Invoice) GetTreatment() TaxTreatment {
+	if x != nil {
+		return x.Treatment
+	}
+	return TaxTreatment_TAX_TREATMENT_NONE
+}
+
+func (x *Invoice) GetLines() []*InvoiceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Invoice) GetTaxLines() []*InvoiceTaxLine {
+	if x != nil {
+		return x.TaxLines
+	}
+	return nil
+}
+
+func (x *Invoice) GetNet() *Money {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *Invoice) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *Invoice) GetGross() *Money {
+	if x != nil {
+		return x.Gross
+	}
+	return nil
+}
+
+func (x *Invoice) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type InvoiceLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// Tax-inclusive discount, already subtracted from line amounts.
+	Discount *Money  `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	TaxRate  float64 `protobuf:"fixed64,3,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	Net      *Money  `protobuf:"bytes,4,opt,name=net,proto3" json:"net,omitempty"`
+	Tax      *Money  `protobuf:"bytes,5,opt,name=tax,proto3" json:"tax,omitempty"`
+	Gross    *Money  `protobuf:"bytes,6,opt,name=gross,proto3" json:"gross,omitempty"`
+}
+
+func (x *InvoiceLine) Reset() {
+	*x = InvoiceLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceLine) ProtoMessage() {}
+
+func (x *InvoiceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceLine.ProtoReflect.Descriptor instead.
+func (*InvoiceLine) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *InvoiceLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InvoiceLine) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *InvoiceLine) GetTaxRate() float64 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetNet() *Money {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *InvoiceLine) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *InvoiceLine) GetGross() *Money {
+	if x != nil {
+		return x.Gross
+	}
+	return nil
+}
+
+type InvoiceTaxLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rate float64 `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Net  *Money  `protobuf:"bytes,2,opt,name=net,proto3" json:"net,omitempty"`
+	Tax  *Money  `protobuf:"bytes,3,opt,name=tax,proto3" json:"tax,omitempty"`
+}
+
+func (x *InvoiceTaxLine) Reset() {
+	*x = InvoiceTaxLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceTaxLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceTaxLine) ProtoMessage() {}
+
+func (x *InvoiceTaxLine) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceTaxLine.ProtoReflect.Descriptor instead.
+func (*InvoiceTaxLine) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *InvoiceTaxLine) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *InvoiceTaxLine) GetNet() *Money {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *InvoiceTaxLine) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+type PromoCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    string        `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Type    PromoCodeType `protobuf:"varint,2,opt,name=type,proto3,enum=nglogic.bikerental.v1.PromoCodeType" json:"type,omitempty"`
+	Percent float64       `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	// Deprecated: use fixed_amount. Amount in eurocents, used only if fixed_amount is not set.
+	//
+	// Deprecated: Do not use.
+	Amount                    int32                `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExpiresAt                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxRedemptions            int32                `protobuf:"varint,6,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	MaxRedemptionsPerCustomer int32                `protobuf:"varint,7,opt,name=max_redemptions_per_customer,json=maxRedemptionsPerCustomer,proto3" json:"max_redemptions_per_customer,omitempty"`
+	CustomerType              CustomerType         `protobuf:"varint,8,opt,name=customer_type,json=customerType,proto3,enum=nglogic.bikerental.v1.CustomerType" json:"customer_type,omitempty"`
+	BikeModelName             string               `protobuf:"bytes,9,opt,name=bike_model_name,json=bikeModelName,proto3" json:"bike_model_name,omitempty"`
+	Stackable                 bool                 `protobuf:"varint,10,opt,name=stackable,proto3" json:"stackable,omitempty"`
+	Redemptions               int32                `protobuf:"varint,11,opt,name=redemptions,proto3" json:"redemptions,omitempty"`
+	CreatedAt                 *timestamp.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Amount of fixed codes. It's converted to reservation currency when the code is used.
+	FixedAmount *Money `protobuf:"bytes,13,opt,name=fixed_amount,json=fixedAmount,proto3" json:"fixed_amount,omitempty"`
+}
+
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PromoCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PromoCode) GetType() PromoCodeType {
+	if x != nil {
+		return x.Type
+	}
+	return PromoCodeType_PROMO_CODE_TYPE_UNKNOWN
+}
+
+func (x *PromoCode) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *PromoCode) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PromoCode) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PromoCode) GetMaxRedemptions() int32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *PromoCode) GetMaxRedemptionsPerCustomer() int32 {
+	if x != nil {
+		return x.MaxRedemptionsPerCustomer
+	}
+	return 0
+}
+
+func (x *PromoCode) GetCustomerType() CustomerType {
+	if x != nil {
+		return x.CustomerType
+	}
+	return CustomerType_CUSTOMER_TYPE_UNKNOWN
+}
+
+func (x *PromoCode) GetBikeModelName() string {
+	if x != nil {
+		return x.BikeModelName
+	}
+	return ""
+}
+
+func (x *PromoCode) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+func (x *PromoCode) GetRedemptions() int32 {
+	if x != nil {
+		return x.Redemptions
+	}
+	return 0
+}
+
+func (x *PromoCode) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PromoCode) GetFixedAmount() *Money {
+	if x != nil {
+		return x.FixedAmount
+	}
+	return nil
+}
+
+type CreatePromoCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromoCode *PromoCode `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+}
+
+func (x *CreatePromoCodeRequest) Reset() {
+	*x = CreatePromoCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeRequest) ProtoMessage() {}
+
+func (x *CreatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreatePromoCodeRequest) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type ListPromoCodesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromoCodes []*PromoCode `protobuf:"bytes,1,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+}
+
+func (x *ListPromoCodesResponse) Reset() {
+	*x = ListPromoCodesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPromoCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesResponse) ProtoMessage() {}
+
+func (x *ListPromoCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesResponse.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListPromoCodesResponse) GetPromoCodes() []*PromoCode {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+type LoyaltyAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerId   string      `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Balance      int32       `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	EarnedPoints int32       `protobuf:"varint,3,opt,name=earned_points,json=earnedPoints,proto3" json:"earned_points,omitempty"`
+	Tier         LoyaltyTier `protobuf:"varint,4,opt,name=tier,proto3,enum=nglogic.bikerental.v1.LoyaltyTier" json:"tier,omitempty"`
+}
+
+func (x *LoyaltyAccount) Reset() {
+	*x = LoyaltyAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoyaltyAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoyaltyAccount) ProtoMessage() {}
+
+func (x *LoyaltyAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoyaltyAccount.ProtoReflect.Descriptor instead.
+func (*LoyaltyAccount) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LoyaltyAccount) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *LoyaltyAccount) GetBalance() int32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *LoyaltyAccount) GetEarnedPoints() int32 {
+	if x != nil {
+		return x.EarnedPoints
+	}
+	return 0
+}
+
+func (x *LoyaltyAccount) GetTier() LoyaltyTier {
+	if x != nil {
+		return x.Tier
+	}
+	return LoyaltyTier_LOYALTY_TIER_NONE
+}
+
+type LoyaltyEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    string               `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ReservationId string               `protobuf:"bytes,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Kind          LoyaltyEntryKind     `protobuf:"varint,4,opt,name=kind,proto3,enum=nglogic.bikerental.v1.LoyaltyEntryKind" json:"kind,omitempty"`
+	Points        int32                `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`
+	CreatedAt     *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *LoyaltyEntry) Reset() {
+	*x = LoyaltyEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoyaltyEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoyaltyEntry) ProtoMessage() {}
+
+func (x *LoyaltyEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoyaltyEntry.ProtoReflect.Descriptor instead.
+func (*LoyaltyEntry) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LoyaltyEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoyaltyEntry) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *LoyaltyEntry) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *LoyaltyEntry) GetKind() LoyaltyEntryKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LoyaltyEntryKind_LOYALTY_ENTRY_KIND_UNKNOWN
+}
+
+func (x *LoyaltyEntry) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *LoyaltyEntry) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetLoyaltyAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerId string `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+}
+
+func (x *GetLoyaltyAccountRequest) Reset() {
+	*x = GetLoyaltyAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoyaltyAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoyaltyAccountRequest) ProtoMessage() {}
+
+func (x *GetLoyaltyAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoyaltyAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetLoyaltyAccountRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetLoyaltyAccountRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type ListLoyaltyEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerId string `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+}
+
+func (x *ListLoyaltyEntriesRequest) Reset() {
+	*x = ListLoyaltyEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoyaltyEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoyaltyEntriesRequest) ProtoMessage() {}
+
+func (x *ListLoyaltyEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoyaltyEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListLoyaltyEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListLoyaltyEntriesRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type ListLoyaltyEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LoyaltyEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListLoyaltyEntriesResponse) Reset() {
+	*x = ListLoyaltyEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoyaltyEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoyaltyEntriesResponse) ProtoMessage() {}
+
+func (x *ListLoyaltyEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoyaltyEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListLoyaltyEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListLoyaltyEntriesResponse) GetEntries() []*LoyaltyEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type Company struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data *CompanyData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Company) Reset() {
+	*x = Company{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Company) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Company) ProtoMessage() {}
+
+func (x *Company) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Company.ProtoReflect.Descriptor instead.
+func (*Company) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Company) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Company) GetData() *CompanyData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CompanyData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DiscountPercent float64 `protobuf:"fixed64,2,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	// Deprecated: use monthly_spending_limit. Limit in eurocents, used only if monthly_spending_limit is not set.
+	//
+	// Deprecated: Do not use.
+	SpendingLimit int32 `protobuf:"varint,3,opt,name=spending_limit,json=spendingLimit,proto3" json:"spending_limit,omitempty"`
+	// Monthly limit of members reservations value, in EUR. Zero means no limit.
+	MonthlySpendingLimit *Money `protobuf:"bytes,4,opt,name=monthly_spending_limit,json=monthlySpendingLimit,proto3" json:"monthly_spending_limit,omitempty"`
+}
+
+func (x *CompanyData) Reset() {
+	*x = CompanyData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompanyData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyData) ProtoMessage() {}
+
+func (x *CompanyData) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanyData.ProtoReflect.Descriptor instead.
+func (*CompanyData) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CompanyData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CompanyData) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *CompanyData) GetSpendingLimit() int32 {
+	if x != nil {
+		return x.SpendingLimit
+	}
+	return 0
+}
+
+func (x *CompanyData) GetMonthlySpendingLimit() *Money {
+	if x != nil {
+		return x.MonthlySpendingLimit
+	}
+	return nil
+}
+
+type ListCompaniesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Companies []*Company `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+}
+
+func (x *ListCompaniesResponse) Reset() {
+	*x = ListCompaniesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCompaniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesResponse) ProtoMessage() {}
+
+func (x *ListCompaniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesResponse.ProtoReflect.Descriptor instead.
+func (*ListCompaniesResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListCompaniesResponse) GetCompanies() []*Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+type GetCompanyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetCompanyRequest) Reset() {
+	*x = GetCompanyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyRequest) ProtoMessage() {}
+
+func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetCompanyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateCompanyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *CompanyData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CreateCompanyRequest) Reset() {
+	*x = CreateCompanyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyRequest) ProtoMessage() {}
+
+func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateCompanyRequest) GetData() *CompanyData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateCompanyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data *CompanyData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UpdateCompanyRequest) Reset() {
+	*x = UpdateCompanyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateCompanyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetData() *CompanyData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListCompanyMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId string `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+}
+
+func (x *ListCompanyMembersRequest) Reset() {
+	*x = ListCompanyMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCompanyMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompanyMembersRequest) ProtoMessage() {}
+
+func (x *ListCompanyMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompanyMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListCompanyMembersRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListCompanyMembersRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+type ListCompanyMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*Customer `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *ListCompanyMembersResponse) Reset() {
+	*x = ListCompanyMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCompanyMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompanyMembersResponse) ProtoMessage() {}
+
+func (x *ListCompanyMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompanyMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListCompanyMembersResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListCompanyMembersResponse) GetMembers() []*Customer {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AddCompanyMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId  string `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CustomerId string `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+}
+
+func (x *AddCompanyMemberRequest) Reset() {
+	*x = AddCompanyMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCompanyMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCompanyMemberRequest) ProtoMessage() {}
+
+func (x *AddCompanyMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCompanyMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddCompanyMemberRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AddCompanyMemberRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *AddCompanyMemberRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type RemoveCompanyMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId  string `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CustomerId string `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+}
+
+func (x *RemoveCompanyMemberRequest) Reset() {
+	*x = RemoveCompanyMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCompanyMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCompanyMemberRequest) ProtoMessage() {}
+
+func (x *RemoveCompanyMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCompanyMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCompanyMemberRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RemoveCompanyMemberRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *RemoveCompanyMemberRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type GetCompanyStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId string `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Year      int32  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Month     int32  `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+}
+
+func (x *GetCompanyStatementRequest) Reset() {
+	*x = GetCompanyStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompanyStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyStatementRequest) ProtoMessage() {}
+
+func (x *GetCompanyStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyStatementRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetCompanyStatementRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *GetCompanyStatementRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetCompanyStatementRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+type CompanyStatement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Company      *Company       `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	Year         int32          `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Month        int32          `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	Reservations []*Reservation `protobuf:"bytes,4,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	// Deprecated: use total.
+	//
+	// Deprecated: Do not use.
+	TotalValue int32 `protobuf:"varint,5,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	// Deprecated: use discount.
+	//
+	// Deprecated: Do not use.
+	AppliedDiscount int32 `protobuf:"varint,6,opt,name=applied_discount,json=appliedDiscount,proto3" json:"applied_discount,omitempty"`
+	// Totals are in EUR, reservations in other currencies are converted with their exchange rates.
+	Total    *Money `protobuf:"bytes,7,opt,name=total,proto3" json:"total,omitempty"`
+	Discount *Money `protobuf:"bytes,8,opt,name=discount,proto3" json:"discount,omitempty"`
+}
+
+func (x *CompanyStatement) Reset() {
+	*x = CompanyStatement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompanyStatement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyStatement) ProtoMessage() {}
+
+func (x *CompanyStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanyStatement.ProtoReflect.Descriptor instead.
+func (*CompanyStatement) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CompanyStatement) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+func (x *CompanyStatement) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CompanyStatement) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *CompanyStatement) GetReservations() []*Reservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *CompanyStatement) GetTotalValue() int32 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *CompanyStatement) GetAppliedDiscount() int32 {
+	if x != nil {
+		return x.AppliedDiscount
+	}
+	return 0
+}
+
+func (x *CompanyStatement) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *CompanyStatement) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+type BlocklistEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind BlocklistEntryKind `protobuf:"varint,2,opt,name=kind,proto3,enum=nglogic.bikerental.v1.BlocklistEntryKind" json:"kind,omitempty"`
+	// Customer id or email domain, depending on kind.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Internal note, not shown to the customer.
+	Reason    string               `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *BlocklistEntry) Reset() {
+	*x = BlocklistEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlocklistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlocklistEntry) ProtoMessage() {}
+
+func (x *BlocklistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlocklistEntry.ProtoReflect.Descriptor instead.
+func (*BlocklistEntry) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *BlocklistEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BlocklistEntry) GetKind() BlocklistEntryKind {
+	if x != nil {
+		return x.Kind
+	}
+	return BlocklistEntryKind_BLOCKLIST_ENTRY_KIND_UNKNOWN
+}
+
+func (x *BlocklistEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *BlocklistEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlocklistEntry) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListBlocklistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*BlocklistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListBlocklistResponse) Reset() {
+	*x = ListBlocklistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlocklistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlocklistResponse) ProtoMessage() {}
+
+func (x *ListBlocklistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlocklistResponse.ProtoReflect.Descriptor instead.
+func (*ListBlocklistResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListBlocklistResponse) GetEntries() []*BlocklistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type AddToBlocklistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *BlocklistEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *AddToBlocklistRequest) Reset() {
+	*x = AddToBlocklistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddToBlocklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToBlocklistRequest) ProtoMessage() {}
+
+func (x *AddToBlocklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToBlocklistRequest.ProtoReflect.Descriptor instead.
+func (*AddToBlocklistRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AddToBlocklistRequest) GetEntry() *BlocklistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type RemoveFromBlocklistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveFromBlocklistRequest) Reset() {
+	*x = RemoveFromBlocklistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFromBlocklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromBlocklistRequest) ProtoMessage() {}
+
+func (x *RemoveFromBlocklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromBlocklistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromBlocklistRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RemoveFromBlocklistRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WebhookSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Absolute http or https url.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Event types sent to the subscriber, e.g. "reservation.created". Empty list means all events.
+	EventTypes []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// Secret used for signing payloads, at least 16 characters.
+	// It's returned only when subscription is created.
+	Secret    string               `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *WebhookSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *ListWebhookSubscriptionsResponse) Reset() {
+	*x = ListWebhookSubscriptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListWebhookSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *WebhookSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WebhookAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttemptedAt *timestamp.Timestamp `protobuf:"bytes,1,opt,name=attempted_at,json=attemptedAt,proto3" json:"attempted_at,omitempty"`
+	// Http status of subscriber response, 0 if there was no response.
+	StatusCode int32  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error      string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs int64  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+}
+
+func (x *WebhookAttempt) Reset() {
+	*x = WebhookAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookAttempt) ProtoMessage() {}
+
+func (x *WebhookAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookAttempt.ProtoReflect.Descriptor instead.
+func (*WebhookAttempt) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *WebhookAttempt) GetAttemptedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.AttemptedAt
+	}
+	return nil
+}
+
+func (x *WebhookAttempt) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookAttempt) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	EventId        int64                 `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	AggregateId    string                `protobuf:"bytes,5,opt,name=aggregate_id,json=aggregateId,proto3" json:"aggregate_id,omitempty"`
+	Status         WebhookDeliveryStatus `protobuf:"varint,6,opt,name=status,proto3,enum=nglogic.bikerental.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	// Number of failed attempts since the delivery was created or redelivered.
+	Attempts      int32                `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastError     string               `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt     *timestamp.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt   *timestamp.Timestamp `protobuf:"bytes,11,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	// All delivery attempts, from the oldest.
+	Log []*WebhookAttempt `protobuf:"bytes,12,rep,name=log,proto3" json:"log,omitempty"`
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAggregateId() string {
+	if x != nil {
+		return x.AggregateId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNKNOWN
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetLog() []*WebhookAttempt {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// Optional status filter.
+	Status WebhookDeliveryStatus `protobuf:"varint,2,opt,name=status,proto3,enum=nglogic.bikerental.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	// Default limit is 50.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNKNOWN
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Id             string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RedeliverWebhookRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *RedeliverWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type APIKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role     Role   `protobuf:"varint,7,opt,name=role,proto3,enum=nglogic.bikerental.v1.Role" json:"role,omitempty"`
+	// Beginning of the key, to help recognizing it.
+	Prefix    string               `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Empty for active keys.
+	RevokedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *APIKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIKey) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNKNOWN
+}
+
+func (x *APIKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *APIKey) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetRevokedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*APIKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListAPIKeysResponse) GetKeys() []*APIKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type IssueAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Describes what the key is used for.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Admin or staff. Customers authenticate with JWTs.
+	Role Role `protobuf:"varint,2,opt,name=role,proto3,enum=nglogic.bikerental.v1.Role" json:"role,omitempty"`
+}
+
+func (x *IssueAPIKeyRequest) Reset() {
+	*x = IssueAPIKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueAPIKeyRequest) ProtoMessage() {}
+
+func (x *IssueAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*IssueAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *IssueAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IssueAPIKeyRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNKNOWN
+}
+
+type IssueAPIKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key *APIKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The key, to be sent in "X-API-Key" header or as a bearer token.
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *IssueAPIKeyResponse) Reset() {
+	*x = IssueAPIKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueAPIKeyResponse) ProtoMessage() {}
+
+func (x *IssueAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*IssueAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *IssueAPIKeyResponse) GetKey() *APIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *IssueAPIKeyResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RevokeAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_nglogic_bikerental_v1_service_proto protoreflect.FileDescriptor
+
+var file_nglogic_bikerental_v1_service_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x61, 0x6e,
+	0x6f, 0x73, 0x22, 0x4b, 0x0a, 0x04, 0x42, 0x69, 0x6b, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x9f, 0x02, 0x0a, 0x08, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x48, 0x6f,
+	0x75, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x75,
+	0x74, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x6f, 0x75, 0x74, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x22, 0x53, 0x0a, 0x08, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xcc, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x15,
+	0x0a, 0x06, 0x76, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x74, 0x49, 0x64, 0x22, 0x97, 0x08, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x62, 0x69, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x52,
+	0x04, 0x62, 0x69, 0x6b, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x0f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x64, 0x65, 0x65,
+	0x6d, 0x65, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x4a, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6e,
+	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2e, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x66,
+	0x65, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65,
+	0x64, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x36, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x48, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x66, 0x65,
+	0x69, 0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x10, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x12, 0x37, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x61, 0x78, 0x52, 0x03, 0x74, 0x61, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x22,
+	0x30, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6c,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x6c, 0x6f, 0x6e,
+	0x67, 0x22, 0x46, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69,
+	0x6b, 0x65, 0x52, 0x05, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x58, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xa7, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b, 0x65,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3b,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xa2, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x3b,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x32, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x22, 0x84, 0x03, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0e, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65,
+	0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64,
+	0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe3, 0x02, 0x0a, 0x18,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49,
+	0x64, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x3b,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0xbb, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0xa4, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62,
+	0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69,
+	0x6b, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x62, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52, 0x0a, 0x18, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xd8,
+	0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b,
+	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x56, 0x0a, 0x1c, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x9d, 0x02, 0x0a, 0x16, 0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x43, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x1a, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x22, 0x43, 0x0a,
+	0x18, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65,
+	0x49, 0x64, 0x22, 0x90, 0x03, 0x0a, 0x0c, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69,
+	0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b,
+	0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08,
+	0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x43, 0x6f, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x11, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xf5, 0x02, 0x0a, 0x17, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x41, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65,
+	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x34, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x73, 0x74,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x68, 0x6f,
+	0x74, 0x6f, 0x52, 0x06, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x0d, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x6e,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x22, 0xab,
+	0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e,
+	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x22, 0x80, 0x01, 0x0a,
+	0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x39, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xe1, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x61, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x41, 0x0a, 0x09,
+	0x74, 0x72, 0x65, 0x61, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x78, 0x54, 0x72, 0x65, 0x61, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x74, 0x72, 0x65, 0x61, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6e, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03,
+	0x6e, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03,
+	0x74, 0x61, 0x78, 0x22, 0x47, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc1, 0x04, 0x0a,
+	0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x3b, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x41, 0x0a, 0x09, 0x74, 0x72, 0x65, 0x61, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x61, 0x78, 0x54, 0x72, 0x65, 0x61, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x09, 0x74, 0x72, 0x65, 0x61, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x05, 0x6c, 0x69,
+	0x6e, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x74, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x08,
+	0x74, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x6e, 0x65, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x03, 0x6e, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x03, 0x74, 0x61, 0x78, 0x12, 0x32, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x73,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x22, 0x98, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x74, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6e, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x03, 0x6e, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x03, 0x74, 0x61, 0x78, 0x12, 0x32, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x73, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0e,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03, 0x6e,
+	0x65, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03, 0x74,
+	0x61, 0x78, 0x22, 0xe2, 0x04, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65,
+	0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x23, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x69,
+	0x6b, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e,
+	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x5b, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22,
+	0xa8, 0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79,
+	0x54, 0x69, 0x65, 0x72, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x22, 0xf6, 0x01, 0x0a, 0x0c, 0x4c,
+	0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x27, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74,
+	0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x3b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74,
+	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x3c, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5b,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x07, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xcb,
+	0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a,
+	0x0e, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x52, 0x0a, 0x16, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x6c, 0x79, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x14, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x55, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x69, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5e, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x59, 0x0a,
+	0x17, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x22, 0x80, 0x03,
+	0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x46, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e,
+	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x2d, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xc8, 0x01, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x29, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x58, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x2c, 0x0a, 0x1a, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x13, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x74, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x72, 0x0a,
+	0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4e, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x32, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa7, 0x01, 0x0a, 0x0e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0x9f, 0x04, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x03, 0x6c, 0x6f,
+	0x67, 0x22, 0xa3, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x67, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e,
+	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x52, 0x0a, 0x17, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x88, 0x02, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x48, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x59, 0x0a, 0x12, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x5e, 0x0a, 0x13, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x66, 0x0a, 0x08, 0x42,
+	0x69, 0x6b, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x49, 0x4b, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4e,
+	0x44, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x52, 0x49, 0x43, 0x10, 0x02, 0x12, 0x13,
+	0x0a, 0x0f, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x52, 0x47,
+	0x4f, 0x10, 0x03, 0x2a, 0x63, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1c,
+	0x0a, 0x18, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x49, 0x4e, 0x44, 0x49, 0x56, 0x49, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16,
+	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x55,
+	0x53, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x10, 0x02, 0x2a, 0xb9, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
+	0x0a, 0x1a, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1f,
+	0x0a, 0x1b, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x10, 0x04, 0x2a, 0xae, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23,
+	0x0a, 0x1f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x53, 0x45,
+	0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x25,
+	0x0a, 0x21, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x7c, 0x0a, 0x0e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x53,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x41, 0x4d, 0x41, 0x47,
+	0x45, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x41, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x53,
+	0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x1a, 0x0a,
+	0x16, 0x44, 0x41, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59,
+	0x5f, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x41, 0x4d,
+	0x41, 0x47, 0x45, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47,
+	0x48, 0x10, 0x03, 0x2a, 0x9c, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a,
+	0x17, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41,
+	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x46,
+	0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x59, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f, 0x49, 0x44, 0x45, 0x44,
+	0x10, 0x04, 0x2a, 0x64, 0x0a, 0x0c, 0x54, 0x61, 0x78, 0x54, 0x72, 0x65, 0x61, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x41, 0x58, 0x5f, 0x54, 0x52, 0x45, 0x41, 0x54, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x41,
+	0x58, 0x5f, 0x54, 0x52, 0x45, 0x41, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x4e,
+	0x44, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x41, 0x58, 0x5f, 0x54, 0x52,
+	0x45, 0x41, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x5f,
+	0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x10, 0x02, 0x2a, 0x7c, 0x0a, 0x0d, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x50,
+	0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x45, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x44,
+	0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45,
+	0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4f,
+	0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x46, 0x45,
+	0x49, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x67, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43,
+	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x4f, 0x4d, 0x4f,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41,
+	0x47, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x02, 0x2a,
+	0x54, 0x0a, 0x0b, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x69, 0x65, 0x72, 0x12, 0x15,
+	0x0a, 0x11, 0x4c, 0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59,
+	0x5f, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x53, 0x49, 0x4c, 0x56, 0x45, 0x52, 0x10, 0x01, 0x12, 0x15,
+	0x0a, 0x11, 0x4c, 0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x47,
+	0x4f, 0x4c, 0x44, 0x10, 0x02, 0x2a, 0xb5, 0x01, 0x0a, 0x10, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74,
+	0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x4f,
+	0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x4f,
+	0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x41, 0x43, 0x43, 0x52, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x4f,
+	0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x52, 0x45, 0x44, 0x45, 0x4d, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a,
+	0x1b, 0x4c, 0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x1d,
+	0x0a, 0x19, 0x4c, 0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x2a, 0x83, 0x01,
+	0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x4c, 0x49, 0x53,
+	0x54, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x4c,
+	0x49, 0x53, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43,
+	0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x44, 0x4f, 0x4d, 0x41, 0x49,
+	0x4e, 0x10, 0x02, 0x2a, 0xaa, 0x01, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a,
+	0x1f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52,
+	0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45,
+	0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x45, 0x42, 0x48, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x20,
+	0x0a, 0x1c, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45,
+	0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x10, 0x03,
+	0x2a, 0x4b, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x4f,
+	0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x4f,
+	0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x46, 0x46, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x4f,
+	0x4c, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x10, 0x03, 0x32, 0xd2, 0x2f,
+	0x0a, 0x11, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x67, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b, 0x65,
+	0x12, 0x25, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x6b, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x12, 0x6c,
+	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x12, 0x28, 0x2e, 0x6e,
+	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x69, 0x6b, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x09, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x3a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x68, 0x0a, 0x0a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x12, 0x28, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x18, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x12, 0x6e, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x69, 0x6b, 0x65, 0x12, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x1a, 0x10,
+	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d,
+	0x3a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0xa8, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x69,
+	0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x31,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b,
+	0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69,
+	0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x9f, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12,
+	0x22, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65,
+	0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69,
+	0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x12,
+	0xa5, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b,
+	0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e,
+	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x30,
+	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f,
+	0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x12, 0x9c, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x22, 0x32, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d,
+	0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x96, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x38,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x30, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65,
+	0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a,
+	0x7d, 0x3a, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0xa7, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x12, 0x33, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12,
+	0x31, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65,
+	0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x12, 0x90, 0x01, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0xa2, 0x01, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42,
+	0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x33, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x69, 0x6b,
+	0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b,
+	0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x30, 0x01, 0x12, 0xb7, 0x01, 0x0a, 0x10, 0x46,
+	0x69, 0x6c, 0x65, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x2e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x48, 0x3a, 0x01, 0x2a, 0x22,
+	0x43, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65,
+	0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0xa3, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2f, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73,
+	0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x64, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0xc3, 0x01, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x68,
+	0x6f, 0x74, 0x6f, 0x12, 0x32, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x12, 0x45, 0x2f, 0x76, 0x31, 0x2f, 0x62,
+	0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d,
+	0x2f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x73, 0x2f, 0x7b, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d,
+	0x12, 0x6f, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2d, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x63, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x86, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x0e,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x3a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x79, 0x61,
+	0x6c, 0x74, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74,
+	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x12, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f,
+	0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x12, 0xb0, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x30,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x79,
+	0x61, 0x6c, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x6c, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x6c, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x12, 0x74, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x12, 0x79,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12,
+	0x2b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e,
+	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x22, 0x1b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x15, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x69, 0x65, 0x73, 0x3a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x78, 0x0a, 0x0d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x2b, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x1a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0xa7, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x30, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6e,
+	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f,
+	0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0xa1, 0x01,
+	0x0a, 0x10, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x2e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x1a, 0x34, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x3d, 0x2a,
+	0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x2a, 0x34, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x3d,
+	0x2a, 0x7d, 0x12, 0xb5, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x79, 0x65, 0x61, 0x72, 0x3d, 0x2a, 0x7d,
+	0x2f, 0x7b, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x3d, 0x2a, 0x7d, 0x12, 0x6c, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64,
+	0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x7e,
+	0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x12, 0x81,
+	0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x37, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x37, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x0c, 0x2f,
+	0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x3a, 0x0c, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x89, 0x01, 0x0a, 0x19, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x2a, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x12, 0xb7, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x33, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0xb0, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x2e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x22, 0x44, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x3e, 0x22, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x12, 0x66, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2a, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x7c, 0x0a, 0x0b, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70,
+	0x69, 0x6b, 0x65, 0x79, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x75, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x6b, 0x65, 0x79,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12,
+	0x90, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
+	0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x68, 0x6f, 0x75,
+	0x72, 0x73, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x67, 0x75, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x76, 0x31, 0x3b, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_nglogic_bikerental_v1_service_proto_rawDescOnce sync.Once
+	file_nglogic_bikerental_v1_service_proto_rawDescData = file_nglogic_bikerental_v1_service_proto_rawDesc
+)
+
+func file_nglogic_bikerental_v1_service_proto_rawDescGZIP() []byte {
+	file_nglogic_bikerental_v1_service_proto_rawDescOnce.Do(func() {
+		file_nglogic_bikerental_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_nglogic_bikerental_v1_service_proto_rawDescData)
+	})
+	return file_nglogic_bikerental_v1_service_proto_rawDescData
+}
+
+var file_nglogic_bikerental_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_nglogic_bikerental_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
+var file_nglogic_bikerental_v1_service_proto_goTypes = []interface{}{
+	(BikeType)(0),                            // 0: nglogic.bikerental.v1.BikeType
+	(CustomerType)(0),                        // 1: nglogic.bikerental.v1.CustomerType
+	(ReservationStatus)(0),                   // 2: nglogic.bikerental.v1.ReservationStatus
+	(ReservationChangeType)(0),               // 3: nglogic.bikerental.v1.ReservationChangeType
+	(DamageSeverity)(0),                      // 4: nglogic.bikerental.v1.DamageSeverity
+	(PaymentStatus)(0),                       // 5: nglogic.bikerental.v1.PaymentStatus
+	(TaxTreatment)(0),                        // 6: nglogic.bikerental.v1.TaxTreatment
+	(DepositStatus)(0),                       // 7: nglogic.bikerental.v1.DepositStatus
+	(PromoCodeType)(0),                       // 8: nglogic.bikerental.v1.PromoCodeType
+	(LoyaltyTier)(0),                         // 9: nglogic.bikerental.v1.LoyaltyTier
+	(LoyaltyEntryKind)(0),                    // 10: nglogic.bikerental.v1.LoyaltyEntryKind
+	(BlocklistEntryKind)(0),                  // 11: nglogic.bikerental.v1.BlocklistEntryKind
+	(WebhookDeliveryStatus)(0),               // 12: nglogic.bikerental.v1.WebhookDeliveryStatus
+	(Role)(0),                                // 13: nglogic.bikerental.v1.Role
+	(*Money)(nil),                            // 14: nglogic.bikerental.v1.Money
+	(*Bike)(nil),                             // 15: nglogic.bikerental.v1.Bike
+	(*BikeData)(nil),                         // 16: nglogic.bikerental.v1.BikeData
+	(*Customer)(nil),                         // 17: nglogic.bikerental.v1.Customer
+	(*CustomerData)(nil),                     // 18: nglogic.bikerental.v1.CustomerData
+	(*Reservation)(nil),                      // 19: nglogic.bikerental.v1.Reservation
+	(*Location)(nil),                         // 20: nglogic.bikerental.v1.Location
+	(*ListBikesResponse)(nil),                // 21: nglogic.bikerental.v1.ListBikesResponse
+	(*GetBikeRequest)(nil),                   // 22: nglogic.bikerental.v1.GetBikeRequest
+	(*CreateBikeRequest)(nil),                // 23: nglogic.bikerental.v1.CreateBikeRequest
+	(*UpdateBikeRequest)(nil),                // 24: nglogic.bikerental.v1.UpdateBikeRequest
+	(*DeleteBikeRequest)(nil),                // 25: nglogic.bikerental.v1.DeleteBikeRequest
+	(*GetBikeAvailabilityRequest)(nil),       // 26: nglogic.bikerental.v1.GetBikeAvailabilityRequest
+	(*GetBikeAvailabilityResponse)(nil),      // 27: nglogic.bikerental.v1.GetBikeAvailabilityResponse
+	(*GetPriceQuoteRequest)(nil),             // 28: nglogic.bikerental.v1.GetPriceQuoteRequest
+	(*PriceQuote)(nil),                       // 29: nglogic.bikerental.v1.PriceQuote
+	(*PriceQuoteItem)(nil),                   // 30: nglogic.bikerental.v1.PriceQuoteItem
+	(*CreateReservationRequest)(nil),         // 31: nglogic.bikerental.v1.CreateReservationRequest
+	(*CreateReservationResponse)(nil),        // 32: nglogic.bikerental.v1.CreateReservationResponse
+	(*ListReservationsRequest)(nil),          // 33: nglogic.bikerental.v1.ListReservationsRequest
+	(*ListReservationsResponse)(nil),         // 34: nglogic.bikerental.v1.ListReservationsResponse
+	(*WatchReservationsRequest)(nil),         // 35: nglogic.bikerental.v1.WatchReservationsRequest
+	(*ReservationChange)(nil),                // 36: nglogic.bikerental.v1.ReservationChange
+	(*WatchBikeAvailabilityRequest)(nil),     // 37: nglogic.bikerental.v1.WatchBikeAvailabilityRequest
+	(*BikeAvailabilityChange)(nil),           // 38: nglogic.bikerental.v1.BikeAvailabilityChange
+	(*CancelReservationRequest)(nil),         // 39: nglogic.bikerental.v1.CancelReservationRequest
+	(*CompleteReservationRequest)(nil),       // 40: nglogic.bikerental.v1.CompleteReservationRequest
+	(*RefundReservationRequest)(nil),         // 41: nglogic.bikerental.v1.RefundReservationRequest
+	(*DamageReport)(nil),                     // 42: nglogic.bikerental.v1.DamageReport
+	(*DamageReportPhoto)(nil),                // 43: nglogic.bikerental.v1.DamageReportPhoto
+	(*FileDamageReportRequest)(nil),          // 44: nglogic.bikerental.v1.FileDamageReportRequest
+	(*ListDamageReportsRequest)(nil),         // 45: nglogic.bikerental.v1.ListDamageReportsRequest
+	(*ListDamageReportsResponse)(nil),        // 46: nglogic.bikerental.v1.ListDamageReportsResponse
+	(*GetDamageReportPhotoRequest)(nil),      // 47: nglogic.bikerental.v1.GetDamageReportPhotoRequest
+	(*ListOpenIntervalsRequest)(nil),         // 48: nglogic.bikerental.v1.ListOpenIntervalsRequest
+	(*ListOpenIntervalsResponse)(nil),        // 49: nglogic.bikerental.v1.ListOpenIntervalsResponse
+	(*OpenInterval)(nil),                     // 50: nglogic.bikerental.v1.OpenInterval
+	(*ReservationTax)(nil),                   // 51: nglogic.bikerental.v1.ReservationTax
+	(*GetReservationInvoiceRequest)(nil),     // 52: nglogic.bikerental.v1.GetReservationInvoiceRequest
+	(*Invoice)(nil),                          // 53: nglogic.bikerental.v1.Invoice
+	(*InvoiceLine)(nil),                      // 54: nglogic.bikerental.v1.InvoiceLine
+	(*InvoiceTaxLine)(nil),                   // 55: nglogic.bikerental.v1.InvoiceTaxLine
+	(*PromoCode)(nil),                        // 56: nglogic.bikerental.v1.PromoCode
+	(*CreatePromoCodeRequest)(nil),           // 57: nglogic.bikerental.v1.CreatePromoCodeRequest
+	(*ListPromoCodesResponse)(nil),           // 58: nglogic.bikerental.v1.ListPromoCodesResponse
+	(*LoyaltyAccount)(nil),                   // 59: nglogic.bikerental.v1.LoyaltyAccount
+	(*LoyaltyEntry)(nil),                     // 60: nglogic.bikerental.v1.LoyaltyEntry
+	(*GetLoyaltyAccountRequest)(nil),         // 61: nglogic.bikerental.v1.GetLoyaltyAccountRequest
+	(*ListLoyaltyEntriesRequest)(nil),        // 62: nglogic.bikerental.v1.ListLoyaltyEntriesRequest
+	(*ListLoyaltyEntriesResponse)(nil),       // 63: nglogic.bikerental.v1.ListLoyaltyEntriesResponse
+	(*Company)(nil),                          // 64: nglogic.bikerental.v1.Company
+	(*CompanyData)(nil),                      // 65: nglogic.bikerental.v1.CompanyData
+	(*ListCompaniesResponse)(nil),            // 66: nglogic.bikerental.v1.ListCompaniesResponse
+	(*GetCompanyRequest)(nil),                // 67: nglogic.bikerental.v1.GetCompanyRequest
+	(*CreateCompanyRequest)(nil),             // 68: nglogic.bikerental.v1.CreateCompanyRequest
+	(*UpdateCompanyRequest)(nil),             // 69: nglogic.bikerental.v1.UpdateCompanyRequest
+	(*ListCompanyMembersRequest)(nil),        // 70: nglogic.bikerental.v1.ListCompanyMembersRequest
+	(*ListCompanyMembersResponse)(nil),       // 71: nglogic.bikerental.v1.ListCompanyMembersResponse
+	(*AddCompanyMemberRequest)(nil),          // 72: nglogic.bikerental.v1.AddCompanyMemberRequest
+	(*RemoveCompanyMemberRequest)(nil),       // 73: nglogic.bikerental.v1.RemoveCompanyMemberRequest
+	(*GetCompanyStatementRequest)(nil),       // 74: nglogic.bikerental.v1.GetCompanyStatementRequest
+	(*CompanyStatement)(nil),                 // 75: nglogic.bikerental.v1.CompanyStatement
+	(*BlocklistEntry)(nil),                   // 76: nglogic.bikerental.v1.BlocklistEntry
+	(*ListBlocklistResponse)(nil),            // 77: nglogic.bikerental.v1.ListBlocklistResponse
+	(*AddToBlocklistRequest)(nil),            // 78: nglogic.bikerental.v1.AddToBlocklistRequest
+	(*RemoveFromBlocklistRequest)(nil),       // 79: nglogic.bikerental.v1.RemoveFromBlocklistRequest
+	(*WebhookSubscription)(nil),              // 80: nglogic.bikerental.v1.WebhookSubscription
+	(*ListWebhookSubscriptionsResponse)(nil), // 81: nglogic.bikerental.v1.ListWebhookSubscriptionsResponse
+	(*CreateWebhookSubscriptionRequest)(nil), // 82: nglogic.bikerental.v1.CreateWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionRequest)(nil), // 83: nglogic.bikerental.v1.DeleteWebhookSubscriptionRequest
+	(*WebhookAttempt)(nil),                   // 84: nglogic.bikerental.v1.WebhookAttempt
+	(*WebhookDelivery)(nil),                  // 85: nglogic.bikerental.v1.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),     // 86: nglogic.bikerental.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),    // 87: nglogic.bikerental.v1.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),          // 88: nglogic.bikerental.v1.RedeliverWebhookRequest
+	(*APIKey)(nil),                           // 89: nglogic.bikerental.v1.APIKey
+	(*ListAPIKeysResponse)(nil),              // 90: nglogic.bikerental.v1.ListAPIKeysResponse
+	(*IssueAPIKeyRequest)(nil),               // 91: nglogic.bikerental.v1.IssueAPIKeyRequest
+	(*IssueAPIKeyResponse)(nil),              // 92: nglogic.bikerental.v1.IssueAPIKeyResponse
+	(*RevokeAPIKeyRequest)(nil),              // 93: nglogic.bikerental.v1.RevokeAPIKeyRequest
+	(*timestamp.Timestamp)(nil),              // 94: google.protobuf.Timestamp
+	(*empty.Empty)(nil),                      // 95: google.protobuf.Empty
+}
+var file_nglogic_bikerental_v1_service_proto_depIdxs = []int32{
+	16,  // 0: nglogic.bikerental.v1.Bike.data:type_name -> nglogic.bikerental.v1.BikeData
+	0,   // 1: nglogic.bikerental.v1.BikeData.type:type_name -> nglogic.bikerental.v1.BikeType
+	14,  // 2: nglogic.bikerental.v1.BikeData.hourlyPrice:type_name -> nglogic.bikerental.v1.Money
+	18,  // 3: nglogic.bikerental.v1.Customer.data:type_name -> nglogic.bikerental.v1.CustomerData
+	1,   // 4: nglogic.bikerental.v1.CustomerData.type:type_name -> nglogic.bikerental.v1.CustomerType
+	2,   // 5: nglogic.bikerental.v1.Reservation.status:type_name -> nglogic.bikerental.v1.ReservationStatus
+	17,  // 6: nglogic.bikerental.v1.Reservation.customer:type_name -> nglogic.bikerental.v1.Customer
+	15,  // 7: nglogic.bikerental.v1.Reservation.bike:type_name -> nglogic.bikerental.v1.Bike
+	94,  // 8: nglogic.bikerental.v1.Reservation.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 9: nglogic.bikerental.v1.Reservation.end_time:type_name -> google.protobuf.Timestamp
+	5,   // 10: nglogic.bikerental.v1.Reservation.paymentStatus:type_name -> nglogic.bikerental.v1.PaymentStatus
+	7,   // 11: nglogic.bikerental.v1.Reservation.depositStatus:type_name -> nglogic.bikerental.v1.DepositStatus
+	14,  // 12: nglogic.bikerental.v1.Reservation.total:type_name -> nglogic.bikerental.v1.Money
+	14,  // 13: nglogic.bikerental.v1.Reservation.discount:type_name -> nglogic.bikerental.v1.Money
+	14,  // 14: nglogic.bikerental.v1.Reservation.deposit:type_name -> nglogic.bikerental.v1.Money
+	14,  // 15: nglogic.bikerental.v1.Reservation.forfeitedDeposit:type_name -> nglogic.bikerental.v1.Money
+	51,  // 16: nglogic.bikerental.v1.Reservation.tax:type_name -> nglogic.bikerental.v1.ReservationTax
+	15,  // 17: nglogic.bikerental.v1.ListBikesResponse.bikes:type_name -> nglogic.bikerental.v1.Bike
+	16,  // 18: nglogic.bikerental.v1.CreateBikeRequest.data:type_name -> nglogic.bikerental.v1.BikeData
+	16,  // 19: nglogic.bikerental.v1.UpdateBikeRequest.data:type_name -> nglogic.bikerental.v1.BikeData
+	94,  // 20: nglogic.bikerental.v1.GetBikeAvailabilityRequest.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 21: nglogic.bikerental.v1.GetBikeAvailabilityRequest.end_time:type_name -> google.protobuf.Timestamp
+	94,  // 22: nglogic.bikerental.v1.GetPriceQuoteRequest.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 23: nglogic.bikerental.v1.GetPriceQuoteRequest.end_time:type_name -> google.protobuf.Timestamp
+	30,  // 24: nglogic.bikerental.v1.PriceQuote.items:type_name -> nglogic.bikerental.v1.PriceQuoteItem
+	14,  // 25: nglogic.bikerental.v1.PriceQuote.total:type_name -> nglogic.bikerental.v1.Money
+	94,  // 26: nglogic.bikerental.v1.PriceQuoteItem.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 27: nglogic.bikerental.v1.PriceQuoteItem.end_time:type_name -> google.protobuf.Timestamp
+	14,  // 28: nglogic.bikerental.v1.PriceQuoteItem.hourly_price:type_name -> nglogic.bikerental.v1.Money
+	14,  // 29: nglogic.bikerental.v1.PriceQuoteItem.amount:type_name -> nglogic.bikerental.v1.Money
+	17,  // 30: nglogic.bikerental.v1.CreateReservationRequest.customer:type_name -> nglogic.bikerental.v1.Customer
+	20,  // 31: nglogic.bikerental.v1.CreateReservationRequest.location:type_name -> nglogic.bikerental.v1.Location
+	94,  // 32: nglogic.bikerental.v1.CreateReservationRequest.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 33: nglogic.bikerental.v1.CreateReservationRequest.end_time:type_name -> google.protobuf.Timestamp
+	19,  // 34: nglogic.bikerental.v1.CreateReservationResponse.reservation:type_name -> nglogic.bikerental.v1.Reservation
+	2,   // 35: nglogic.bikerental.v1.CreateReservationResponse.status:type_name -> nglogic.bikerental.v1.ReservationStatus
+	94,  // 36: nglogic.bikerental.v1.ListReservationsRequest.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 37: nglogic.bikerental.v1.ListReservationsRequest.end_time:type_name -> google.protobuf.Timestamp
+	19,  // 38: nglogic.bikerental.v1.ListReservationsResponse.reservations:type_name -> nglogic.bikerental.v1.Reservation
+	3,   // 39: nglogic.bikerental.v1.ReservationChange.type:type_name -> nglogic.bikerental.v1.ReservationChangeType
+	19,  // 40: nglogic.bikerental.v1.ReservationChange.reservation:type_name -> nglogic.bikerental.v1.Reservation
+	94,  // 41: nglogic.bikerental.v1.ReservationChange.occurred_at:type_name -> google.protobuf.Timestamp
+	94,  // 42: nglogic.bikerental.v1.BikeAvailabilityChange.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 43: nglogic.bikerental.v1.BikeAvailabilityChange.end_time:type_name -> google.protobuf.Timestamp
+	94,  // 44: nglogic.bikerental.v1.BikeAvailabilityChange.occurred_at:type_name -> google.protobuf.Timestamp
+	4,   // 45: nglogic.bikerental.v1.DamageReport.severity:type_name -> nglogic.bikerental.v1.DamageSeverity
+	94,  // 46: nglogic.bikerental.v1.DamageReport.created_at:type_name -> google.protobuf.Timestamp
+	14,  // 47: nglogic.bikerental.v1.DamageReport.repair_cost:type_name -> nglogic.bikerental.v1.Money
+	4,   // 48: nglogic.bikerental.v1.FileDamageReportRequest.severity:type_name -> nglogic.bikerental.v1.DamageSeverity
+	43,  // 49: nglogic.bikerental.v1.FileDamageReportRequest.photos:type_name -> nglogic.bikerental.v1.DamageReportPhoto
+	14,  // 50: nglogic.bikerental.v1.FileDamageReportRequest.repair_cost:type_name -> nglogic.bikerental.v1.Money
+	42,  // 51: nglogic.bikerental.v1.ListDamageReportsResponse.damage_reports:type_name -> nglogic.bikerental.v1.DamageReport
+	94,  // 52: nglogic.bikerental.v1.ListOpenIntervalsRequest.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 53: nglogic.bikerental.v1.ListOpenIntervalsRequest.end_time:type_name -> google.protobuf.Timestamp
+	50,  // 54: nglogic.bikerental.v1.ListOpenIntervalsResponse.intervals:type_name -> nglogic.bikerental.v1.OpenInterval
+	94,  // 55: nglogic.bikerental.v1.OpenInterval.start_time:type_name -> google.protobuf.Timestamp
+	94,  // 56: nglogic.bikerental.v1.OpenInterval.end_time:type_name -> google.protobuf.Timestamp
+	6,   // 57: nglogic.bikerental.v1.ReservationTax.treatment:type_name -> nglogic.bikerental.v1.TaxTreatment
+	14,  // 58: nglogic.bikerental.v1.ReservationTax.net:type_name -> nglogic.bikerental.v1.Money
+	14,  // 59: nglogic.bikerental.v1.ReservationTax.tax:type_name -> nglogic.bikerental.v1.Money
+	94,  // 60: nglogic.bikerental.v1.Invoice.issued_at:type_name -> google.protobuf.Timestamp
+	17,  // 61: nglogic.bikerental.v1.Invoice.customer:type_name -> nglogic.bikerental.v1.Customer
+	6,   // 62: nglogic.bikerental.v1.Invoice.treatment:type_name -> nglogic.bikerental.v1.TaxTreatment
+	54,  // 63: nglogic.bikerental.v1.Invoice.lines:type_name -> nglogic.bikerental.v1.InvoiceLine
+	55,  // 64: nglogic.bikerental.v1.Invoice.tax_lines:type_name -> nglogic.bikerental.v1.InvoiceTaxLine
+	14,  // 65: nglogic.bikerental.v1.Invoice.net:type_name -> nglogic.bikerental.v1.Money
+	14,  // 66: nglogic.bikerental.v1.Invoice.tax:type_name -> nglogic.bikerental.v1.Money
+	14,  // 67: nglogic.bikerental.v1.Invoice.gross:type_name -> nglogic.bikerental.v1.Money
+	14,  // 68: nglogic.bikerental.v1.InvoiceLine.discount:type_name -> nglogic.bikerental.v1.Money
+	14,  // 69: nglogic.bikerental.v1.InvoiceLine.net:type_name -> nglogic.bikerental.v1.Money
+	14,  // 70: nglogic.bikerental.v1.InvoiceLine.tax:type_name -> nglogic.bikerental.v1.Money
+	14,  // 71: nglogic.bikerental.v1.InvoiceLine.gross:type_name -> nglogic.bikerental.v1.Money
+	14,  // 72: nglogic.bikerental.v1.InvoiceTaxLine.net:type_name -> nglogic.bikerental.v1.Money
+	14,  // 73: nglogic.bikerental.v1.InvoiceTaxLine.tax:type_name -> nglogic.bikerental.v1.Money
+	8,   // 74: nglogic.bikerental.v1.PromoCode.type:type_name -> nglogic.bikerental.v1.PromoCodeType
+	94,  // 75: nglogic.bikerental.v1.PromoCode.expires_at:type_name -> google.protobuf.Timestamp
+	1,   // 76: nglogic.bikerental.v1.PromoCode.customer_type:type_name -> nglogic.bikerental.v1.CustomerType
+	94,  // 77: nglogic.bikerental.v1.PromoCode.created_at:type_name -> google.protobuf.Timestamp
+	14,  // 78: nglogic.bikerental.v1.PromoCode.fixed_amount:type_name -> nglogic.bikerental.v1.Money
+	56,  // 79: nglogic.bikerental.v1.CreatePromoCodeRequest.promo_code:type_name -> nglogic.bikerental.v1.PromoCode
+	56,  // 80: nglogic.bikerental.v1.ListPromoCodesResponse.promo_codes:type_name -> nglogic.bikerental.v1.PromoCode
+	9,   // 81: nglogic.bikerental.v1.LoyaltyAccount.tier:type_name -> nglogic.bikerental.v1.LoyaltyTier
+	10,  // 82: nglogic.bikerental.v1.LoyaltyEntry.kind:type_name -> nglogic.bikerental.v1.LoyaltyEntryKind
+	94,  // 83: nglogic.bikerental.v1.LoyaltyEntry.created_at:type_name -> google.protobuf.Timestamp
+	60,  // 84: nglogic.bikerental.v1.ListLoyaltyEntriesResponse.entries:type_name -> nglogic.bikerental.v1.LoyaltyEntry
+	65,  // 85: nglogic.bikerental.v1.Company.data:type_name -> nglogic.bikerental.v1.CompanyData
+	14,  // 86: nglogic.bikerental.v1.CompanyData.monthly_spending_limit:type_name -> nglogic.bikerental.v1.Money
+	64,  // 87: nglogic.bikerental.v1.ListCompaniesResponse.companies:type_name -> nglogic.bikerental.v1.Company
+	65,  // 88: nglogic.bikerental.v1.CreateCompanyRequest.data:type_name -> nglogic.bikerental.v1.CompanyData
+	65,  // 89: nglogic.bikerental.v1.UpdateCompanyRequest.data:type_name -> nglogic.bikerental.v1.CompanyData
+	17,  // 90: nglogic.bikerental.v1.ListCompanyMembersResponse.members:type_name -> nglogic.bikerental.v1.Customer
+	64,  // 91: nglogic.bikerental.v1.CompanyStatement.company:type_name -> nglogic.bikerental.v1.Company
+	19,  // 92: nglogic.bikerental.v1.CompanyStatement.reservations:type_name -> nglogic.bikerental.v1.Reservation
+	14,  // 93: nglogic.bikerental.v1.CompanyStatement.total:type_name -> nglogic.bikerental.v1.Money
+	14,  // 94: nglogic.bikerental.v1.CompanyStatement.discount:type_name -> nglogic.bikerental.v1.Money
+	11,  // 95: nglogic.bikerental.v1.BlocklistEntry.kind:type_name -> nglogic.bikerental.v1.BlocklistEntryKind
+	94,  // 96: nglogic.bikerental.v1.BlocklistEntry.created_at:type_name -> google.protobuf.Timestamp
+	76,  // 97: nglogic.bikerental.v1.ListBlocklistResponse.entries:type_name -> nglogic.bikerental.v1.BlocklistEntry
+	76,  // 98: nglogic.bikerental.v1.AddToBlocklistRequest.entry:type_name -> nglogic.bikerental.v1.BlocklistEntry
+	94,  // 99: nglogic.bikerental.v1.WebhookSubscription.created_at:type_name -> google.protobuf.Timestamp
+	80,  // 100: nglogic.bikerental.v1.ListWebhookSubscriptionsResponse.subscriptions:type_name -> nglogic.bikerental.v1.WebhookSubscription
+	80,  // 101: nglogic.bikerental.v1.CreateWebhookSubscriptionRequest.subscription:type_name -> nglogic.bikerental.v1.WebhookSubscription
+	94,  // 102: nglogic.bikerental.v1.WebhookAttempt.attempted_at:type_name -> google.protobuf.Timestamp
+	12,  // 103: nglogic.bikerental.v1.WebhookDelivery.status:type_name -> nglogic.bikerental.v1.WebhookDeliveryStatus
+	94,  // 104: nglogic.bikerental.v1.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	94,  // 105: nglogic.bikerental.v1.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 106: nglogic.bikerental.v1.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	84,  // 107: nglogic.bikerental.v1.WebhookDelivery.log:type_name -> nglogic.bikerental.v1.WebhookAttempt
+	12,  // 108: nglogic.bikerental.v1.ListWebhookDeliveriesRequest.status:type_name -> nglogic.bikerental.v1.WebhookDeliveryStatus
+	85,  // 109: nglogic.bikerental.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> nglogic.bikerental.v1.WebhookDelivery
+	13,  // 110: nglogic.bikerental.v1.APIKey.role:type_name -> nglogic.bikerental.v1.Role
+	94,  // 111: nglogic.bikerental.v1.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 112: nglogic.bikerental.v1.APIKey.revoked_at:type_name -> google.protobuf.Timestamp
+	89,  // 113: nglogic.bikerental.v1.ListAPIKeysResponse.keys:type_name -> nglogic.bikerental.v1.APIKey
+	13,  // 114: nglogic.bikerental.v1.IssueAPIKeyRequest.role:type_name -> nglogic.bikerental.v1.Role
+	89,  // 115: nglogic.bikerental.v1.IssueAPIKeyResponse.key:type_name -> nglogic.bikerental.v1.APIKey
+	95,  // 116: nglogic.bikerental.v1.BikeRentalService.ListBikes:input_type -> google.protobuf.Empty
+	22,  // 117: nglogic.bikerental.v1.BikeRentalService.GetBike:input_type -> nglogic.bikerental.v1.GetBikeRequest
+	23,  // 118: nglogic.bikerental.v1.BikeRentalService.CreateBike:input_type -> nglogic.bikerental.v1.CreateBikeRequest
+	25,  // 119: nglogic.bikerental.v1.BikeRentalService.DeleteBike:input_type -> nglogic.bikerental.v1.DeleteBikeRequest
+	24,  // 120: nglogic.bikerental.v1.BikeRentalService.UpdateBike:input_type -> nglogic.bikerental.v1.UpdateBikeRequest
+	26,  // 121: nglogic.bikerental.v1.BikeRentalService.GetBikeAvailability:input_type -> nglogic.bikerental.v1.GetBikeAvailabilityRequest
+	33,  // 122: nglogic.bikerental.v1.BikeRentalService.ListReservations:input_type -> nglogic.bikerental.v1.ListReservationsRequest
+	28,  // 123: nglogic.bikerental.v1.BikeRentalService.GetPriceQuote:input_type -> nglogic.bikerental.v1.GetPriceQuoteRequest
+	31,  // 124: nglogic.bikerental.v1.BikeRentalService.CreateReservation:input_type -> nglogic.bikerental.v1.CreateReservationRequest
+	39,  // 125: nglogic.bikerental.v1.BikeRentalService.CancelReservation:input_type -> nglogic.bikerental.v1.CancelReservationRequest
+	40,  // 126: nglogic.bikerental.v1.BikeRentalService.CompleteReservation:input_type -> nglogic.bikerental.v1.CompleteReservationRequest
+	41,  // 127: nglogic.bikerental.v1.BikeRentalService.RefundReservation:input_type -> nglogic.bikerental.v1.RefundReservationRequest
+	52,  // 128: nglogic.bikerental.v1.BikeRentalService.GetReservationInvoice:input_type -> nglogic.bikerental.v1.GetReservationInvoiceRequest
+	35,  // 129: nglogic.bikerental.v1.BikeRentalService.WatchReservations:input_type -> nglogic.bikerental.v1.WatchReservationsRequest
+	37,  // 130: nglogic.bikerental.v1.BikeRentalService.WatchBikeAvailability:input_type -> nglogic.bikerental.v1.WatchBikeAvailabilityRequest
+	44,  // 131: nglogic.bikerental.v1.BikeRentalService.FileDamageReport:input_type -> nglogic.bikerental.v1.FileDamageReportRequest
+	45,  // 132: nglogic.bikerental.v1.BikeRentalService.ListDamageReports:input_type -> nglogic.bikerental.v1.ListDamageReportsRequest
+	47,  // 133: nglogic.bikerental.v1.BikeRentalService.GetDamageReportPhoto:input_type -> nglogic.bikerental.v1.GetDamageReportPhotoRequest
+	95,  // 134: nglogic.bikerental.v1.BikeRentalService.ListPromoCodes:input_type -> google.protobuf.Empty
+	57,  // 135: nglogic.bikerental.v1.BikeRentalService.CreatePromoCode:input_type -> nglogic.bikerental.v1.CreatePromoCodeRequest
+	61,  // 136: nglogic.bikerental.v1.BikeRentalService.GetLoyaltyAccount:input_type -> nglogic.bikerental.v1.GetLoyaltyAccountRequest
+	62,  // 137: nglogic.bikerental.v1.BikeRentalService.ListLoyaltyEntries:input_type -> nglogic.bikerental.v1.ListLoyaltyEntriesRequest
+	95,  // 138: nglogic.bikerental.v1.BikeRentalService.ListCompanies:input_type -> google.protobuf.Empty
+	67,  // 139: nglogic.bikerental.v1.BikeRentalService.GetCompany:input_type -> nglogic.bikerental.v1.GetCompanyRequest
+	68,  // 140: nglogic.bikerental.v1.BikeRentalService.CreateCompany:input_type -> nglogic.bikerental.v1.CreateCompanyRequest
+	69,  // 141: nglogic.bikerental.v1.BikeRentalService.UpdateCompany:input_type -> nglogic.bikerental.v1.UpdateCompanyRequest
+	70,  // 142: nglogic.bikerental.v1.BikeRentalService.ListCompanyMembers:input_type -> nglogic.bikerental.v1.ListCompanyMembersRequest
+	72,  // 143: nglogic.bikerental.v1.BikeRentalService.AddCompanyMember:input_type -> nglogic.bikerental.v1.AddCompanyMemberRequest
+	73,  // 144: nglogic.bikerental.v1.BikeRentalService.RemoveCompanyMember:input_type -> nglogic.bikerental.v1.RemoveCompanyMemberRequest
+	74,  // 145: nglogic.bikerental.v1.BikeRentalService.GetCompanyStatement:input_type -> nglogic.bikerental.v1.GetCompanyStatementRequest
+	95,  // 146: nglogic.bikerental.v1.BikeRentalService.ListBlocklist:input_type -> google.protobuf.Empty
+	78,  // 147: nglogic.bikerental.v1.BikeRentalService.AddToBlocklist:input_type -> nglogic.bikerental.v1.AddToBlocklistRequest
+	79,  // 148: nglogic.bikerental.v1.BikeRentalService.RemoveFromBlocklist:input_type -> nglogic.bikerental.v1.RemoveFromBlocklistRequest
+	95,  // 149: nglogic.bikerental.v1.BikeRentalService.ListWebhookSubscriptions:input_type -> google.protobuf.Empty
+	82,  // 150: nglogic.bikerental.v1.BikeRentalService.CreateWebhookSubscription:input_type -> nglogic.bikerental.v1.CreateWebhookSubscriptionRequest
+	83,  // 151: nglogic.bikerental.v1.BikeRentalService.DeleteWebhookSubscription:input_type -> nglogic.bikerental.v1.DeleteWebhookSubscriptionRequest
+	86,  // 152: nglogic.bikerental.v1.BikeRentalService.ListWebhookDeliveries:input_type -> nglogic.bikerental.v1.ListWebhookDeliveriesRequest
+	88,  // 153: nglogic.bikerental.v1.BikeRentalService.RedeliverWebhook:input_type -> nglogic.bikerental.v1.RedeliverWebhookRequest
+	95,  // 154: nglogic.bikerental.v1.BikeRentalService.ListAPIKeys:input_type -> google.protobuf.Empty
+	91,  // 155: nglogic.bikerental.v1.BikeRentalService.IssueAPIKey:input_type -> nglogic.bikerental.v1.IssueAPIKeyRequest
+	93,  // 156: nglogic.bikerental.v1.BikeRentalService.RevokeAPIKey:input_type -> nglogic.bikerental.v1.RevokeAPIKeyRequest
+	48,  // 157: nglogic.bikerental.v1.BikeRentalService.ListOpenIntervals:input_type -> nglogic.bikerental.v1.ListOpenIntervalsRequest
+	21,  // 158: nglogic.bikerental.v1.BikeRentalService.ListBikes:output_type -> nglogic.bikerental.v1.ListBikesResponse
+	15,  // 159: nglogic.bikerental.v1.BikeRentalService.GetBike:output_type -> nglogic.bikerental.v1.Bike
+	15,  // 160: nglogic.bikerental.v1.BikeRentalService.CreateBike:output_type -> nglogic.bikerental.v1.Bike
+	95,  // 161: nglogic.bikerental.v1.BikeRentalService.DeleteBike:output_type -> google.protobuf.Empty
+	95,  // 162: nglogic.bikerental.v1.BikeRentalService.UpdateBike:output_type -> google.protobuf.Empty
+	27,  // 163: nglogic.bikerental.v1.BikeRentalService.GetBikeAvailability:output_type -> nglogic.bikerental.v1.GetBikeAvailabilityResponse
+	34,  // 164: nglogic.bikerental.v1.BikeRentalService.ListReservations:output_type -> nglogic.bikerental.v1.ListReservationsResponse
+	29,  // 165: nglogic.bikerental.v1.BikeRentalService.GetPriceQuote:output_type -> nglogic.bikerental.v1.PriceQuote
+	32,  // 166: nglogic.bikerental.v1.BikeRentalService.CreateReservation:output_type -> nglogic.bikerental.v1.CreateReservationResponse
+	95,  // 167: nglogic.bikerental.v1.BikeRentalService.CancelReservation:output_type -> google.protobuf.Empty
+	95,  // 168: nglogic.bikerental.v1.BikeRentalService.CompleteReservation:output_type -> google.protobuf.Empty
+	95,  // 169: nglogic.bikerental.v1.BikeRentalService.RefundReservation:output_type -> google.protobuf.Empty
+	53,  // 170: nglogic.bikerental.v1.BikeRentalService.GetReservationInvoice:output_type -> nglogic.bikerental.v1.Invoice
+	36,  // 171: nglogic.bikerental.v1.BikeRentalService.WatchReservations:output_type -> nglogic.bikerental.v1.ReservationChange
+	38,  // 172: nglogic.bikerental.v1.BikeRentalService.WatchBikeAvailability:output_type -> nglogic.bikerental.v1.BikeAvailabilityChange
+	42,  // 173: nglogic.bikerental.v1.BikeRentalService.FileDamageReport:output_type -> nglogic.bikerental.v1.DamageReport
+	46,  // 174: nglogic.bikerental.v1.BikeRentalService.ListDamageReports:output_type -> nglogic.bikerental.v1.ListDamageReportsResponse
+	43,  // 175: nglogic.bikerental.v1.BikeRentalService.GetDamageReportPhoto:output_type -> nglogic.bikerental.v1.DamageReportPhoto
+	58,  // 176: nglogic.bikerental.v1.BikeRentalService.ListPromoCodes:output_type -> nglogic.bikerental.v1.ListPromoCodesResponse
+	56,  // 177: nglogic.bikerental.v1.BikeRentalService.CreatePromoCode:output_type -> nglogic.bikerental.v1.PromoCode
+	59,  // 178: nglogic.bikerental.v1.BikeRentalService.GetLoyaltyAccount:output_type -> nglogic.bikerental.v1.LoyaltyAccount
+	63,  // 179: nglogic.bikerental.v1.BikeRentalService.ListLoyaltyEntries:output_type -> nglogic.bikerental.v1.ListLoyaltyEntriesResponse
+	66,  // 180: nglogic.bikerental.v1.BikeRentalService.ListCompanies:output_type -> nglogic.bikerental.v1.ListCompaniesResponse
+	64,  // 181: nglogic.bikerental.v1.BikeRentalService.GetCompany:output_type -> nglogic.bikerental.v1.Company
+	64,  // 182: nglogic.bikerental.v1.BikeRentalService.CreateCompany:output_type -> nglogic.bikerental.v1.Company
+	95,  // 183: nglogic.bikerental.v1.BikeRentalService.UpdateCompany:output_type -> google.protobuf.Empty
+	71,  // 184: nglogic.bikerental.v1.BikeRentalService.ListCompanyMembers:output_type -> nglogic.bikerental.v1.ListCompanyMembersResponse
+	17,  // 185: nglogic.bikerental.v1.BikeRentalService.AddCompanyMember:output_type -> nglogic.bikerental.v1.Customer
+	95,  // 186: nglogic.bikerental.v1.BikeRentalService.RemoveCompanyMember:output_type -> google.protobuf.Empty
+	75,  // 187: nglogic.bikerental.v1.BikeRentalService.GetCompanyStatement:output_type -> nglogic.bikerental.v1.CompanyStatement
+	77,  // 188: nglogic.bikerental.v1.BikeRentalService.ListBlocklist:output_type -> nglogic.bikerental.v1.ListBlocklistResponse
+	76,  // 189: nglogic.bikerental.v1.BikeRentalService.AddToBlocklist:output_type -> nglogic.bikerental.v1.BlocklistEntry
+	95,  // 190: nglogic.bikerental.v1.BikeRentalService.RemoveFromBlocklist:output_type -> google.protobuf.Empty
+	81,  // 191: nglogic.bikerental.v1.BikeRentalService.ListWebhookSubscriptions:output_type -> nglogic.bikerental.v1.ListWebhookSubscriptionsResponse
+	80,  // 192: nglogic.bikerental.v1.BikeRentalService.CreateWebhookSubscription:output_type -> nglogic.bikerental.v1.WebhookSubscription
+	95,  // 193: nglogic.bikerental.v1.BikeRentalService.DeleteWebhookSubscription:output_type -> google.protobuf.Empty
+	87,  // 194: nglogic.bikerental.v1.BikeRentalService.ListWebhookDeliveries:output_type -> nglogic.bikerental.v1.ListWebhookDeliveriesResponse
+	85,  // 195: nglogic.bikerental.v1.BikeRentalService.RedeliverWebhook:output_type -> nglogic.bikerental.v1.WebhookDelivery
+	90,  // 196: nglogic.bikerental.v1.BikeRentalService.ListAPIKeys:output_type -> nglogic.bikerental.v1.ListAPIKeysResponse
+	92,  // 197: nglogic.bikerental.v1.BikeRentalService.IssueAPIKey:output_type -> nglogic.bikerental.v1.IssueAPIKeyResponse
+	95,  // 198: nglogic.bikerental.v1.BikeRentalService.RevokeAPIKey:output_type -> google.protobuf.Empty
+	49,  // 199: nglogic.bikerental.v1.BikeRentalService.ListOpenIntervals:output_type -> nglogic.bikerental.v1.ListOpenIntervalsResponse
+	158, // [158:200] is the sub-list for method output_type
+	116, // [116:158] is the sub-list for method input_type
+	116, // [116:116] is the sub-list for extension type_name
+	116, // [116:116] is the sub-list for extension extendee
+	0,   // [0:116] is the sub-list for field type_name
+}
+
+func init() { file_nglogic_bikerental_v1_service_proto_init() }
+func file_nglogic_bikerental_v1_service_proto_init() {
+	if File_nglogic_bikerental_v1_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_nglogic_bikerental_v1_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bike); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BikeData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Customer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reservation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBikesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBikeAvailabilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBikeAvailabilityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPriceQuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceQuoteItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReservationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReservationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReservationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchReservationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservationChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchBikeAvailabilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BikeAvailabilityChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DamageReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DamageReportPhoto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileDamageReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDamageReportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDamageReportsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDamageReportPhotoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOpenIntervalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOpenIntervalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenInterval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservationTax); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReservationInvoiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceTaxLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePromoCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPromoCodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoyaltyAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoyaltyEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoyaltyAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoyaltyEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoyaltyEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Company); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompanyData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCompaniesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompanyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCompanyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCompanyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCompanyMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCompanyMembersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCompanyMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCompanyMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompanyStatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompanyStatement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlocklistEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlocklistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddToBlocklistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFromBlocklistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookSubscriptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeliverWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAPIKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueAPIKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueAPIKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAPIKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_nglogic_bikerental_v1_service_proto_rawDesc,
+			NumEnums:      14,
+			NumMessages:   80,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_nglogic_bikerental_v1_service_proto_goTypes,
+		DependencyIndexes: file_nglogic_bikerental_v1_service_proto_depIdxs,
+		EnumInfos:         file_nglogic_bikerental_v1_service_proto_enumTypes,
+		MessageInfos:      file_nglogic_bikerental_v1_service_proto_msgTypes,
+	}.Build()
+	File_nglogic_bikerental_v1_service_proto = out.File
+	file_nglogic_bikerental_v1_service_proto_rawDesc = nil
+	file_nglogic_bikerental_v1_service_proto_goTypes = nil
+	file_nglogic_bikerental_v1_service_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// BikeRentalServiceClient is the client API for BikeRentalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BikeRentalServiceClient interface {
+	// List all bikes.
+	ListBikes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListBikesResponse, error)
+	// Return bike by id.
+	GetBike(ctx context.Context, in *GetBikeRequest, opts ...grpc.CallOption) (*Bike, error)
+	// Create new bike.
+	//
+	// Returns created object with new id.
+	// Retries with the same Idempotency-Key header (or metadata) within 24 hours get response of the first call.
+	// Reusing the key with a different request fails with FAILED_PRECONDITION.
+	CreateBike(ctx context.Context, in *CreateBikeRequest, opts ...grpc.CallOption) (*Bike, error)
+	// Delete a bike by id.
+	DeleteBike(ctx context.Context, in *DeleteBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Update a bike.
+	UpdateBike(ctx context.Context, in *UpdateBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Check if bike is available.
+	GetBikeAvailability(ctx context.Context, in *GetBikeAvailabilityRequest, opts ...grpc.CallOption) (*GetBikeAvailabilityResponse, error)
+	// List reservations.
+	//
+	// Returns list of reservations for a bike.
+	ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
+	// Calculate price of renting a bike.
+	//
+	// Returns price itemized by rate periods (peak/off-peak hours, weekends, seasons). Discounts are not included.
+	GetPriceQuote(ctx context.Context, in *GetPriceQuoteRequest, opts ...grpc.CallOption) (*PriceQuote, error)
+	// Create reservation.
+	//
+	// Returns created object with new id.
+	// Retries with the same Idempotency-Key header (or metadata) within 24 hours get response of the first call.
+	// Reusing the key with a different request fails with FAILED_PRECONDITION.
+	CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error)
+	// Cancel reservation.
+	//
+	// Only approved reservations can be canceled. Payment authorization is voided and deposit released.
+	CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Complete reservation.
+	//
+	// Marks reservation as completed when the bike is returned. Customer earns loyalty points.
+	CompleteReservation(ctx context.Context, in *CompleteReservationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Refund reservation.
+	//
+	// Refunds captured payment of completed reservation. Loyalty points earned for the reservation are kept.
+	RefundReservation(ctx context.Context, in *RefundReservationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Get reservation invoice.
+	//
+	// Returns VAT invoice with itemized tax lines. Only completed reservations can be invoiced.
+	GetReservationInvoice(ctx context.Context, in *GetReservationInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error)
+	// Watch reservation changes.
+	//
+	// Streams created, canceled and completed reservations, optionally filtered by bike or station.
+	// Only changes made after the call are sent. Stream ends with UNAVAILABLE status if changes could have been missed,
+	// e.g. the client is too slow or the server is shutting down; clients should reload data and watch again.
+	// HTTP gateway streams newline-delimited JSON, or Server-Sent Events if "text/event-stream" is accepted.
+	WatchReservations(ctx context.Context, in *WatchReservationsRequest, opts ...grpc.CallOption) (BikeRentalService_WatchReservationsClient, error)
+	// Watch bike availability changes.
+	//
+	// Streams time ranges becoming unavailable or available again by reservation changes,
+	// optionally filtered by bike or station. Stream ends like in WatchReservations.
+	WatchBikeAvailability(ctx context.Context, in *WatchBikeAvailabilityRequest, opts ...grpc.CallOption) (BikeRentalService_WatchBikeAvailabilityClient, error)
+	// File a damage report for a reservation.
+	//
+	// Reports with high severity put the bike out of service.
+	FileDamageReport(ctx context.Context, in *FileDamageReportRequest, opts ...grpc.CallOption) (*DamageReport, error)
+	// List damage reports.
+	//
+	// Returns list of damage reports for a bike, from the newest.
+	ListDamageReports(ctx context.Context, in *ListDamageReportsRequest, opts ...grpc.CallOption) (*ListDamageReportsResponse, error)
+	// Return a photo attached to damage report.
+	GetDamageReportPhoto(ctx context.Context, in *GetDamageReportPhotoRequest, opts ...grpc.CallOption) (*DamageReportPhoto, error)
+	// List promo codes.
+	ListPromoCodes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListPromoCodesResponse, error)
+	// Create promo code.
+	//
+	// Code is converted to upper case. Returns created object.
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCode, error)
+	// Return customer loyalty account.
+	//
+	// Returns points balance and loyalty tier.
+	GetLoyaltyAccount(ctx context.Context, in *GetLoyaltyAccountRequest, opts ...grpc.CallOption) (*LoyaltyAccount, error)
+	// List customer loyalty ledger entries.
+	//
+	// Returns all points movements, from the oldest.
+	ListLoyaltyEntries(ctx context.Context, in *ListLoyaltyEntriesRequest, opts ...grpc.CallOption) (*ListLoyaltyEntriesResponse, error)
+	// List companies.
+	ListCompanies(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListCompaniesResponse, error)
+	// Return company by id.
+	GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*Company, error)
+	// Create new company.
+	//
+	// Returns created object with new id.
+	CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*Company, error)
+	// Update a company.
+	UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List company members.
+	ListCompanyMembers(ctx context.Context, in *ListCompanyMembersRequest, opts ...grpc.CallOption) (*ListCompanyMembersResponse, error)
+	// Add existing customer to a company.
+	//
+	// Customer becomes a business customer. Returns updated customer.
+	AddCompanyMember(ctx context.Context, in *AddCompanyMemberRequest, opts ...grpc.CallOption) (*Customer, error)
+	// Remove customer from a company.
+	RemoveCompanyMember(ctx context.Context, in *RemoveCompanyMemberRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Return monthly company statement.
+	//
+	// Returns all approved and completed reservations of company members starting in given month (UTC).
+	GetCompanyStatement(ctx context.Context, in *GetCompanyStatementRequest, opts ...grpc.CallOption) (*CompanyStatement, error)
+	// List customer blocklist.
+	ListBlocklist(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListBlocklistResponse, error)
+	// Add entry to customer blocklist.
+	//
+	// Blocked customers can't make new reservations. Email domains are converted to lower case.
+	// Returns created object with new id.
+	AddToBlocklist(ctx context.Context, in *AddToBlocklistRequest, opts ...grpc.CallOption) (*BlocklistEntry, error)
+	// Remove entry from customer blocklist.
+	RemoveFromBlocklist(ctx context.Context, in *RemoveFromBlocklistRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List webhook subscriptions.
+	//
+	// Secrets are not returned.
+	ListWebhookSubscriptions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	// Create webhook subscription.
+	//
+	// Events matching the filter are posted to the url, signed with HMAC-SHA256 using the secret.
+	// Secret is generated if it's empty. It's returned only in this response.
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error)
+	// Delete webhook subscription with its delivery log.
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List webhook deliveries.
+	//
+	// Returns delivery log of a subscription, from the newest deliveries.
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// Redeliver webhook.
+	//
+	// Schedules delivery to be sent again immediately, also if it's dead or already delivered.
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+	// List API keys.
+	//
+	// Returns API keys of the caller's tenant, revoked too. Keys themselves are not returned.
+	ListAPIKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// Issue API key.
+	//
+	// Creates API key for the caller's tenant. The key is returned only in this response, only its hash is stored.
+	// API key methods are available only for admins.
+	IssueAPIKey(ctx context.Context, in *IssueAPIKeyRequest, opts ...grpc.CallOption) (*IssueAPIKeyResponse, error)
+	// Revoke API key.
+	//
+	// Revoked key can't be used anymore.
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List station opening hours.
+	//
+	// Returns time ranges when a station is open. Reservations can start and end only within them.
+	// Empty station id means global opening hours.
+	ListOpenIntervals(ctx context.Context, in *ListOpenIntervalsRequest, opts ...grpc.CallOption) (*ListOpenIntervalsResponse, error)
+}
+
+type bikeRentalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBikeRentalServiceClient(cc grpc.ClientConnInterface) BikeRentalServiceClient {
+	return &bikeRentalServiceClient{cc}
+}
+
+func (c *bikeRentalServiceClient) ListBikes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListBikesResponse, error) {
+	out := new(ListBikesResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListBikes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetBike(ctx context.Context, in *GetBikeRequest, opts ...grpc.CallOption) (*Bike, error) {
+	out := new(Bike)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetBike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CreateBike(ctx context.Context, in *CreateBikeRequest, opts ...grpc.CallOption) (*Bike, error) {
+	out := new(Bike)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateBike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) DeleteBike(ctx context.Context, in *DeleteBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/DeleteBike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) UpdateBike(ctx context.Context, in *UpdateBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/UpdateBike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetBikeAvailability(ctx context.Context, in *GetBikeAvailabilityRequest, opts ...grpc.CallOption) (*GetBikeAvailabilityResponse, error) {
+	out := new(GetBikeAvailabilityResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetBikeAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error) {
+	out := new(ListReservationsResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListReservations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetPriceQuote(ctx context.Context, in *GetPriceQuoteRequest, opts ...grpc.CallOption) (*PriceQuote, error) {
+	out := new(PriceQuote)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetPriceQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error) {
+	out := new(CreateReservationResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CancelReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CompleteReservation(ctx context.Context, in *CompleteReservationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CompleteReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) RefundReservation(ctx context.Context, in *RefundReservationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/RefundReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetReservationInvoice(ctx context.Context, in *GetReservationInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error) {
+	out := new(Invoice)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetReservationInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) WatchReservations(ctx context.Context, in *WatchReservationsRequest, opts ...grpc.CallOption) (BikeRentalService_WatchReservationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BikeRentalService_serviceDesc.Streams[0], "/nglogic.bikerental.v1.BikeRentalService/WatchReservations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bikeRentalServiceWatchReservationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BikeRentalService_WatchReservationsClient interface {
+	Recv() (*ReservationChange, error)
+	grpc.ClientStream
+}
+
+type bikeRentalServiceWatchReservationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bikeRentalServiceWatchReservationsClient) Recv() (*ReservationChange, error) {
+	m := new(ReservationChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bikeRentalServiceClient) WatchBikeAvailability(ctx context.Context, in *WatchBikeAvailabilityRequest, opts ...grpc.CallOption) (BikeRentalService_WatchBikeAvailabilityClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BikeRentalService_serviceDesc.Streams[1], "/nglogic.bikerental.v1.BikeRentalService/WatchBikeAvailability", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bikeRentalServiceWatchBikeAvailabilityClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BikeRentalService_WatchBikeAvailabilityClient interface {
+	Recv() (*BikeAvailabilityChange, error)
+	grpc.ClientStream
+}
+
+type bikeRentalServiceWatchBikeAvailabilityClient struct {
+	grpc.ClientStream
+}
+
+func (x *bikeRentalServiceWatchBikeAvailabilityClient) Recv() (*BikeAvailabilityChange, error) {
+	m := new(BikeAvailabilityChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bikeRentalServiceClient) FileDamageReport(ctx context.Context, in *FileDamageReportRequest, opts ...grpc.CallOption) (*DamageReport, error) {
+	out := new(DamageReport)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/FileDamageReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListDamageReports(ctx context.Context, in *ListDamageReportsRequest, opts ...grpc.CallOption) (*ListDamageReportsResponse, error) {
+	out := new(ListDamageReportsResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListDamageReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetDamageReportPhoto(ctx context.Context, in *GetDamageReportPhotoRequest, opts ...grpc.CallOption) (*DamageReportPhoto, error) {
+	out := new(DamageReportPhoto)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetDamageReportPhoto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListPromoCodes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListPromoCodesResponse, error) {
+	out := new(ListPromoCodesResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListPromoCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCode, error) {
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreatePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetLoyaltyAccount(ctx context.Context, in *GetLoyaltyAccountRequest, opts ...grpc.CallOption) (*LoyaltyAccount, error) {
+	out := new(LoyaltyAccount)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetLoyaltyAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListLoyaltyEntries(ctx context.Context, in *ListLoyaltyEntriesRequest, opts ...grpc.CallOption) (*ListLoyaltyEntriesResponse, error) {
+	out := new(ListLoyaltyEntriesResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListLoyaltyEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListCompanies(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListCompaniesResponse, error) {
+	out := new(ListCompaniesResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListCompanies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
+	out := new(Company)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
+	out := new(Company)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/UpdateCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListCompanyMembers(ctx context.Context, in *ListCompanyMembersRequest, opts ...grpc.CallOption) (*ListCompanyMembersResponse, error) {
+	out := new(ListCompanyMembersResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListCompanyMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) AddCompanyMember(ctx context.Context, in *AddCompanyMemberRequest, opts ...grpc.CallOption) (*Customer, error) {
+	out := new(Customer)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/AddCompanyMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) RemoveCompanyMember(ctx context.Context, in *RemoveCompanyMemberRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/RemoveCompanyMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetCompanyStatement(ctx context.Context, in *GetCompanyStatementRequest, opts ...grpc.CallOption) (*CompanyStatement, error) {
+	out := new(CompanyStatement)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetCompanyStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListBlocklist(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListBlocklistResponse, error) {
+	out := new(ListBlocklistResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListBlocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) AddToBlocklist(ctx context.Context, in *AddToBlocklistRequest, opts ...grpc.CallOption) (*BlocklistEntry, error) {
+	out := new(BlocklistEntry)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/AddToBlocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) RemoveFromBlocklist(ctx context.Context, in *RemoveFromBlocklistRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/RemoveFromBlocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListWebhookSubscriptions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListWebhookSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error) {
+	out := new(WebhookSubscription)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateWebhookSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/DeleteWebhookSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/RedeliverWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListAPIKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) IssueAPIKey(ctx context.Context, in *IssueAPIKeyRequest, opts ...grpc.CallOption) (*IssueAPIKeyResponse, error) {
+	out := new(IssueAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/IssueAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListOpenIntervals(ctx context.Context, in *ListOpenIntervalsRequest, opts ...grpc.CallOption) (*ListOpenIntervalsResponse, error) {
+	out := new(ListOpenIntervalsResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListOpenIntervals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BikeRentalServiceServer is the server API for BikeRentalService service.
+type BikeRentalServiceServer interface {
+	// List all bikes.
+	ListBikes(context.Context, *empty.Empty) (*ListBikesResponse, error)
+	// Return bike by id.
+	GetBike(context.Context, *GetBikeRequest) (*Bike, error)
+	// Create new bike.
+	//
+	// Returns created object with new id.
+	// Retries with the same Idempotency-Key header (or metadata) within 24 hours get response of the first call.
+	// Reusing the key with a different request fails with FAILED_PRECONDITION.
+	CreateBike(context.Context, *CreateBikeRequest) (*Bike, error)
+	// Delete a bike by id.
+	DeleteBike(context.Context, *DeleteBikeRequest) (*empty.Empty, error)
+	// Update a bike.
+	UpdateBike(context.Context, *UpdateBikeRequest) (*empty.Empty, error)
+	// Check if bike is available.
+	GetBikeAvailability(context.Context, *GetBikeAvailabilityRequest) (*GetBikeAvailabilityResponse, error)
+	// List reservations.
+	//
+	// Returns list of reservations for a bike.
+	ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error)
+	// Calculate price of renting a bike.
+	//
+	// Returns price itemized by rate periods (peak/off-peak hours, weekends, seasons). Discounts are not included.
+	GetPriceQuote(context.Context, *GetPriceQuoteRequest) (*PriceQuote, error)
+	// Create reservation.
+	//
+	// Returns created object with new id.
+	// Retries with the same Idempotency-Key header (or metadata) within 24 hours get response of the first call.
+	// Reusing the key with a different request fails with FAILED_PRECONDITION.
+	CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error)
+	// Cancel reservation.
+	//
+	// Only approved reservations can be canceled. Payment authorization is voided and deposit released.
+	CancelReservation(context.Context, *CancelReservationRequest) (*empty.Empty, error)
+	// Complete reservation.
+	//
+	// Marks reservation as completed when the bike is returned. Customer earns loyalty points.
+	CompleteReservation(context.Context, *CompleteReservationRequest) (*empty.Empty, error)
+	// Refund reservation.
+	//
+	// Refunds captured payment of completed reservation. Loyalty points earned for the reservation are kept.
+	RefundReservation(context.Context, *RefundReservationRequest) (*empty.Empty, error)
+	// Get reservation invoice.
+	//
+	// Returns VAT invoice with itemized tax lines. Only completed reservations can be invoiced.
+	GetReservationInvoice(context.Context, *GetReservationInvoiceRequest) (*Invoice, error)
+	// Watch reservation changes.
+	//
+	// Streams created, canceled and completed reservations, optionally filtered by bike or station.
+	// Only changes made after the call are sent. Stream ends with UNAVAILABLE status if changes could have been missed,
+	// e.g. the client is too slow or the server is shutting down; clients should reload data and watch again.
+	// HTTP gateway streams newline-delimited JSON, or Server-Sent Events if "text/event-stream" is accepted.
+	WatchReservations(*WatchReservationsRequest, BikeRentalService_WatchReservationsServer) error
+	// Watch bike availability changes.
+	//
+	// Streams time ranges becoming unavailable or available again by reservation changes,
+	// optionally filtered by bike or station. Stream ends like in WatchReservations.
+	WatchBikeAvailability(*WatchBikeAvailabilityRequest, BikeRentalService_WatchBikeAvailabilityServer) error
+	// File a damage report for a reservation.
+	//
+	// Reports with high severity put the bike out of service.
+	FileDamageReport(context.Context, *FileDamageReportRequest) (*DamageReport, error)
+	// List damage reports.
+	//
+	// Returns list of damage reports for a bike, from the newest.
+	ListDamageReports(context.Context, *ListDamageReportsRequest) (*ListDamageReportsResponse, error)
+	// Return a photo attached to damage report.
+	GetDamageReportPhoto(context.Context, *GetDamageReportPhotoRequest) (*DamageReportPhoto, error)
+	// List promo codes.
+	ListPromoCodes(context.Context, *empty.Empty) (*ListPromoCodesResponse, error)
+	// Create promo code.
+	//
+	// Code is converted to upper case. Returns created object.
+	CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*PromoCode, error)
+	// Return customer loyalty account.
+	//
+	// Returns points balance and loyalty tier.
+	GetLoyaltyAccount(context.Context, *GetLoyaltyAccountRequest) (*LoyaltyAccount, error)
+	// List customer loyalty ledger entries.
+	//
+	// Returns all points movements, from the oldest.
+	ListLoyaltyEntries(context.Context, *ListLoyaltyEntriesRequest) (*ListLoyaltyEntriesResponse, error)
+	// List companies.
+	ListCompanies(context.Context, *empty.Empty) (*ListCompaniesResponse, error)
+	// Return company by id.
+	GetCompany(context.Context, *GetCompanyRequest) (*Company, error)
+	// Create new company.
+	//
+	// Returns created object with new id.
+	CreateCompany(context.Context, *CreateCompanyRequest) (*Company, error)
+	// Update a company.
+	UpdateCompany(context.Context, *UpdateCompanyRequest) (*empty.Empty, error)
+	// List company members.
+	ListCompanyMembers(context.Context, *ListCompanyMembersRequest) (*ListCompanyMembersResponse, error)
+	// Add existing customer to a company.
+	//
+	// Customer becomes a business customer. Returns updated customer.
+	AddCompanyMember(context.Context, *AddCompanyMemberRequest) (*Customer, error)
+	// Remove customer from a company.
+	RemoveCompanyMember(context.Context, *RemoveCompanyMemberRequest) (*empty.Empty, error)
+	// Return monthly company statement.
+	//
+	// Returns all approved and completed reservations of company members starting in given month (UTC).
+	GetCompanyStatement(context.Context, *GetCompanyStatementRequest) (*CompanyStatement, error)
+	// List customer blocklist.
+	ListBlocklist(context.Context, *empty.Empty) (*ListBlocklistResponse, error)
+	// Add entry to customer blocklist.
+	//
+	// Blocked customers can't make new reservations. Email domains are converted to lower case.
+	// Returns created object with new id.
+	AddToBlocklist(context.Context, *AddToBlocklistRequest) (*BlocklistEntry, error)
+	// Remove entry from customer blocklist.
+	RemoveFromBlocklist(context.Context, *RemoveFromBlocklistRequest) (*empty.Empty, error)
+	// List webhook subscriptions.
+	//
+	// Secrets are not returned.
+	ListWebhookSubscriptions(context.Context, *empty.Empty) (*ListWebhookSubscriptionsResponse, error)
+	// Create webhook subscription.
+	//
+	// Events matching the filter are posted to the url, signed with HMAC-SHA256 using the secret.
+	// Secret is generated if it's empty. It's returned only in this response.
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error)
+	// Delete webhook subscription with its delivery log.
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*empty.Empty, error)
+	// List webhook deliveries.
+	//
+	// Returns delivery log of a subscription, from the newest deliveries.
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// Redeliver webhook.
+	//
+	// Schedules delivery to be sent again immediately, also if it's dead or already delivered.
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*WebhookDelivery, error)
+	// List API keys.
+	//
+	// Returns API keys of the caller's tenant, revoked too. Keys themselves are not returned.
+	ListAPIKeys(context.Context, *empty.Empty) (*ListAPIKeysResponse, error)
+	// Issue API key.
+	//
+	// Creates API key for the caller's tenant. The key is returned only in this response, only its hash is stored.
+	// API key methods are available only for admins.
+	IssueAPIKey(context.Context, *IssueAPIKeyRequest) (*IssueAPIKeyResponse, error)
+	// Revoke API key.
+	//
+	// Revoked key can't be used anymore.
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*empty.Empty, error)
+	// List station opening hours.
+	//
+	// Returns time ranges when a station is open. Reservations can start and end only within them.
+	// Empty station id means global opening hours.
+	ListOpenIntervals(context.Context, *ListOpenIntervalsRequest) (*ListOpenIntervalsResponse, error)
+}
+
+// UnimplementedBikeRentalServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedBikeRentalServiceServer struct {
+}
+
+func (*UnimplementedBikeRentalServiceServer) ListBikes(context.Context, *empty.Empty) (*ListBikesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBikes not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetBike(context.Context, *GetBikeRequest) (*Bike, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBike not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CreateBike(context.Context, *CreateBikeRequest) (*Bike, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBike not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) DeleteBike(context.Context, *DeleteBikeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBike not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) UpdateBike(context.Context, *UpdateBikeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBike not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetBikeAvailability(context.Context, *GetBikeAvailabilityRequest) (*GetBikeAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBikeAvailability not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReservations not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetPriceQuote(context.Context, *GetPriceQuoteRequest) (*PriceQuote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceQuote not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReservation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CancelReservation(context.Context, *CancelReservationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CompleteReservation(context.Context, *CompleteReservationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteReservation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) RefundReservation(context.Context, *RefundReservationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundReservation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetReservationInvoice(context.Context, *GetReservationInvoiceRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReservationInvoice not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) WatchReservations(*WatchReservationsRequest, BikeRentalService_WatchReservationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchReservations not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) WatchBikeAvailability(*WatchBikeAvailabilityRequest, BikeRentalService_WatchBikeAvailabilityServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBikeAvailability not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) FileDamageReport(context.Context, *FileDamageReportRequest) (*DamageReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileDamageReport not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListDamageReports(context.Context, *ListDamageReportsRequest) (*ListDamageReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDamageReports not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetDamageReportPhoto(context.Context, *GetDamageReportPhotoRequest) (*DamageReportPhoto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDamageReportPhoto not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListPromoCodes(context.Context, *empty.Empty) (*ListPromoCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromoCodes not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetLoyaltyAccount(context.Context, *GetLoyaltyAccountRequest) (*LoyaltyAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoyaltyAccount not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListLoyaltyEntries(context.Context, *ListLoyaltyEntriesRequest) (*ListLoyaltyEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoyaltyEntries not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListCompanies(context.Context, *empty.Empty) (*ListCompaniesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompanies not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetCompany(context.Context, *GetCompanyRequest) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompany not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CreateCompany(context.Context, *CreateCompanyRequest) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompany not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) UpdateCompany(context.Context, *UpdateCompanyRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompany not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListCompanyMembers(context.Context, *ListCompanyMembersRequest) (*ListCompanyMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompanyMembers not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) AddCompanyMember(context.Context, *AddCompanyMemberRequest) (*Customer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCompanyMember not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) RemoveCompanyMember(context.Context, *RemoveCompanyMemberRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCompanyMember not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetCompanyStatement(context.Context, *GetCompanyStatementRequest) (*CompanyStatement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyStatement not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListBlocklist(context.Context, *empty.Empty) (*ListBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlocklist not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) AddToBlocklist(context.Context, *AddToBlocklistRequest) (*BlocklistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToBlocklist not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) RemoveFromBlocklist(context.Context, *RemoveFromBlocklistRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromBlocklist not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListWebhookSubscriptions(context.Context, *empty.Empty) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListAPIKeys(context.Context, *empty.Empty) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) IssueAPIKey(context.Context, *IssueAPIKeyRequest) (*IssueAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueAPIKey not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListOpenIntervals(context.Context, *ListOpenIntervalsRequest) (*ListOpenIntervalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOpenIntervals not implemented")
+}
+
+func RegisterBikeRentalServiceServer(s *grpc.Server, srv BikeRentalServiceServer) {
+	s.RegisterService(&_BikeRentalService_serviceDesc, srv)
+}
+
+func _BikeRentalService_ListBikes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListBikes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListBikes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListBikes(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_GetBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).GetBike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetBike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).GetBike(ctx, req.(*GetBikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_CreateBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CreateBike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateBike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CreateBike(ctx, req.(*CreateBikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_DeleteBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).DeleteBike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/DeleteBike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).DeleteBike(ctx, req.(*DeleteBikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_UpdateBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).UpdateBike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/UpdateBike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).UpdateBike(ctx, req.(*UpdateBikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_GetBikeAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBikeAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).GetBikeAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetBikeAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).GetBikeAvailability(ctx, req.(*GetBikeAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_ListReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListReservations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListReservations(ctx, req.(*ListReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_GetPriceQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).GetPriceQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetPriceQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).GetPriceQuote(ctx, req.(*GetPriceQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_CreateReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CreateReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CreateReservation(ctx, req.(*CreateReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CancelReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CancelReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CancelReservation(ctx, req.(*CancelReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_CompleteReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CompleteReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CompleteReservation",
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_nglogic_bikerental_v1_service_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   18,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_nglogic_bikerental_v1_service_proto_goTypes,
-		DependencyIndexes: file_nglogic_bikerental_v1_service_proto_depIdxs,
-		EnumInfos:         file_nglogic_bikerental_v1_service_proto_enumTypes,
-		MessageInfos:      file_nglogic_bikerental_v1_service_proto_msgTypes,
-	}.Build()
-	File_nglogic_bikerental_v1_service_proto = out.File
-	file_nglogic_bikerental_v1_service_proto_rawDesc = nil
-	file_nglogic_bikerental_v1_service_proto_goTypes = nil
-	file_nglogic_bikerental_v1_service_proto_depIdxs = nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CompleteReservation(ctx, req.(*CompleteReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func _BikeRentalService_RefundReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).RefundReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/RefundReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).RefundReservation(ctx, req.(*RefundReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func _BikeRentalService_GetReservationInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReservationInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).GetReservationInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetReservationInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).GetReservationInvoice(ctx, req.(*GetReservationInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// BikeRentalServiceClient is the client API for BikeRentalService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BikeRentalServiceClient interface {
-	// List all bikes.
-	ListBikes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListBikesResponse, error)
-	// Return bike by id.
-	GetBike(ctx context.Context, in *GetBikeRequest, opts ...grpc.CallOption) (*Bike, error)
-	// Create new bike.
-	//
-	// Returns created object with new id.
-	CreateBike(ctx context.Context, in *CreateBikeRequest, opts ...grpc.CallOption) (*Bike, error)
-	// Delete a bike by id.
-	DeleteBike(ctx context.Context, in *DeleteBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Update a bike.
-	UpdateBike(ctx context.Context, in *UpdateBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Check if bike is available.
-	GetBikeAvailability(ctx context.Context, in *GetBikeAvailabilityRequest, opts ...grpc.CallOption) (*GetBikeAvailabilityResponse, error)
-	// List reservations.
-	//
-	// Returns list of reservations for a bike.
-	ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
-	// Create reservation.
-	//
-	// Returns created object with new id.
-	CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error)
-	// Cancel reservation.
-	CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+func _BikeRentalService_WatchReservations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReservationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BikeRentalServiceServer).WatchReservations(m, &bikeRentalServiceWatchReservationsServer{stream})
 }
 
-type bikeRentalServiceClient struct {
-	cc grpc.ClientConnInterface
+type BikeRentalService_WatchReservationsServer interface {
+	Send(*ReservationChange) error
+	grpc.ServerStream
 }
 
-func NewBikeRentalServiceClient(cc grpc.ClientConnInterface) BikeRentalServiceClient {
-	return &bikeRentalServiceClient{cc}
+type bikeRentalServiceWatchReservationsServer struct {
+	grpc.ServerStream
 }
 
-func (c *bikeRentalServiceClient) ListBikes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListBikesResponse, error) {
-	out := new(ListBikesResponse)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListBikes", in, out, opts...)
-	if err != nil {
+func (x *bikeRentalServiceWatchReservationsServer) Send(m *ReservationChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BikeRentalService_WatchBikeAvailability_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBikeAvailabilityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BikeRentalServiceServer).WatchBikeAvailability(m, &bikeRentalServiceWatchBikeAvailabilityServer{stream})
+}
+
+type BikeRentalService_WatchBikeAvailabilityServer interface {
+	Send(*BikeAvailabilityChange) error
+	grpc.ServerStream
+}
+
+type bikeRentalServiceWatchBikeAvailabilityServer struct {
+	grpc.ServerStream
+}
+
+func (x *bikeRentalServiceWatchBikeAvailabilityServer) Send(m *BikeAvailabilityChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BikeRentalService_FileDamageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileDamageReportRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).FileDamageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/FileDamageReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).FileDamageReport(ctx, req.(*FileDamageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) GetBike(ctx context.Context, in *GetBikeRequest, opts ...grpc.CallOption) (*Bike, error) {
-	out := new(Bike)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetBike", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_ListDamageReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDamageReportsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListDamageReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListDamageReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListDamageReports(ctx, req.(*ListDamageReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) CreateBike(ctx context.Context, in *CreateBikeRequest, opts ...grpc.CallOption) (*Bike, error) {
-	out := new(Bike)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateBike", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_GetDamageReportPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDamageReportPhotoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).GetDamageReportPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetDamageReportPhoto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).GetDamageReportPhoto(ctx, req.(*GetDamageReportPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) DeleteBike(ctx context.Context, in *DeleteBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/DeleteBike", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_ListPromoCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListPromoCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListPromoCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListPromoCodes(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreatePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CreatePromoCode(ctx, req.(*CreatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_GetLoyaltyAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoyaltyAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).GetLoyaltyAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetLoyaltyAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).GetLoyaltyAccount(ctx, req.(*GetLoyaltyAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_ListLoyaltyEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoyaltyEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListLoyaltyEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListLoyaltyEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListLoyaltyEntries(ctx, req.(*ListLoyaltyEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_ListCompanies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListCompanies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListCompanies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListCompanies(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_GetCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).GetCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).GetCompany(ctx, req.(*GetCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_CreateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CreateCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CreateCompany(ctx, req.(*CreateCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_UpdateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).UpdateCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/UpdateCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).UpdateCompany(ctx, req.(*UpdateCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_ListCompanyMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompanyMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListCompanyMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListCompanyMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListCompanyMembers(ctx, req.(*ListCompanyMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) UpdateBike(ctx context.Context, in *UpdateBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/UpdateBike", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_AddCompanyMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCompanyMemberRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).AddCompanyMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/AddCompanyMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).AddCompanyMember(ctx, req.(*AddCompanyMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) GetBikeAvailability(ctx context.Context, in *GetBikeAvailabilityRequest, opts ...grpc.CallOption) (*GetBikeAvailabilityResponse, error) {
-	out := new(GetBikeAvailabilityResponse)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetBikeAvailability", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_RemoveCompanyMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCompanyMemberRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).RemoveCompanyMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/RemoveCompanyMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).RemoveCompanyMember(ctx, req.(*RemoveCompanyMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error) {
-	out := new(ListReservationsResponse)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListReservations", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_GetCompanyStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyStatementRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).GetCompanyStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetCompanyStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).GetCompanyStatement(ctx, req.(*GetCompanyStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error) {
-	out := new(CreateReservationResponse)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateReservation", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_ListBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListBlocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListBlocklist(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CancelReservation", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_AddToBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToBlocklistRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// BikeRentalServiceServer is the server API for BikeRentalService service.
-type BikeRentalServiceServer interface {
-	// List all bikes.
-	ListBikes(context.Context, *empty.Empty) (*ListBikesResponse, error)
-	// Return bike by id.
-	GetBike(context.Context, *GetBikeRequest) (*Bike, error)
-	// Create new bike.
-	//
-	// Returns created object with new id.
-	CreateBike(context.Context, *CreateBikeRequest) (*Bike, error)
-	// Delete a bike by id.
-	DeleteBike(context.Context, *DeleteBikeRequest) (*empty.Empty, error)
-	// Update a bike.
-	UpdateBike(context.Context, *UpdateBikeRequest) (*empty.Empty, error)
-	// Check if bike is available.
-	GetBikeAvailability(context.Context, *GetBikeAvailabilityRequest) (*GetBikeAvailabilityResponse, error)
-	// List reservations.
-	//
-	// Returns list of reservations for a bike.
-	ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error)
-	// Create reservation.
-	//
-	// Returns created object with new id.
-	CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error)
-	// Cancel reservation.
-	CancelReservation(context.Context, *CancelReservationRequest) (*empty.Empty, error)
-}
-
-// UnimplementedBikeRentalServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedBikeRentalServiceServer struct {
-}
-
-func (*UnimplementedBikeRentalServiceServer) ListBikes(context.Context, *empty.Empty) (*ListBikesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBikes not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) GetBike(context.Context, *GetBikeRequest) (*Bike, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBike not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) CreateBike(context.Context, *CreateBikeRequest) (*Bike, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBike not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) DeleteBike(context.Context, *DeleteBikeRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBike not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) UpdateBike(context.Context, *UpdateBikeRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBike not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) GetBikeAvailability(context.Context, *GetBikeAvailabilityRequest) (*GetBikeAvailabilityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBikeAvailability not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReservations not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateReservation not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) CancelReservation(context.Context, *CancelReservationRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).AddToBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/AddToBlocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).AddToBlocklist(ctx, req.(*AddToBlocklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBikeRentalServiceServer(s *grpc.Server, srv BikeRentalServiceServer) {
-	s.RegisterService(&_BikeRentalService_serviceDesc, srv)
+func _BikeRentalService_RemoveFromBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromBlocklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).RemoveFromBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/RemoveFromBlocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).RemoveFromBlocklist(ctx, req.(*RemoveFromBlocklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_ListBikes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BikeRentalService_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).ListBikes(ctx, in)
+		return srv.(BikeRentalServiceServer).ListWebhookSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListBikes",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListWebhookSubscriptions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).ListBikes(ctx, req.(*empty.Empty))
+		return srv.(BikeRentalServiceServer).ListWebhookSubscriptions(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_GetBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBikeRequest)
+func _BikeRentalService_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).GetBike(ctx, in)
+		return srv.(BikeRentalServiceServer).CreateWebhookSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetBike",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateWebhookSubscription",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).GetBike(ctx, req.(*GetBikeRequest))
+		return srv.(BikeRentalServiceServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_CreateBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBikeRequest)
+func _BikeRentalService_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).CreateBike(ctx, in)
+		return srv.(BikeRentalServiceServer).DeleteWebhookSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateBike",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/DeleteWebhookSubscription",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).CreateBike(ctx, req.(*CreateBikeRequest))
+		return srv.(BikeRentalServiceServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_DeleteBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBikeRequest)
+func _BikeRentalService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).DeleteBike(ctx, in)
+		return srv.(BikeRentalServiceServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/DeleteBike",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListWebhookDeliveries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).DeleteBike(ctx, req.(*DeleteBikeRequest))
+		return srv.(BikeRentalServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_UpdateBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBikeRequest)
+func _BikeRentalService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).UpdateBike(ctx, in)
+		return srv.(BikeRentalServiceServer).RedeliverWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/UpdateBike",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/RedeliverWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).UpdateBike(ctx, req.(*UpdateBikeRequest))
+		return srv.(BikeRentalServiceServer).RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_GetBikeAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBikeAvailabilityRequest)
+func _BikeRentalService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).GetBikeAvailability(ctx, in)
+		return srv.(BikeRentalServiceServer).ListAPIKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetBikeAvailability",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListAPIKeys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).GetBikeAvailability(ctx, req.(*GetBikeAvailabilityRequest))
+		return srv.(BikeRentalServiceServer).ListAPIKeys(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_ListReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReservationsRequest)
+func _BikeRentalService_IssueAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueAPIKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).ListReservations(ctx, in)
+		return srv.(BikeRentalServiceServer).IssueAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListReservations",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/IssueAPIKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).ListReservations(ctx, req.(*ListReservationsRequest))
+		return srv.(BikeRentalServiceServer).IssueAPIKey(ctx, req.(*IssueAPIKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_CreateReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReservationRequest)
+func _BikeRentalService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).CreateReservation(ctx, in)
+		return srv.(BikeRentalServiceServer).RevokeAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateReservation",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/RevokeAPIKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).CreateReservation(ctx, req.(*CreateReservationRequest))
+		return srv.(BikeRentalServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelReservationRequest)
+func _BikeRentalService_ListOpenIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOpenIntervalsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).CancelReservation(ctx, in)
+		return srv.(BikeRentalServiceServer).ListOpenIntervals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CancelReservation",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListOpenIntervals",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).CancelReservation(ctx, req.(*CancelReservationRequest))
+		return srv.(BikeRentalServiceServer).ListOpenIntervals(ctx, req.(*ListOpenIntervalsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2164,6 +10410,10 @@ var _BikeRentalService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListReservations",
 			Handler:    _BikeRentalService_ListReservations_Handler,
 		},
+		{
+			MethodName: "GetPriceQuote",
+			Handler:    _BikeRentalService_GetPriceQuote_Handler,
+		},
 		{
 			MethodName: "CreateReservation",
 			Handler:    _BikeRentalService_CreateReservation_Handler,
@@ -2172,7 +10422,138 @@ var _BikeRentalService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelReservation",
 			Handler:    _BikeRentalService_CancelReservation_Handler,
 		},
+		{
+			MethodName: "CompleteReservation",
+			Handler:    _BikeRentalService_CompleteReservation_Handler,
+		},
+		{
+			MethodName: "RefundReservation",
+			Handler:    _BikeRentalService_RefundReservation_Handler,
+		},
+		{
+			MethodName: "GetReservationInvoice",
+			Handler:    _BikeRentalService_GetReservationInvoice_Handler,
+		},
+		{
+			MethodName: "FileDamageReport",
+			Handler:    _BikeRentalService_FileDamageReport_Handler,
+		},
+		{
+			MethodName: "ListDamageReports",
+			Handler:    _BikeRentalService_ListDamageReports_Handler,
+		},
+		{
+			MethodName: "GetDamageReportPhoto",
+			Handler:    _BikeRentalService_GetDamageReportPhoto_Handler,
+		},
+		{
+			MethodName: "ListPromoCodes",
+			Handler:    _BikeRentalService_ListPromoCodes_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _BikeRentalService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "GetLoyaltyAccount",
+			Handler:    _BikeRentalService_GetLoyaltyAccount_Handler,
+		},
+		{
+			MethodName: "ListLoyaltyEntries",
+			Handler:    _BikeRentalService_ListLoyaltyEntries_Handler,
+		},
+		{
+			MethodName: "ListCompanies",
+			Handler:    _BikeRentalService_ListCompanies_Handler,
+		},
+		{
+			MethodName: "GetCompany",
+			Handler:    _BikeRentalService_GetCompany_Handler,
+		},
+		{
+			MethodName: "CreateCompany",
+			Handler:    _BikeRentalService_CreateCompany_Handler,
+		},
+		{
+			MethodName: "UpdateCompany",
+			Handler:    _BikeRentalService_UpdateCompany_Handler,
+		},
+		{
+			MethodName: "ListCompanyMembers",
+			Handler:    _BikeRentalService_ListCompanyMembers_Handler,
+		},
+		{
+			MethodName: "AddCompanyMember",
+			Handler:    _BikeRentalService_AddCompanyMember_Handler,
+		},
+		{
+			MethodName: "RemoveCompanyMember",
+			Handler:    _BikeRentalService_RemoveCompanyMember_Handler,
+		},
+		{
+			MethodName: "GetCompanyStatement",
+			Handler:    _BikeRentalService_GetCompanyStatement_Handler,
+		},
+		{
+			MethodName: "ListBlocklist",
+			Handler:    _BikeRentalService_ListBlocklist_Handler,
+		},
+		{
+			MethodName: "AddToBlocklist",
+			Handler:    _BikeRentalService_AddToBlocklist_Handler,
+		},
+		{
+			MethodName: "RemoveFromBlocklist",
+			Handler:    _BikeRentalService_RemoveFromBlocklist_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _BikeRentalService_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _BikeRentalService_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _BikeRentalService_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _BikeRentalService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _BikeRentalService_RedeliverWebhook_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _BikeRentalService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "IssueAPIKey",
+			Handler:    _BikeRentalService_IssueAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _BikeRentalService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "ListOpenIntervals",
+			Handler:    _BikeRentalService_ListOpenIntervals_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchReservations",
+			Handler:       _BikeRentalService_WatchReservations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchBikeAvailability",
+			Handler:       _BikeRentalService_WatchBikeAvailability_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "nglogic/bikerental/v1/service.proto",
 }